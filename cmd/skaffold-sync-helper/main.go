@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// skaffold-sync-helper receives the files synced by Skaffold into containers that don't have `tar`.
+//
+// It is installed into the pods by an init container that runs `skaffold-sync-helper install <dir>`,
+// and then run by Skaffold with `skaffold-sync-helper receive` each time files have to be synced.
+//
+// It also implements the post-sync actions: `signal <SIG> <pid>` sends a signal to a process,
+// `supervise -- <command>` runs the container's entrypoint and restarts it on `restart`.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
+)
+
+func main() {
+	code, err := run(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(code)
+}
+
+func run(args []string) (int, error) {
+	switch {
+	case len(args) == 2 && args[0] == "install":
+		return 0, helper.Install(args[1])
+	case len(args) == 1 && args[0] == "receive":
+		return 0, helper.Receive(os.Stdin, "/")
+	case len(args) == 3 && args[0] == "signal":
+		pid, err := strconv.Atoi(args[2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid pid %q", args[2])
+		}
+		return 0, helper.Signal(args[1], pid)
+	case len(args) == 1 && args[0] == "restart":
+		return 0, helper.Restart()
+	case len(args) > 2 && args[0] == "supervise" && args[1] == "--":
+		return helper.Supervise(args[2:])
+	default:
+		return 0, fmt.Errorf("usage: %s install <dir> | receive | signal <signal> <pid> | restart | supervise -- <command>", helper.BinaryName)
+	}
+}
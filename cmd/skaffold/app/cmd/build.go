@@ -34,7 +34,6 @@ import (
 var (
 	quietFlag       bool
 	buildFormatFlag = flags.NewTemplateFlag("{{json .}}", flags.BuildOutput{})
-	buildOutputFlag string
 )
 
 // NewCmdBuild describes the CLI command to build artifacts.
@@ -52,7 +51,7 @@ func NewCmdBuild() *cobra.Command {
 		WithFlags([]*Flag{
 			{Value: &quietFlag, Name: "quiet", Shorthand: "q", DefValue: false, Usage: "Suppress the build output and print image built on success. See --output to format output.", IsEnum: true},
 			{Value: buildFormatFlag, Name: "output", Shorthand: "o", Usage: "Used in conjunction with --quiet flag. " + buildFormatFlag.Usage()},
-			{Value: &buildOutputFlag, Name: "file-output", DefValue: "", Usage: "Filename to write build images to"},
+			{Value: &opts.BuildOutputFile, Name: "file-output", DefValue: "", Usage: "Filename to write build images to"},
 			{Value: &opts.DryRun, Name: "dry-run", DefValue: false, Usage: "Don't build images, just compute the tag for each artifact.", IsEnum: true},
 		}).
 		WithHouseKeepingMessages().
@@ -68,7 +67,7 @@ func doBuild(ctx context.Context, out io.Writer) error {
 	return withRunner(ctx, func(r runner.Runner, config *latest.SkaffoldConfig) error {
 		bRes, err := r.BuildAndTest(ctx, buildOut, targetArtifacts(opts, config))
 
-		if quietFlag || buildOutputFlag != "" {
+		if quietFlag || opts.BuildOutputFile != "" {
 			cmdOut := flags.BuildOutput{Builds: bRes}
 			var buildOutput bytes.Buffer
 			if err := buildFormatFlag.Template().Execute(&buildOutput, cmdOut); err != nil {
@@ -81,8 +80,8 @@ func doBuild(ctx context.Context, out io.Writer) error {
 				}
 			}
 
-			if buildOutputFlag != "" {
-				if err := ioutil.WriteFile(buildOutputFlag, buildOutput.Bytes(), 0644); err != nil {
+			if opts.BuildOutputFile != "" {
+				if err := ioutil.WriteFile(opts.BuildOutputFile, buildOutput.Bytes(), 0644); err != nil {
 					return fmt.Errorf("writing build output to file: %w", err)
 				}
 			}
@@ -152,7 +152,7 @@ func TestFileOutputFlag(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&quietFlag, test.quietFlag)
-			t.Override(&buildOutputFlag, test.filename)
+			t.Override(&opts.BuildOutputFile, test.filename)
 			t.Override(&createRunner, mockCreateRunner)
 			if test.template != "" {
 				t.Override(&buildFormatFlag, flags.NewTemplateFlag(test.template, flags.BuildOutput{}))
@@ -85,6 +85,9 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 			}
 			shutdownAPIServer = shutdown
 
+			// Stream the output to the clients of the API
+			cmd.Root().SetOutput(server.TeeOutput(out))
+
 			// Print version
 			version := version.Get()
 			logrus.Infof("Skaffold %+v", version)
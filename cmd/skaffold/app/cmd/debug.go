@@ -21,9 +21,6 @@ import (
 	"io"
 
 	"github.com/spf13/cobra"
-
-	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 )
 
 // for tests
@@ -44,7 +41,7 @@ func NewCmdDebug() *cobra.Command {
 
 func runDebug(ctx context.Context, out io.Writer) error {
 	opts.PortForward.ForwardPods = true
-	manifest.AddTransform(debugging.ApplyDebuggingTransforms)
 
+	// The debug transforms are added by the dev loop, which knows the artifacts' debug recipes.
 	return doDev(ctx, out)
 }
@@ -24,8 +24,14 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server/remote"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 )
 
 // for testing
@@ -41,6 +47,11 @@ func NewCmdDev() *cobra.Command {
 }
 
 func runDev(ctx context.Context, out io.Writer) error {
+	// The dev loop runs on a remote Skaffold, this one only uploads the local changes.
+	if opts.Remote != "" {
+		return remote.Dev(ctx, out, opts)
+	}
+
 	prune := func() {}
 	if opts.Prune() {
 		defer func() {
@@ -55,12 +66,32 @@ func runDev(ctx context.Context, out io.Writer) error {
 		}()
 	}
 
+	var artifacts []*latest.Artifact
+
+	// Configure the containers for debugging, using the artifacts' debug recipes.
+	// When attaching debuggers through ephemeral containers, the workloads are deployed unchanged.
+	if opts.Mode() == config.RunModes.Debug && opts.DebugMode != debugging.ModeAttach {
+		manifest.AddTransform(func(l manifest.ManifestList, builds []build.Artifact, registries manifest.Registries) (manifest.ManifestList, error) {
+			probeTimeout, err := debugging.ParseProbeTimeout(opts.AutoProbeTimeout)
+			if err != nil {
+				return nil, err
+			}
+			return debugging.ApplyDebuggingTransforms(l, builds, artifacts, registries, probeTimeout)
+		})
+	}
+
+	// Add the sync helper to the pods of the artifacts that may be synced without `tar`.
+	manifest.AddTransform(func(l manifest.ManifestList, builds []build.Artifact, registries manifest.Registries) (manifest.ManifestList, error) {
+		return sync.InjectHelper(l, builds, artifacts, registries)
+	})
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		default:
 			err := withRunner(ctx, func(r runner.Runner, config *latest.SkaffoldConfig) error {
+				artifacts = config.Build.Artifacts
 				err := r.Dev(ctx, out, config.Build.Artifacts)
 
 				if r.HasDeployed() {
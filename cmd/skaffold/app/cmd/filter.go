@@ -69,15 +69,19 @@ func runFilter(ctx context.Context, out io.Writer, debuggingFilters bool, buildA
 			if err != nil {
 				return fmt.Errorf("resolving debug helpers: %w", err)
 			}
+			probeTimeout, err := debugging.ParseProbeTimeout(opts.AutoProbeTimeout)
+			if err != nil {
+				return err
+			}
 			insecureRegistries, err := getInsecureRegistries(opts, cfg)
 			if err != nil {
 				return fmt.Errorf("retrieving insecure registries: %w", err)
 			}
 
-			manifestList, err = debugging.ApplyDebuggingTransforms(manifestList, buildArtifacts, manifest.Registries{
+			manifestList, err = debugging.ApplyDebuggingTransforms(manifestList, buildArtifacts, cfg.Build.Artifacts, manifest.Registries{
 				DebugHelpersRegistry: debugHelpersRegistry,
 				InsecureRegistries:   insecureRegistries,
-			})
+			}, probeTimeout)
 			if err != nil {
 				return fmt.Errorf("transforming manifests: %w", err)
 			}
@@ -27,6 +27,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/flags"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 var (
@@ -76,6 +77,15 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringSliceVar",
 		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
 	},
+	{
+		Name:          "module",
+		Shorthand:     "m",
+		Usage:         "Filter Skaffold configs to only the provided named modules",
+		Value:         &opts.Modules,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
+	},
 	{
 		Name:          "namespace",
 		Shorthand:     "n",
@@ -111,6 +121,22 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug"},
 	},
+	{
+		Name:          "remote-cache",
+		Usage:         "Share the artifact cache with other users. Set to 'registry' to record artifact hashes as tags in the image repositories, or to an http(s) URL to store them on a file server",
+		Value:         &opts.RemoteCache,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
+	{
+		Name:          "platform",
+		Usage:         "The target platforms to build images for, in the os/arch format. Overrides the platforms listed in the skaffold config. For example: linux/amd64,linux/arm64",
+		Value:         &opts.Platforms,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
 	{
 		Name:          "insecure-registry",
 		Usage:         "Target registries for built images which are not secure",
@@ -155,6 +181,55 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "IntVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "rpc-address",
+		Usage:         "network address the event API listens on. Use 0.0.0.0 to accept remote clients",
+		Value:         &opts.RPCAddress,
+		DefValue:      util.Loopback,
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "rpc-tls-cert",
+		Usage:         "Certificate file to serve the event API over TLS. With --remote, the certificate to trust when connecting to the remote Skaffold",
+		Value:         &opts.RPCTLSCert,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "rpc-tls-key",
+		Usage:         "Private key file to serve the event API over TLS",
+		Value:         &opts.RPCTLSKey,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "rpc-token",
+		Usage:         "Token the clients of the API have to send as a bearer token. With --remote, the token sent to the remote Skaffold",
+		Value:         &opts.RPCToken,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "accept-uploads",
+		Usage:         "EXPERIMENTAL: Accept file changes uploaded by a `skaffold dev --remote` client into the working directory",
+		Value:         &opts.AcceptUploads,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "remote",
+		Usage:         "EXPERIMENTAL: Address of a remote `skaffold dev --accept-uploads`. Local file changes are uploaded to it and its output is streamed back",
+		Value:         &opts.Remote,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev"},
+	},
 	{
 		Name:          "label",
 		Shorthand:     "l",
@@ -186,6 +261,47 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "log-format",
+		Usage:         "Format of the application logs: `text` or `json`",
+		Value:         &opts.LogFormat,
+		DefValue:      "text",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
+	{
+		Name:          "log-files",
+		Usage:         "Also write the logs of each container to `.skaffold/logs/<pod>/<container>.log`",
+		Value:         &opts.LogFiles,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+		IsEnum:        true,
+	},
+	{
+		Name:          "debug-mode",
+		Usage:         "How to debug the containers: `transform` rewrites the manifests to start them under a debugger, `attach` deploys them unchanged and attaches debuggers through ephemeral containers",
+		Value:         &opts.DebugMode,
+		DefValue:      "transform",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"debug"},
+	},
+	{
+		Name:          "auto-probe-timeout",
+		Usage:         "Timeout given to the liveness and readiness probes of the debugged containers, so that they aren't restarted while stopped at a breakpoint. Use `0` to leave the probes unchanged, or `remove` to remove them",
+		Value:         &opts.AutoProbeTimeout,
+		DefValue:      "10m",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"debug", "filter"},
+	},
+	{
+		Name:          "ide-config",
+		Usage:         "Write the launch configurations of an IDE to attach to the debugged containers, and keep them up to date as pods restart: `vscode` (.vscode/launch.json), `jetbrains` (.run/) or `dap` (.skaffold/dap.json)",
+		Value:         &opts.IDEConfig,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"debug"},
+	},
 	{
 		Name:          "force",
 		Usage:         "Recreate Kubernetes resources if necessary for deployment, warning: might cause downtime!",
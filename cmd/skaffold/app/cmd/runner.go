@@ -24,6 +24,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/compose"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
@@ -92,10 +93,25 @@ func runContext(opts config.SkaffoldOptions) (*runcontext.RunContext, *latest.Sk
 
 	kubectx.ConfigureKubeConfig(opts.KubeConfig, opts.KubeContext, config.Deploy.KubeContext)
 
+	if err := compose.AddArtifacts(&config.Pipeline, ""); err != nil {
+		return nil, nil, fmt.Errorf("reading compose files: %w", err)
+	}
+
 	if err := defaults.Set(config); err != nil {
 		return nil, nil, fmt.Errorf("setting default values: %w", err)
 	}
 
+	if err := schema.ResolveDependencies(config, opts); err != nil {
+		return nil, nil, fmt.Errorf("resolving required configs: %w", err)
+	}
+
+	// The `--platform` flag overrides the target platforms of every artifact.
+	if len(opts.Platforms) > 0 {
+		for _, a := range config.Build.Artifacts {
+			a.Platforms = opts.Platforms
+		}
+	}
+
 	if err := validation.Process(config); err != nil {
 		return nil, nil, fmt.Errorf("invalid skaffold config: %w", err)
 	}
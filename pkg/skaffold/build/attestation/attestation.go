@@ -0,0 +1,265 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/daemon"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// for testing
+var (
+	getImage       = image
+	pushAttachment = docker.PushAttachment
+)
+
+type Config interface {
+	docker.Config
+
+	Pipeline() latest.Pipeline
+	BuildOutputFile() string
+	ConfigurationFile() string
+}
+
+// InputHasher computes the hash of the inputs of an artifact.
+type InputHasher func(context.Context, *latest.Artifact) (string, error)
+
+// Attester generates the SBOM and provenance of built images.
+type Attester struct {
+	cfg            Config
+	attestations   latest.Attestations
+	imagesAreLocal bool
+	inputHash      InputHasher
+}
+
+// document is an attestation of a single image.
+type document struct {
+	// kind is used in file names and in the tags of pushed documents.
+	kind      string
+	fileName  string
+	mediaType types.MediaType
+	content   []byte
+}
+
+// NewAttester returns an Attester, or nil if no attestations are configured.
+func NewAttester(cfg Config, imagesAreLocal bool, inputHash InputHasher) *Attester {
+	attestations := cfg.Pipeline().Build.Attestations
+	if attestations == nil || (attestations.SBOM == "" && !attestations.Provenance) {
+		return nil
+	}
+
+	return &Attester{
+		cfg:            cfg,
+		attestations:   *attestations,
+		imagesAreLocal: imagesAreLocal,
+		inputHash:      inputHash,
+	}
+}
+
+// Attest generates the configured documents for each built image,
+// writes them next to the build output file and optionally pushes them.
+func (a *Attester) Attest(ctx context.Context, out io.Writer, artifacts []*latest.Artifact, builds []build.Artifact, started, finished time.Time) error {
+	push := a.attestations.Push
+	if push && a.imagesAreLocal {
+		logrus.Warnln("Images are not pushed to a remote registry, not pushing attestations")
+		push = false
+	}
+	if a.cfg.BuildOutputFile() == "" && !push {
+		logrus.Warnln("Attestations are neither written to a file nor pushed, use --file-output or set 'push: true' to keep them")
+		return nil
+	}
+
+	fmt.Fprintln(out, "Generating attestations...")
+	for _, b := range builds {
+		artifact := findArtifact(artifacts, b.ImageName)
+		if artifact == nil {
+			continue
+		}
+
+		img, digest, err := getImage(ctx, a.cfg, b.Tag, a.imagesAreLocal)
+		if err != nil {
+			return fmt.Errorf("getting image %q: %w", b.Tag, err)
+		}
+
+		docs, err := a.documents(ctx, artifact, img, b.Tag, digest, started, finished)
+		if err != nil {
+			return fmt.Errorf("generating attestations for %q: %w", b.ImageName, err)
+		}
+
+		for _, doc := range docs {
+			if err := a.save(out, b.ImageName, doc); err != nil {
+				return err
+			}
+			if push {
+				if err := a.push(out, b.Tag, digest, doc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Attester) documents(ctx context.Context, artifact *latest.Artifact, img v1.Image, tag, digest string, started, finished time.Time) ([]document, error) {
+	var docs []document
+
+	if format := a.attestations.SBOM; format != "" {
+		inv, err := listPackages(img)
+		if err != nil {
+			return nil, err
+		}
+		content, err := sbomDocument(format, artifact.ImageName, digest, inv, finished)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, document{
+			kind:      "sbom",
+			fileName:  fmt.Sprintf("sbom.%s.json", format),
+			mediaType: sbomMediaType(format),
+			content:   content,
+		})
+	}
+
+	if a.attestations.Provenance {
+		hash, err := a.inputHash(ctx, artifact)
+		if err != nil {
+			return nil, fmt.Errorf("getting hash for artifact %q: %w", artifact.ImageName, err)
+		}
+		stmt, err := provenanceStatement(provenanceInput{
+			artifact:   artifact,
+			image:      tag,
+			digest:     digest,
+			configFile: a.cfg.ConfigurationFile(),
+			inputHash:  hash,
+			started:    started,
+			finished:   finished,
+		})
+		if err != nil {
+			return nil, err
+		}
+		content, err := json.MarshalIndent(stmt, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, document{
+			kind:      "att",
+			fileName:  "provenance.json",
+			mediaType: provenanceMimeType,
+			content:   content,
+		})
+	}
+
+	return docs, nil
+}
+
+// save writes a document next to the build output file, e.g. `gcr.io_project_app.sbom.spdx.json`.
+func (a *Attester) save(out io.Writer, imageName string, doc document) error {
+	if a.cfg.BuildOutputFile() == "" {
+		return nil
+	}
+
+	file := filepath.Join(filepath.Dir(a.cfg.BuildOutputFile()), fileSafe(imageName)+"."+doc.fileName)
+	if err := ioutil.WriteFile(file, doc.content, 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", file, err)
+	}
+	fmt.Fprintf(out, " - %s -> %s\n", imageName, file)
+	return nil
+}
+
+// push pushes a document next to the image it describes.
+func (a *Attester) push(out io.Writer, image, digest string, doc document) error {
+	tag, err := docker.AttachmentTag(image, digest, doc.kind)
+	if err != nil {
+		return err
+	}
+	if _, err := pushAttachment(tag, doc.content, doc.mediaType, a.cfg); err != nil {
+		return fmt.Errorf("pushing %s: %w", tag, err)
+	}
+	fmt.Fprintf(out, " - %s -> %s\n", image, tag)
+	return nil
+}
+
+// image retrieves a built image and its digest, either from the local Docker daemon or from its registry.
+func image(ctx context.Context, cfg docker.Config, tag string, imagesAreLocal bool) (v1.Image, string, error) {
+	var img v1.Image
+	if imagesAreLocal {
+		ref, err := name.ParseReference(tag, name.WeakValidation)
+		if err != nil {
+			return nil, "", fmt.Errorf("parsing reference %q: %w", tag, err)
+		}
+		localDocker, err := docker.NewAPIClient(cfg)
+		if err != nil {
+			return nil, "", err
+		}
+		img, err = daemon.Image(ref, daemon.WithClient(localDocker.RawClient()), daemon.WithUnbufferedOpener())
+		if err != nil {
+			return nil, "", err
+		}
+	} else {
+		var err error
+		img, err = docker.RetrieveRemoteImage(tag, cfg)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	// Pushed images are referenced by their digest.
+	if parts := strings.SplitN(tag, "@", 2); len(parts) == 2 {
+		return img, parts[1], nil
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return nil, "", err
+	}
+	return img, digest.String(), nil
+}
+
+func sbomMediaType(format string) types.MediaType {
+	if format == CycloneDX {
+		return "application/vnd.cyclonedx+json"
+	}
+	return "application/spdx+json"
+}
+
+func findArtifact(artifacts []*latest.Artifact, imageName string) *latest.Artifact {
+	for _, a := range artifacts {
+		if a.ImageName == imageName {
+			return a
+		}
+	}
+	return nil
+}
+
+func fileSafe(imageName string) string {
+	return strings.NewReplacer("/", "_", ":", "_").Replace(imageName)
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const testDigest = "sha256:9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a"
+
+type mockConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	attestations          *latest.Attestations
+	buildOutputFile       string
+	configFile            string
+}
+
+func (c *mockConfig) Pipeline() latest.Pipeline {
+	var pipeline latest.Pipeline
+	pipeline.Build.Attestations = c.attestations
+	return pipeline
+}
+func (c *mockConfig) BuildOutputFile() string   { return c.buildOutputFile }
+func (c *mockConfig) ConfigurationFile() string { return c.configFile }
+
+func TestNewAttester(t *testing.T) {
+	tests := []struct {
+		description  string
+		attestations *latest.Attestations
+		expected     bool
+	}{
+		{
+			description: "not configured",
+		},
+		{
+			description:  "nothing to generate",
+			attestations: &latest.Attestations{Push: true},
+		},
+		{
+			description:  "sbom",
+			attestations: &latest.Attestations{SBOM: SPDX},
+			expected:     true,
+		},
+		{
+			description:  "provenance",
+			attestations: &latest.Attestations{Provenance: true},
+			expected:     true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			attester := NewAttester(&mockConfig{attestations: test.attestations}, false, nil)
+
+			t.CheckDeepEqual(test.expected, attester != nil)
+		})
+	}
+}
+
+func TestAttest(t *testing.T) {
+	tests := []struct {
+		description    string
+		attestations   latest.Attestations
+		imagesAreLocal bool
+		fileOutput     bool
+		expectedFiles  []string
+		expectedPushed map[string]types.MediaType
+	}{
+		{
+			description:   "sbom and provenance written next to the build output",
+			attestations:  latest.Attestations{SBOM: CycloneDX, Provenance: true},
+			fileOutput:    true,
+			expectedFiles: []string{"gcr.io_project_app.sbom.cyclonedx.json", "gcr.io_project_app.provenance.json"},
+		},
+		{
+			description:  "pushed next to the image",
+			attestations: latest.Attestations{SBOM: SPDX, Provenance: true, Push: true},
+			expectedPushed: map[string]types.MediaType{
+				"gcr.io/project/app:sha256-9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a.sbom": "application/spdx+json",
+				"gcr.io/project/app:sha256-9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a.att":  "application/vnd.in-toto+json",
+			},
+		},
+		{
+			description:    "local images are not pushed",
+			attestations:   latest.Attestations{SBOM: SPDX, Push: true},
+			imagesAreLocal: true,
+			fileOutput:     true,
+			expectedFiles:  []string{"gcr.io_project_app.sbom.spdx.json"},
+		},
+		{
+			description:  "nowhere to write",
+			attestations: latest.Attestations{Provenance: true},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("skaffold.yaml", "apiVersion: skaffold/v2beta11")
+			pushed := map[string]types.MediaType{}
+			t.Override(&getImage, func(context.Context, docker.Config, string, bool) (v1.Image, string, error) {
+				return imageWithFiles(t, map[string]string{"etc/os-release": "ID=alpine\n"}, nil), testDigest, nil
+			})
+			t.Override(&pushAttachment, func(tag string, _ []byte, mediaType types.MediaType, _ docker.Config) (string, error) {
+				pushed[tag] = mediaType
+				return "", nil
+			})
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("git rev-parse HEAD", "0123456789abcdef").
+				AndRunOut("git config --get remote.origin.url", "https://github.com/org/app.git"))
+
+			cfg := &mockConfig{attestations: &test.attestations, configFile: tmpDir.Path("skaffold.yaml")}
+			if test.fileOutput {
+				cfg.buildOutputFile = tmpDir.Path("build.json")
+			}
+			attester := NewAttester(cfg, test.imagesAreLocal, func(context.Context, *latest.Artifact) (string, error) {
+				return "input-hash", nil
+			})
+
+			artifacts := []*latest.Artifact{{ImageName: "gcr.io/project/app", Workspace: "."}}
+			builds := []build.Artifact{{ImageName: "gcr.io/project/app", Tag: "gcr.io/project/app:v1@" + testDigest}}
+			err := attester.Attest(context.Background(), &bytes.Buffer{}, artifacts, builds, time.Now(), time.Now())
+
+			t.CheckNoError(err)
+			for _, file := range test.expectedFiles {
+				t.CheckTrue(util.IsFile(tmpDir.Path(file)))
+			}
+			if test.expectedPushed == nil {
+				test.expectedPushed = map[string]types.MediaType{}
+			}
+			t.CheckDeepEqual(test.expectedPushed, pushed)
+		})
+	}
+}
+
+func TestProvenanceStatement(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("skaffold.yaml", "apiVersion: skaffold/v2beta11")
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("git rev-parse HEAD", "0123456789abcdef").
+			AndRunOut("git config --get remote.origin.url", "https://github.com/org/app.git"))
+
+		started := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+		stmt, err := provenanceStatement(provenanceInput{
+			artifact:   &latest.Artifact{ImageName: "gcr.io/project/app", Workspace: ".", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"}}},
+			image:      "gcr.io/project/app:v1@" + testDigest,
+			digest:     testDigest,
+			configFile: tmpDir.Path("skaffold.yaml"),
+			inputHash:  "input-hash",
+			started:    started,
+			finished:   started.Add(time.Minute),
+		})
+		t.CheckNoError(err)
+
+		expected := `{"_type":"https://in-toto.io/Statement/v0.1","predicateType":"https://slsa.dev/provenance/v0.1",` +
+			`"subject":[{"name":"gcr.io/project/app","digest":{"sha256":"9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a"}}],` +
+			`"predicate":{"builder":{"id":"https://skaffold.dev/builders/docker"},` +
+			`"recipe":{"type":"https://skaffold.dev/recipes/build@v1","definedInMaterial":0,"entryPoint":"` + filepath.ToSlash(tmpDir.Path("skaffold.yaml")) + `",` +
+			`"arguments":{"artifact":"image: gcr.io/project/app\ncontext: .\ndocker:\n  dockerfile: Dockerfile","inputHash":"input-hash"}},` +
+			`"metadata":{"buildStartedOn":"2021-01-02T03:04:05Z","buildFinishedOn":"2021-01-02T03:05:05Z","completeness":{"arguments":true,"environment":false,"materials":false},"reproducible":false},` +
+			`"materials":[{"uri":"` + filepath.ToSlash(tmpDir.Path("skaffold.yaml")) + `","digest":{"sha256":"240e0d8e6249e2bb19826db92ca714f6ddf088a27b0dfa22d33674bf6ba3ec92"}},` +
+			`{"uri":"git+https://github.com/org/app.git","digest":{"sha1":"0123456789abcdef"}}]}}`
+		actual, err := json.Marshal(stmt)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expected, string(actual))
+	})
+}
+
+func TestProvenanceStatementConfigFromStdin(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOutErr("git rev-parse HEAD", "", errors.New("not a git repository")))
+
+		stmt, err := provenanceStatement(provenanceInput{
+			artifact:   &latest.Artifact{ImageName: "gcr.io/project/app", Workspace: "."},
+			image:      "gcr.io/project/app:v1@" + testDigest,
+			digest:     testDigest,
+			configFile: "-",
+		})
+		t.CheckNoError(err)
+
+		t.CheckNil(stmt.Predicate.Recipe.DefinedInMaterial)
+		t.CheckDeepEqual("-", stmt.Predicate.Recipe.EntryPoint)
+		t.CheckEmpty(stmt.Predicate.Materials)
+	})
+}
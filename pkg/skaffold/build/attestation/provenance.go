@@ -0,0 +1,209 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	statementType      = "https://in-toto.io/Statement/v0.1"
+	provenanceType     = "https://slsa.dev/provenance/v0.1"
+	recipeType         = "https://skaffold.dev/recipes/build@v1"
+	builderIDPrefix    = "https://skaffold.dev/builders/"
+	provenanceMimeType = "application/vnd.in-toto+json"
+)
+
+// statement is an in-toto statement, see https://github.com/in-toto/attestation.
+type statement struct {
+	Type          string     `json:"_type"`
+	PredicateType string     `json:"predicateType"`
+	Subject       []subject  `json:"subject"`
+	Predicate     provenance `json:"predicate"`
+}
+
+type subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// provenance is a SLSA provenance predicate, see https://slsa.dev/provenance/v0.1.
+type provenance struct {
+	Builder   builder    `json:"builder"`
+	Recipe    recipe     `json:"recipe"`
+	Metadata  metadata   `json:"metadata"`
+	Materials []material `json:"materials"`
+}
+
+type builder struct {
+	ID string `json:"id"`
+}
+
+type recipe struct {
+	Type              string          `json:"type"`
+	DefinedInMaterial *int            `json:"definedInMaterial,omitempty"`
+	EntryPoint        string          `json:"entryPoint"`
+	Arguments         recipeArguments `json:"arguments"`
+}
+
+type recipeArguments struct {
+	Artifact  string `json:"artifact"`
+	InputHash string `json:"inputHash,omitempty"`
+}
+
+type metadata struct {
+	BuildStartedOn  string       `json:"buildStartedOn"`
+	BuildFinishedOn string       `json:"buildFinishedOn"`
+	Completeness    completeness `json:"completeness"`
+	Reproducible    bool         `json:"reproducible"`
+}
+
+type completeness struct {
+	Arguments   bool `json:"arguments"`
+	Environment bool `json:"environment"`
+	Materials   bool `json:"materials"`
+}
+
+type material struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest"`
+}
+
+// provenanceInput holds what is recorded in the provenance of a single image.
+type provenanceInput struct {
+	artifact   *latest.Artifact
+	image      string
+	digest     string
+	configFile string
+	inputHash  string
+	started    time.Time
+	finished   time.Time
+}
+
+func provenanceStatement(in provenanceInput) (statement, error) {
+	ref, err := name.ParseReference(in.image, name.WeakValidation)
+	if err != nil {
+		return statement{}, fmt.Errorf("parsing reference %q: %w", in.image, err)
+	}
+	algorithm, encoded, err := splitDigest(in.digest)
+	if err != nil {
+		return statement{}, err
+	}
+
+	// a config read from stdin or from a URL isn't recorded as a material
+	var definedInMaterial *int
+	configFile := ""
+	if in.configFile != "-" && !util.IsURL(in.configFile) {
+		definedInMaterial = new(int)
+		configFile = in.configFile
+	}
+	materials, err := provenanceMaterials(in.artifact, configFile)
+	if err != nil {
+		return statement{}, err
+	}
+
+	return statement{
+		Type:          statementType,
+		PredicateType: provenanceType,
+		Subject: []subject{{
+			Name:   ref.Context().Name(),
+			Digest: map[string]string{algorithm: encoded},
+		}},
+		Predicate: provenance{
+			Builder: builder{ID: builderIDPrefix + misc.ArtifactType(in.artifact)},
+			Recipe: recipe{
+				Type:              recipeType,
+				DefinedInMaterial: definedInMaterial,
+				EntryPoint:        in.configFile,
+				Arguments: recipeArguments{
+					Artifact:  misc.FormatArtifact(in.artifact),
+					InputHash: in.inputHash,
+				},
+			},
+			Metadata: metadata{
+				BuildStartedOn:  in.started.UTC().Format(time.RFC3339),
+				BuildFinishedOn: in.finished.UTC().Format(time.RFC3339),
+				Completeness:    completeness{Arguments: true},
+			},
+			Materials: materials,
+		},
+	}, nil
+}
+
+// provenanceMaterials lists the skaffold config, which defines the recipe, if it's a local file,
+// followed by the git commit of the artifact's workspace, if any.
+func provenanceMaterials(a *latest.Artifact, configFile string) ([]material, error) {
+	materials := []material{}
+	if configFile != "" {
+		config, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading %q: %w", configFile, err)
+		}
+		sum := sha256.Sum256(config)
+		materials = append(materials, material{
+			URI:    configFile,
+			Digest: map[string]string{"sha256": hex.EncodeToString(sum[:])},
+		})
+	}
+
+	commit, err := runGit(a.Workspace, "rev-parse", "HEAD")
+	if err != nil {
+		logrus.Debugf("Not recording git commit of %q in provenance: %v", a.Workspace, err)
+		return materials, nil
+	}
+	uri := "git+" + a.Workspace
+	if remote, err := runGit(a.Workspace, "config", "--get", "remote.origin.url"); err == nil && remote != "" {
+		uri = "git+" + remote
+	}
+	return append(materials, material{
+		URI:    uri,
+		Digest: map[string]string{"sha1": commit},
+	}), nil
+}
+
+func splitDigest(digest string) (string, string, error) {
+	parts := strings.SplitN(digest, ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid digest %q", digest)
+	}
+	return parts[0], parts[1], nil
+}
+
+func runGit(workingDir string, arg ...string) (string, error) {
+	cmd := exec.Command("git", arg...)
+	cmd.Dir = workingDir
+
+	out, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimSpace(out)), nil
+}
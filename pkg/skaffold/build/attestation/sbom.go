@@ -0,0 +1,408 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation
+
+import (
+	"archive/tar"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
+)
+
+const (
+	SPDX      = "spdx"
+	CycloneDX = "cyclonedx"
+
+	dpkgStatus    = "var/lib/dpkg/status"
+	dpkgStatusDir = "var/lib/dpkg/status.d"
+	apkInstalled  = "lib/apk/db/installed"
+	osRelease     = "etc/os-release"
+	usrOSRelease  = "usr/lib/os-release"
+
+	// maxLinks bounds the number of links followed when resolving a path, as a protection against loops.
+	maxLinks = 40
+)
+
+// pkg is a package installed in an image.
+type pkg struct {
+	Type    string
+	Name    string
+	Version string
+	Arch    string
+}
+
+// purl returns the package url of a package, as defined in https://github.com/package-url/purl-spec.
+func (p pkg) purl(distro string) string {
+	purl := fmt.Sprintf("pkg:%s/%s/%s@%s", p.Type, distro, p.Name, p.Version)
+	if p.Arch != "" {
+		purl += "?arch=" + p.Arch
+	}
+	return purl
+}
+
+// inventory lists the packages installed in an image.
+type inventory struct {
+	distro   string
+	packages []pkg
+}
+
+// listPackages reads the package databases of the flattened image filesystem.
+func listPackages(img v1.Image) (inventory, error) {
+	rc := mutate.Extract(img)
+	defer rc.Close()
+
+	var inv inventory
+	// os-release is usually a link to `usr/lib/os-release`, which can come before or after it in the archive
+	links := map[string]string{}
+	distros := map[string]string{}
+
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return inventory{}, fmt.Errorf("reading image filesystem: %w", err)
+		}
+
+		file := rootPath(hdr.Name)
+		switch hdr.Typeflag {
+		case tar.TypeSymlink:
+			if path.IsAbs(hdr.Linkname) {
+				links[file] = rootPath(hdr.Linkname)
+			} else {
+				links[file] = rootPath(path.Join(path.Dir(file), hdr.Linkname))
+			}
+			continue
+		case tar.TypeLink:
+			links[file] = rootPath(hdr.Linkname)
+			continue
+		case tar.TypeReg:
+		default:
+			continue
+		}
+
+		switch {
+		case file == dpkgStatus, path.Dir(file) == dpkgStatusDir:
+			inv.packages = append(inv.packages, parseDpkgStatus(tr)...)
+		case file == apkInstalled:
+			inv.packages = append(inv.packages, parseApkInstalled(tr)...)
+		case path.Base(file) == "os-release":
+			distros[file] = parseOSReleaseID(tr)
+		}
+	}
+
+	for _, file := range []string{osRelease, usrOSRelease} {
+		if distro := distros[resolveLinks(file, links)]; distro != "" {
+			inv.distro = distro
+			break
+		}
+	}
+
+	sort.Slice(inv.packages, func(i, j int) bool {
+		return inv.packages[i].Name < inv.packages[j].Name
+	})
+	if inv.distro == "" {
+		inv.distro = "unknown"
+	}
+	return inv, nil
+}
+
+// rootPath returns a path of the image filesystem, relative to its root.
+// Paths that go above the root stay at the root.
+func rootPath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+// resolveLinks follows the links of a path and of its parent directories, within the image filesystem.
+func resolveLinks(file string, links map[string]string) string {
+	for i := 0; i < maxLinks; i++ {
+		resolved := ""
+		parts := strings.Split(file, "/")
+		for j := len(parts); j > 0; j-- {
+			if target, found := links[path.Join(parts[:j]...)]; found {
+				resolved = path.Join(append([]string{target}, parts[j:]...)...)
+				break
+			}
+		}
+		if resolved == "" {
+			return file
+		}
+		file = resolved
+	}
+	return file
+}
+
+// parseDpkgStatus parses the installed packages of a dpkg status file.
+// Packages are separated by blank lines.
+func parseDpkgStatus(r io.Reader) []pkg {
+	var pkgs []pkg
+	var current pkg
+	installed := true
+
+	flush := func() {
+		if current.Name != "" && installed {
+			current.Type = "deb"
+			pkgs = append(pkgs, current)
+		}
+		current = pkg{}
+		installed = true
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		// Skip multi-line values, like descriptions.
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+		key, value := splitField(line, ":")
+		switch key {
+		case "Package":
+			current.Name = value
+		case "Version":
+			current.Version = value
+		case "Architecture":
+			current.Arch = value
+		case "Status":
+			// Distroless images don't record the status of their packages.
+			installed = strings.HasSuffix(value, " installed")
+		}
+	}
+	flush()
+	return pkgs
+}
+
+// parseApkInstalled parses the installed packages of an apk database.
+// Each line is a single letter key, a colon and a value. Packages are separated by blank lines.
+func parseApkInstalled(r io.Reader) []pkg {
+	var pkgs []pkg
+	var current pkg
+
+	flush := func() {
+		if current.Name != "" {
+			current.Type = "apk"
+			pkgs = append(pkgs, current)
+		}
+		current = pkg{}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		key, value := splitField(line, ":")
+		switch key {
+		case "P":
+			current.Name = value
+		case "V":
+			current.Version = value
+		case "A":
+			current.Arch = value
+		}
+	}
+	flush()
+	return pkgs
+}
+
+// parseOSReleaseID returns the ID of the distribution, found in /etc/os-release.
+func parseOSReleaseID(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value := splitField(scanner.Text(), "=")
+		if key == "ID" {
+			return strings.Trim(value, `"'`)
+		}
+	}
+	return ""
+}
+
+func splitField(line, sep string) (string, string) {
+	kv := strings.SplitN(line, sep, 2)
+	if len(kv) != 2 {
+		return kv[0], ""
+	}
+	return kv[0], strings.TrimSpace(kv[1])
+}
+
+// sbomDocument encodes the packages of an image in the given SBOM format.
+func sbomDocument(format, image, digest string, inv inventory, created time.Time) ([]byte, error) {
+	switch format {
+	case SPDX:
+		return json.MarshalIndent(spdxDocument(image, digest, inv, created), "", "  ")
+	case CycloneDX:
+		return json.MarshalIndent(cycloneDXDocument(image, digest, inv, created), "", "  ")
+	default:
+		return nil, fmt.Errorf("unsupported sbom format %q", format)
+	}
+}
+
+// SPDX 2.2, see https://spdx.github.io/spdx-spec/
+type spdx struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func spdxDocument(image, digest string, inv inventory, created time.Time) spdx {
+	doc := spdx{
+		SPDXVersion:       "SPDX-2.2",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              image,
+		DocumentNamespace: fmt.Sprintf("https://skaffold.dev/spdx/%s@%s", image, digest),
+		CreationInfo: spdxCreationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: skaffold-" + version.Get().Version},
+		},
+		Packages: []spdxPackage{{
+			SPDXID:           "SPDXRef-Image",
+			Name:             image,
+			VersionInfo:      digest,
+			DownloadLocation: "NOASSERTION",
+		}},
+		Relationships: []spdxRelationship{{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: "SPDXRef-Image",
+		}},
+	}
+
+	for i, p := range inv.packages {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i)
+		doc.Packages = append(doc.Packages, spdxPackage{
+			SPDXID:           id,
+			Name:             p.Name,
+			VersionInfo:      p.Version,
+			DownloadLocation: "NOASSERTION",
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  p.purl(inv.distro),
+			}},
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      "SPDXRef-Image",
+			RelationshipType:   "CONTAINS",
+			RelatedSPDXElement: id,
+		})
+	}
+	return doc
+}
+
+// CycloneDX 1.2, see https://cyclonedx.org/docs/1.2/
+type cycloneDX struct {
+	BOMFormat   string               `json:"bomFormat"`
+	SpecVersion string               `json:"specVersion"`
+	Version     int                  `json:"version"`
+	Metadata    cycloneDXMetadata    `json:"metadata"`
+	Components  []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Vendor  string `json:"vendor"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type cycloneDXComponent struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+func cycloneDXDocument(image, digest string, inv inventory, created time.Time) cycloneDX {
+	doc := cycloneDX{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.2",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: created.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Vendor: "Skaffold", Name: "skaffold", Version: version.Get().Version}},
+			Component: cycloneDXComponent{Type: "container", Name: image, Version: digest},
+		},
+		Components: []cycloneDXComponent{},
+	}
+
+	for _, p := range inv.packages {
+		doc.Components = append(doc.Components, cycloneDXComponent{
+			Type:    "library",
+			Name:    p.Name,
+			Version: p.Version,
+			PURL:    p.purl(inv.distro),
+		})
+	}
+	return doc
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attestation
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	debianStatus = `Package: base-files
+Status: install ok installed
+Version: 10.3+deb10u7
+Architecture: amd64
+Description: Debian base system miscellaneous files
+ This package contains the basic filesystem hierarchy.
+
+Package: removed
+Status: deinstall ok config-files
+Version: 1.0
+Architecture: amd64
+
+Package: tzdata
+Status: install ok installed
+Version: 2020d-0+deb10u1
+Architecture: all
+`
+	alpineInstalled = `C:Q1abc=
+P:musl
+V:1.2.2-r0
+A:x86_64
+
+P:busybox
+V:1.32.1-r0
+A:x86_64
+`
+)
+
+func TestParseDpkgStatus(t *testing.T) {
+	testutil.CheckDeepEqual(t, []pkg{
+		{Type: "deb", Name: "base-files", Version: "10.3+deb10u7", Arch: "amd64"},
+		{Type: "deb", Name: "tzdata", Version: "2020d-0+deb10u1", Arch: "all"},
+	}, parseDpkgStatus(strings.NewReader(debianStatus)))
+}
+
+func TestParseApkInstalled(t *testing.T) {
+	testutil.CheckDeepEqual(t, []pkg{
+		{Type: "apk", Name: "musl", Version: "1.2.2-r0", Arch: "x86_64"},
+		{Type: "apk", Name: "busybox", Version: "1.32.1-r0", Arch: "x86_64"},
+	}, parseApkInstalled(strings.NewReader(alpineInstalled)))
+}
+
+func TestListPackages(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		symlinks    map[string]string
+		expected    inventory
+	}{
+		{
+			description: "debian",
+			files: map[string]string{
+				"etc/os-release":      "PRETTY_NAME=\"Debian GNU/Linux 10 (buster)\"\nID=debian\n",
+				"var/lib/dpkg/status": debianStatus,
+			},
+			expected: inventory{distro: "debian", packages: []pkg{
+				{Type: "deb", Name: "base-files", Version: "10.3+deb10u7", Arch: "amd64"},
+				{Type: "deb", Name: "tzdata", Version: "2020d-0+deb10u1", Arch: "all"},
+			}},
+		},
+		{
+			description: "distroless",
+			files: map[string]string{
+				"etc/os-release":                  "ID=\"debian\"\n",
+				"var/lib/dpkg/status.d/libc6":     "Package: libc6\nVersion: 2.28-10\nArchitecture: amd64\n",
+				"var/lib/dpkg/status.d/netbase":   "Package: netbase\nVersion: 5.6\nArchitecture: all\n",
+				"var/lib/dpkg/status.d/unrelated": "",
+			},
+			expected: inventory{distro: "debian", packages: []pkg{
+				{Type: "deb", Name: "libc6", Version: "2.28-10", Arch: "amd64"},
+				{Type: "deb", Name: "netbase", Version: "5.6", Arch: "all"},
+			}},
+		},
+		{
+			description: "alpine",
+			files: map[string]string{
+				"etc/os-release":       "ID=alpine\n",
+				"lib/apk/db/installed": alpineInstalled,
+			},
+			expected: inventory{distro: "alpine", packages: []pkg{
+				{Type: "apk", Name: "busybox", Version: "1.32.1-r0", Arch: "x86_64"},
+				{Type: "apk", Name: "musl", Version: "1.2.2-r0", Arch: "x86_64"},
+			}},
+		},
+		{
+			description: "relative os-release symlink",
+			files:       map[string]string{"usr/lib/os-release": "ID=ubuntu\n"},
+			symlinks:    map[string]string{"etc/os-release": "../usr/lib/os-release"},
+			expected:    inventory{distro: "ubuntu"},
+		},
+		{
+			description: "absolute os-release symlink",
+			files:       map[string]string{"usr/lib/os-release": "ID=debian\n"},
+			symlinks:    map[string]string{"etc/os-release": "/usr/lib/os-release"},
+			expected:    inventory{distro: "debian"},
+		},
+		{
+			description: "symlinked directory",
+			files:       map[string]string{"usr/lib/os-release": "ID=debian\n"},
+			symlinks:    map[string]string{"etc/os-release": "/lib/os-release", "lib": "usr/lib"},
+			expected:    inventory{distro: "debian"},
+		},
+		{
+			description: "usr/lib/os-release fallback",
+			files:       map[string]string{"usr/lib/os-release": "ID=debian\n"},
+			expected:    inventory{distro: "debian"},
+		},
+		{
+			description: "symlink loop",
+			symlinks:    map[string]string{"etc/os-release": "os-release2", "etc/os-release2": "os-release"},
+			expected:    inventory{distro: "unknown"},
+		},
+		{
+			description: "scratch",
+			files:       map[string]string{"app": "binary"},
+			expected:    inventory{distro: "unknown"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			inv, err := listPackages(imageWithFiles(t, test.files, test.symlinks))
+
+			t.CheckErrorAndDeepEqual(false, err, test.expected, inv, cmp.AllowUnexported(inventory{}))
+		})
+	}
+}
+
+func TestSBOMDocument(t *testing.T) {
+	inv := inventory{distro: "debian", packages: []pkg{
+		{Type: "deb", Name: "tzdata", Version: "2020d-0+deb10u1", Arch: "all"},
+	}}
+	created := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	digest := "sha256:9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a"
+
+	tests := []struct {
+		description string
+		format      string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "spdx",
+			format:      SPDX,
+			expected: `{"spdxVersion":"SPDX-2.2","dataLicense":"CC0-1.0","SPDXID":"SPDXRef-DOCUMENT","name":"gcr.io/project/app",` +
+				`"documentNamespace":"https://skaffold.dev/spdx/gcr.io/project/app@` + digest + `",` +
+				`"creationInfo":{"created":"2021-01-02T03:04:05Z","creators":["Tool: skaffold-v1.17.0"]},` +
+				`"packages":[{"SPDXID":"SPDXRef-Image","name":"gcr.io/project/app","versionInfo":"` + digest + `","downloadLocation":"NOASSERTION","filesAnalyzed":false},` +
+				`{"SPDXID":"SPDXRef-Package-0","name":"tzdata","versionInfo":"2020d-0+deb10u1","downloadLocation":"NOASSERTION","filesAnalyzed":false,` +
+				`"externalRefs":[{"referenceCategory":"PACKAGE-MANAGER","referenceType":"purl","referenceLocator":"pkg:deb/debian/tzdata@2020d-0+deb10u1?arch=all"}]}],` +
+				`"relationships":[{"spdxElementId":"SPDXRef-DOCUMENT","relationshipType":"DESCRIBES","relatedSpdxElement":"SPDXRef-Image"},` +
+				`{"spdxElementId":"SPDXRef-Image","relationshipType":"CONTAINS","relatedSpdxElement":"SPDXRef-Package-0"}]}`,
+		},
+		{
+			description: "cyclonedx",
+			format:      CycloneDX,
+			expected: `{"bomFormat":"CycloneDX","specVersion":"1.2","version":1,` +
+				`"metadata":{"timestamp":"2021-01-02T03:04:05Z","tools":[{"vendor":"Skaffold","name":"skaffold","version":"v1.17.0"}],` +
+				`"component":{"type":"container","name":"gcr.io/project/app","version":"` + digest + `"}},` +
+				`"components":[{"type":"library","name":"tzdata","version":"2020d-0+deb10u1","purl":"pkg:deb/debian/tzdata@2020d-0+deb10u1?arch=all"}]}`,
+		},
+		{
+			description: "unknown format",
+			format:      "syft",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&version.Get, func() *version.Info { return &version.Info{Version: "v1.17.0"} })
+
+			doc, err := sbomDocument(test.format, "gcr.io/project/app", digest, inv, created)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				var compact bytes.Buffer
+				t.CheckNoError(json.Compact(&compact, doc))
+				t.CheckDeepEqual(test.expected, compact.String())
+			}
+		})
+	}
+}
+
+// imageWithFiles creates an image with a single layer holding the given files and symlinks.
+func imageWithFiles(t *testutil.T, files map[string]string, symlinks map[string]string) v1.Image {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	// the links come before their targets
+	for name, target := range symlinks {
+		t.CheckNoError(tw.WriteHeader(&tar.Header{Name: name, Linkname: target, Mode: 0777, Typeflag: tar.TypeSymlink}))
+	}
+	for name, content := range files {
+		t.CheckNoError(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		t.CheckNoError(err)
+	}
+	t.CheckNoError(tw.Close())
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	t.CheckNoError(err)
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	t.CheckNoError(err)
+	return img
+}
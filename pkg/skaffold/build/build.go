@@ -28,6 +28,12 @@ import (
 type Artifact struct {
 	ImageName string `json:"imageName"`
 	Tag       string `json:"tag"`
+	// Platforms lists the target platforms of the image.
+	// When more than one platform is listed, the tag references a manifest list.
+	Platforms []string `json:"platforms,omitempty"`
+	// Signature references the image holding the signature of the pushed image,
+	// e.g. `gcr.io/project/app:sha256-<hex>.sig@sha256:<hex>`.
+	Signature string `json:"signature,omitempty"`
 }
 
 // ArtifactGraph is a map of [artifact image : artifact definition]
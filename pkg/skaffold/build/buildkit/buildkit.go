@@ -0,0 +1,237 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
+)
+
+var (
+	// invalidTagChars matches the characters that can't be used in an image tag.
+	invalidTagChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+	buildctlBinaryCheck = buildctlBinaryExists // For testing
+)
+
+// Build builds an artifact with `buildctl`. Images are either pushed to their registry,
+// in which case their digest is returned, or exported to an OCI tarball.
+func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
+	if !buildctlBinaryCheck() {
+		return "", errors.New("buildctl not found on the PATH: building with a BuildKit daemon requires the buildctl client from https://github.com/moby/buildkit/releases")
+	}
+
+	// Fail fast if the Dockerfile can't be found.
+	dockerfile, err := docker.NormalizeDockerfilePath(a.Workspace, a.DockerArtifact.DockerfilePath)
+	if err != nil {
+		return "", fmt.Errorf("normalizing dockerfile path: %w", err)
+	}
+	if _, err := os.Stat(dockerfile); os.IsNotExist(err) {
+		return "", fmt.Errorf("dockerfile not found for %q: %w", a.ImageName, err)
+	}
+
+	buildArgs, err := docker.EvalBuildArgs(b.mode, a.Workspace, a.DockerArtifact.DockerfilePath, a.DockerArtifact.BuildArgs, docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true))
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+
+	var tarball string
+	if !b.pushImages {
+		tarball = TarballPath(b.buildkit, a.ImageName)
+		if err := os.MkdirAll(filepath.Dir(tarball), 0755); err != nil {
+			return "", fmt.Errorf("creating output directory: %w", err)
+		}
+	}
+
+	args, err := b.buildctlArgs(a, dockerfile, tag, buildArgs, tarball)
+	if err != nil {
+		return "", err
+	}
+
+	cmd := exec.CommandContext(ctx, "buildctl", args...)
+	cmd.Stdout = color.GetWriter(out)
+	cmd.Stderr = color.GetWriter(out)
+	if err := util.RunCmd(cmd); err != nil {
+		return "", fmt.Errorf("running buildctl build: %w", err)
+	}
+
+	if b.pushImages {
+		return docker.RemoteDigest(tag, b.cfg)
+	}
+	return ociDigest(tarball)
+}
+
+func (b *Builder) buildctlArgs(a *latest.Artifact, dockerfile, tag string, buildArgs map[string]*string, tarball string) ([]string, error) {
+	var args []string
+	if b.buildkit.Address != "" {
+		args = append(args, "--addr", b.buildkit.Address)
+	}
+	args = append(args, "build",
+		"--frontend", "dockerfile.v0",
+		"--local", "context="+a.Workspace,
+		"--local", "dockerfile="+filepath.Dir(dockerfile),
+		"--opt", "filename="+filepath.Base(dockerfile),
+		"--progress", "plain")
+
+	d := a.DockerArtifact
+	if d.Target != "" {
+		args = append(args, "--opt", "target="+d.Target)
+	}
+	var keys []string
+	for k := range buildArgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		// Like with `docker build`, build args without a value are read from the environment.
+		v := buildArgs[k]
+		if v == nil {
+			value, found := os.LookupEnv(k)
+			if !found {
+				continue
+			}
+			v = &value
+		}
+		args = append(args, "--opt", fmt.Sprintf("build-arg:%s=%s", k, *v))
+	}
+	if len(a.Platforms) > 0 {
+		args = append(args, "--opt", "platform="+strings.Join(a.Platforms, ","))
+	}
+	if mode := strings.ToLower(d.NetworkMode); mode == "host" || mode == "none" {
+		args = append(args, "--opt", "force-network-mode="+mode)
+	}
+	if d.NoCache {
+		args = append(args, "--no-cache")
+	}
+	if d.Squash {
+		warnings.Printf("squash is not supported by buildkit, ignoring it for %s", a.ImageName)
+	}
+	if d.Secret != nil {
+		secret := "id=" + d.Secret.ID
+		if d.Secret.Source != "" {
+			secret += ",src=" + d.Secret.Source
+		}
+		args = append(args, "--secret", secret)
+	}
+	if d.SSH != "" {
+		args = append(args, "--ssh", d.SSH)
+	}
+
+	for _, from := range d.CacheFrom {
+		args = append(args, "--import-cache", "type=registry,ref="+from)
+	}
+	if b.buildkit.CacheRepo != "" {
+		ref := b.buildkit.CacheRepo + ":" + cacheTag(a.ImageName)
+		export := "type=registry,ref=" + ref
+		if b.buildkit.CacheMode != "" {
+			export += ",mode=" + b.buildkit.CacheMode
+		}
+		args = append(args, "--import-cache", "type=registry,ref="+ref, "--export-cache", export)
+	}
+
+	if tarball != "" {
+		args = append(args, "--output", fmt.Sprintf("type=oci,name=%s,dest=%s", tag, tarball))
+		return args, nil
+	}
+
+	output := fmt.Sprintf("type=image,name=%s,push=true", tag)
+	ref, err := docker.ParseReference(tag)
+	if err != nil {
+		return nil, fmt.Errorf("parsing image name %q: %w", tag, err)
+	}
+	if b.cfg.GetInsecureRegistries()[ref.Domain] {
+		output += ",registry.insecure=true"
+	}
+	return append(args, "--output", output), nil
+}
+
+// Check for existence of buildctl binary in user's PATH
+func buildctlBinaryExists() bool {
+	_, err := exec.LookPath("buildctl")
+
+	return err == nil
+}
+
+// TarballPath returns the path of the OCI tarball an image is exported to when it's not pushed.
+func TarballPath(buildkit latest.BuildKitConfig, imageName string) string {
+	dir := buildkit.OutputDir
+	if dir == "" {
+		dir = defaultOutputDir
+	}
+	return filepath.Join(dir, strings.NewReplacer("/", "_", ":", "_").Replace(imageName)+".tar")
+}
+
+// cacheTag returns the tag under which the build cache of an image is stored.
+func cacheTag(imageName string) string {
+	tag := invalidTagChars.ReplaceAllString(imageName, "_")
+	if len(tag) > 128 {
+		tag = tag[len(tag)-128:]
+	}
+	return tag
+}
+
+// ociDigest reads the digest of the image stored in an OCI tarball.
+func ociDigest(tarball string) (string, error) {
+	f, err := os.Open(tarball)
+	if err != nil {
+		return "", fmt.Errorf("opening OCI tarball: %w", err)
+	}
+	defer f.Close()
+
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return "", fmt.Errorf("no index.json found in %s", tarball)
+		}
+		if err != nil {
+			return "", fmt.Errorf("reading OCI tarball: %w", err)
+		}
+		if filepath.Clean(hdr.Name) != "index.json" {
+			continue
+		}
+
+		var index struct {
+			Manifests []struct {
+				Digest string `json:"digest"`
+			} `json:"manifests"`
+		}
+		if err := json.NewDecoder(tr).Decode(&index); err != nil {
+			return "", fmt.Errorf("parsing index.json: %w", err)
+		}
+		if len(index.Manifests) == 0 {
+			return "", errors.New("no image found in OCI tarball")
+		}
+		return index.Manifests[0].Digest, nil
+	}
+}
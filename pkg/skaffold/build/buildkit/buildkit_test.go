@@ -0,0 +1,257 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	insecureRegistries    map[string]bool
+}
+
+func (c *mockConfig) GetInsecureRegistries() map[string]bool { return c.insecureRegistries }
+
+type mockArtifactResolver struct {
+	m map[string]string
+}
+
+func (r mockArtifactResolver) GetImageTag(imageName string) (string, bool) {
+	val, found := r.m[imageName]
+	return val, found
+}
+
+func TestBuildctlArgs(t *testing.T) {
+	tests := []struct {
+		description        string
+		buildkit           latest.BuildKitConfig
+		artifact           *latest.DockerArtifact
+		platforms          []string
+		buildArgs          map[string]*string
+		tarball            string
+		insecureRegistries map[string]bool
+		expected           []string
+	}{
+		{
+			description: "push",
+			artifact:    &latest.DockerArtifact{},
+			expected: []string{"build", "--frontend", "dockerfile.v0", "--local", "context=workspace", "--local", "dockerfile=workspace", "--opt", "filename=Dockerfile", "--progress", "plain",
+				"--output", "type=image,name=gcr.io/project/app:tag,push=true"},
+		},
+		{
+			description:        "push to insecure registry",
+			artifact:           &latest.DockerArtifact{},
+			insecureRegistries: map[string]bool{"gcr.io": true},
+			expected: []string{"build", "--frontend", "dockerfile.v0", "--local", "context=workspace", "--local", "dockerfile=workspace", "--opt", "filename=Dockerfile", "--progress", "plain",
+				"--output", "type=image,name=gcr.io/project/app:tag,push=true,registry.insecure=true"},
+		},
+		{
+			description: "OCI tarball",
+			artifact:    &latest.DockerArtifact{},
+			tarball:     "out/app.tar",
+			expected: []string{"build", "--frontend", "dockerfile.v0", "--local", "context=workspace", "--local", "dockerfile=workspace", "--opt", "filename=Dockerfile", "--progress", "plain",
+				"--output", "type=oci,name=gcr.io/project/app:tag,dest=out/app.tar"},
+		},
+		{
+			description: "all options",
+			buildkit:    latest.BuildKitConfig{Address: "tcp://buildkitd:1234", CacheRepo: "gcr.io/project/cache", CacheMode: "max"},
+			artifact: &latest.DockerArtifact{
+				Target:      "prod",
+				NetworkMode: "Host",
+				NoCache:     true,
+				Secret:      &latest.DockerSecret{ID: "token", Source: "token.txt"},
+				SSH:         "default",
+				CacheFrom:   []string{"gcr.io/project/app:latest"},
+			},
+			platforms: []string{"linux/amd64", "linux/arm64"},
+			buildArgs: map[string]*string{"VERSION": util.StringPtr("1.0"), "FROM_ENV": nil, "UNSET": nil},
+			expected: []string{"--addr", "tcp://buildkitd:1234", "build", "--frontend", "dockerfile.v0", "--local", "context=workspace", "--local", "dockerfile=workspace", "--opt", "filename=Dockerfile", "--progress", "plain",
+				"--opt", "target=prod",
+				"--opt", "build-arg:FROM_ENV=value",
+				"--opt", "build-arg:VERSION=1.0",
+				"--opt", "platform=linux/amd64,linux/arm64",
+				"--opt", "force-network-mode=host",
+				"--no-cache",
+				"--secret", "id=token,src=token.txt",
+				"--ssh", "default",
+				"--import-cache", "type=registry,ref=gcr.io/project/app:latest",
+				"--import-cache", "type=registry,ref=gcr.io/project/cache:gcr.io_project_app",
+				"--export-cache", "type=registry,ref=gcr.io/project/cache:gcr.io_project_app,mode=max",
+				"--output", "type=image,name=gcr.io/project/app:tag,push=true"},
+		},
+		{
+			description: "bridge network is the default",
+			artifact:    &latest.DockerArtifact{NetworkMode: "bridge"},
+			expected: []string{"build", "--frontend", "dockerfile.v0", "--local", "context=workspace", "--local", "dockerfile=workspace", "--opt", "filename=Dockerfile", "--progress", "plain",
+				"--output", "type=image,name=gcr.io/project/app:tag,push=true"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{"FROM_ENV": "value"})
+			b := NewArtifactBuilder(test.buildkit, true, config.RunModes.Build, &mockConfig{insecureRegistries: test.insecureRegistries}, nil)
+			a := &latest.Artifact{
+				ImageName:    "gcr.io/project/app",
+				Workspace:    "workspace",
+				Platforms:    test.platforms,
+				ArtifactType: latest.ArtifactType{DockerArtifact: test.artifact},
+			}
+
+			args, err := b.buildctlArgs(a, filepath.Join("workspace", "Dockerfile"), "gcr.io/project/app:tag", test.buildArgs, test.tarball)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, args)
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	testutil.Run(t, "push", func(t *testutil.T) {
+		tmp := t.NewTempDir().Write("Dockerfile", "FROM scratch")
+		t.Override(&buildctlBinaryCheck, func() bool { return true })
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, extra map[string]*string) (map[string]*string, error) {
+			return extra, nil
+		})
+		t.Override(&docker.RemoteDigest, func(identifier string, _ docker.Config) (string, error) {
+			return "sha256:pushed", nil
+		})
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("buildctl build --frontend dockerfile.v0 --local context="+tmp.Root()+" --local dockerfile="+tmp.Root()+" --opt filename=Dockerfile --progress plain --opt build-arg:BASE=gcr.io/project/base:1 --output type=image,name=gcr.io/project/app:tag,push=true"))
+
+		b := NewArtifactBuilder(latest.BuildKitConfig{}, true, config.RunModes.Build, &mockConfig{}, mockArtifactResolver{m: map[string]string{"base": "gcr.io/project/base:1"}})
+		digest, err := b.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+			ImageName:    "gcr.io/project/app",
+			Workspace:    tmp.Root(),
+			Dependencies: []*latest.ArtifactDependency{{ImageName: "base", Alias: "BASE"}},
+			ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"}},
+		}, "gcr.io/project/app:tag")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("sha256:pushed", digest)
+	})
+
+	testutil.Run(t, "OCI tarball", func(t *testutil.T) {
+		tmp := t.NewTempDir().Write("Dockerfile", "FROM scratch")
+		out := tmp.Path("out")
+		t.Override(&buildctlBinaryCheck, func() bool { return true })
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+			return args, nil
+		})
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("buildctl --addr unix:///run/buildkit/buildkitd.sock build --frontend dockerfile.v0 --local context="+tmp.Root()+" --local dockerfile="+tmp.Root()+" --opt filename=Dockerfile --progress plain --output type=oci,name=gcr.io/project/app:tag,dest="+filepath.Join(out, "gcr.io_project_app.tar")))
+		// The fake buildctl doesn't write anything, so the tarball is created upfront.
+		tmp.Mkdir("out")
+		writeTarball(t, filepath.Join(out, "gcr.io_project_app.tar"), `{"schemaVersion":2,"manifests":[{"digest":"sha256:exported"}]}`)
+
+		b := NewArtifactBuilder(latest.BuildKitConfig{Address: "unix:///run/buildkit/buildkitd.sock", OutputDir: out}, false, config.RunModes.Build, &mockConfig{}, nil)
+		digest, err := b.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+			ImageName:    "gcr.io/project/app",
+			Workspace:    tmp.Root(),
+			ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"}},
+		}, "gcr.io/project/app:tag")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("sha256:exported", digest)
+	})
+
+	testutil.Run(t, "missing Dockerfile", func(t *testutil.T) {
+		tmp := t.NewTempDir()
+		t.Override(&buildctlBinaryCheck, func() bool { return true })
+
+		b := NewArtifactBuilder(latest.BuildKitConfig{}, true, config.RunModes.Build, &mockConfig{}, nil)
+		_, err := b.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+			ImageName:    "gcr.io/project/app",
+			Workspace:    tmp.Root(),
+			ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"}},
+		}, "gcr.io/project/app:tag")
+
+		t.CheckErrorContains("dockerfile not found", err)
+	})
+
+	testutil.Run(t, "missing buildctl", func(t *testutil.T) {
+		tmp := t.NewTempDir().Write("Dockerfile", "FROM scratch")
+		t.Override(&buildctlBinaryCheck, func() bool { return false })
+
+		b := NewArtifactBuilder(latest.BuildKitConfig{}, true, config.RunModes.Build, &mockConfig{}, nil)
+		_, err := b.Build(context.Background(), ioutil.Discard, &latest.Artifact{
+			ImageName:    "gcr.io/project/app",
+			Workspace:    tmp.Root(),
+			ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"}},
+		}, "gcr.io/project/app:tag")
+
+		t.CheckErrorContains("buildctl not found", err)
+	})
+}
+
+func TestOCIDigest(t *testing.T) {
+	tests := []struct {
+		description string
+		index       string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "single image",
+			index:       `{"schemaVersion":2,"manifests":[{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"sha256:abc"}]}`,
+			expected:    "sha256:abc",
+		},
+		{
+			description: "no image",
+			index:       `{"schemaVersion":2,"manifests":[]}`,
+			shouldErr:   true,
+		},
+		{
+			description: "invalid index",
+			index:       `not json`,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tarball := filepath.Join(t.NewTempDir().Root(), "image.tar")
+			writeTarball(t, tarball, test.index)
+
+			digest, err := ociDigest(tarball)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, digest)
+		})
+	}
+}
+
+func writeTarball(t *testutil.T, path, index string) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	t.CheckNoError(tw.WriteHeader(&tar.Header{Name: "oci-layout", Mode: 0644, Size: 2}))
+	_, err := tw.Write([]byte("{}"))
+	t.CheckNoError(err)
+	t.CheckNoError(tw.WriteHeader(&tar.Header{Name: "index.json", Mode: 0644, Size: int64(len(index))}))
+	_, err = tw.Write([]byte(index))
+	t.CheckNoError(err)
+	t.CheckNoError(tw.Close())
+	t.CheckNoError(ioutil.WriteFile(path, buf.Bytes(), 0644))
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// defaultOutputDir is where images are exported when they are not pushed.
+const defaultOutputDir = ".skaffold/oci"
+
+// Builder is an artifact builder that uses a standalone BuildKit daemon
+type Builder struct {
+	buildkit   latest.BuildKitConfig
+	pushImages bool
+	mode       config.RunMode
+	cfg        docker.Config
+	artifacts  ArtifactResolver
+}
+
+// ArtifactResolver provides an interface to resolve built artifact tags by image name.
+type ArtifactResolver interface {
+	GetImageTag(imageName string) (string, bool)
+}
+
+// NewArtifactBuilder returns a new instance of a BuildKit builder
+func NewArtifactBuilder(buildkit latest.BuildKitConfig, pushImages bool, mode config.RunMode, cfg docker.Config, r ArtifactResolver) *Builder {
+	return &Builder{
+		buildkit:   buildkit,
+		pushImages: pushImages,
+		mode:       mode,
+		cfg:        cfg,
+		artifacts:  r,
+	}
+}
@@ -56,6 +56,7 @@ type cache struct {
 	imagesAreLocal   bool
 	tryImportMissing bool
 	lister           DependencyLister
+	remoteStore      RemoteStore
 }
 
 // DependencyLister fetches a list of dependencies for an artifact
@@ -66,6 +67,7 @@ type Config interface {
 
 	CacheArtifacts() bool
 	CacheFile() string
+	RemoteCache() string
 	Mode() config.RunMode
 }
 
@@ -92,6 +94,15 @@ func NewCache(cfg Config, imagesAreLocal bool, tryImportMissing bool, dependenci
 		return nil, fmt.Errorf("getting local Docker client: %w", err)
 	}
 
+	remoteStore, err := newRemoteStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if remoteStore != nil && imagesAreLocal {
+		logrus.Warnln("Images are not pushed to a remote registry, ignoring remote cache")
+		remoteStore = nil
+	}
+
 	return &cache{
 		artifactCache:    artifactCache,
 		artifactGraph:    graph,
@@ -102,6 +113,7 @@ func NewCache(cfg Config, imagesAreLocal bool, tryImportMissing bool, dependenci
 		imagesAreLocal:   imagesAreLocal,
 		tryImportMissing: tryImportMissing,
 		lister:           dependencies,
+		remoteStore:      remoteStore,
 	}, nil
 }
 
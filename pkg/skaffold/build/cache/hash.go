@@ -26,6 +26,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -65,6 +66,12 @@ func newArtifactHasher(artifacts build.ArtifactGraph, lister DependencyLister, m
 	}
 }
 
+// ArtifactHash returns the hash of the inputs of an artifact and of its required artifacts.
+// This is the key of the artifact in the cache.
+func ArtifactHash(ctx context.Context, artifacts build.ArtifactGraph, lister DependencyLister, mode config.RunMode, a *latest.Artifact) (string, error) {
+	return newArtifactHasherFunc(artifacts, lister, mode).hash(ctx, a)
+}
+
 func (h *artifactHasherImpl) hash(ctx context.Context, a *latest.Artifact) (string, error) {
 	hash, err := h.safeHash(ctx, a)
 	if err != nil {
@@ -143,9 +150,24 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 	if args != nil {
 		inputs = append(inputs, args...)
 	}
+
+	// add the target platforms for the artifact if specified
+	if platforms := hashPlatforms(a); platforms != "" {
+		inputs = append(inputs, platforms)
+	}
 	return encode(inputs)
 }
 
+// hashPlatforms returns the sorted list of target platforms for the artifact.
+func hashPlatforms(a *latest.Artifact) string {
+	if len(a.Platforms) == 0 {
+		return ""
+	}
+	platforms := append([]string{}, a.Platforms...)
+	sort.Strings(platforms)
+	return "platforms=" + strings.Join(platforms, ",")
+}
+
 func encode(inputs []string) (string, error) {
 	// get a key for the hashes
 	hasher := sha256.New()
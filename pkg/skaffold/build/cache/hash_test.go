@@ -146,6 +146,20 @@ func TestGetHashForArtifact(t *testing.T) {
 			mode:     config.RunModes.Debug,
 			expected: "c3a878f799b2a6532db71683a09771af4f9d20ef5884c57642a272934e5c93ea",
 		},
+		{
+			description:  "target platforms",
+			dependencies: []string{"a", "b"},
+			artifact:     &latest.Artifact{Platforms: []string{"linux/amd64", "linux/arm64"}},
+			mode:         config.RunModes.Dev,
+			expected:     "f63559844a53f3f62dd9780ef8777c65d6afd292f0987b92d1e131b2443f68c9",
+		},
+		{
+			description:  "target platforms in different orders",
+			dependencies: []string{"a", "b"},
+			artifact:     &latest.Artifact{Platforms: []string{"linux/arm64", "linux/amd64"}},
+			mode:         config.RunModes.Dev,
+			expected:     "f63559844a53f3f62dd9780ef8777c65d6afd292f0987b92d1e131b2443f68c9",
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -60,8 +60,11 @@ func (c *cache) lookup(ctx context.Context, a *latest.Artifact, tag string, h ar
 	c.cacheMutex.RUnlock()
 	if !cacheHit {
 		if entry, err = c.tryImport(ctx, a, tag, hash); err != nil {
-			logrus.Debugf("Could not import artifact from Docker, building instead (%s)", err)
-			return needsBuilding{hash: hash}
+			logrus.Debugf("Could not import artifact from Docker (%s)", err)
+			if entry, err = c.tryRemoteStore(ctx, tag, hash); err != nil {
+				logrus.Debugf("Could not find artifact in remote cache, building instead (%s)", err)
+				return needsBuilding{hash: hash}
+			}
 		}
 	}
 
@@ -156,3 +159,22 @@ func (c *cache) tryImport(ctx context.Context, a *latest.Artifact, tag string, h
 	c.cacheMutex.Unlock()
 	return entry, nil
 }
+
+// tryRemoteStore looks up the digest of an image built by someone else for the same hash.
+func (c *cache) tryRemoteStore(ctx context.Context, tag string, hash string) (ImageDetails, error) {
+	if c.remoteStore == nil {
+		return ImageDetails{}, fmt.Errorf("remote cache disabled")
+	}
+
+	digest, err := c.remoteStore.Get(ctx, tag, hash)
+	if err != nil {
+		return ImageDetails{}, err
+	}
+	logrus.Debugf("Found digest %s for %s in remote cache", digest, tag)
+
+	entry := ImageDetails{Digest: digest}
+	c.cacheMutex.Lock()
+	c.artifactCache[hash] = entry
+	c.cacheMutex.Unlock()
+	return entry, nil
+}
@@ -143,6 +143,7 @@ func TestLookupRemote(t *testing.T) {
 		hasher      artifactHasher
 		cache       map[string]ImageDetails
 		api         *testutil.FakeAPIClient
+		remoteStore RemoteStore
 		expected    cacheDetails
 	}{
 		{
@@ -191,6 +192,21 @@ func TestLookupRemote(t *testing.T) {
 			api:      &testutil.FakeAPIClient{},
 			expected: needsBuilding{hash: "hash"},
 		},
+		{
+			description: "found in remote cache",
+			hasher:      mockHasher{"hash"},
+			cache:       map[string]ImageDetails{},
+			remoteStore: mockRemoteStore{"hash": "otherdigest"},
+			expected:    needsRemoteTagging{hash: "hash", tag: "tag", digest: "otherdigest"},
+		},
+		{
+			description: "not found in remote cache",
+			hasher:      mockHasher{"hash"},
+			cache:       map[string]ImageDetails{},
+			api:         &testutil.FakeAPIClient{},
+			remoteStore: mockRemoteStore{"otherhash": "otherdigest"},
+			expected:    needsBuilding{hash: "hash"},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -210,6 +226,7 @@ func TestLookupRemote(t *testing.T) {
 				artifactCache:  test.cache,
 				client:         fakeLocalDaemon(test.api),
 				cfg:            &mockConfig{mode: config.RunModes.Build},
+				remoteStore:    test.remoteStore,
 			}
 			t.Override(&newArtifactHasherFunc, func(_ build.ArtifactGraph, _ DependencyLister, _ config.RunMode) artifactHasher { return test.hasher })
 			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latest.Artifact{{
@@ -240,6 +257,20 @@ func (f failingHasher) hash(context.Context, *latest.Artifact) (string, error) {
 	return "", f.err
 }
 
+type mockRemoteStore map[string]string
+
+func (m mockRemoteStore) Get(_ context.Context, _, hash string) (string, error) {
+	if digest, found := m[hash]; found {
+		return digest, nil
+	}
+	return "", errors.New("not found")
+}
+
+func (m mockRemoteStore) Put(_ context.Context, _, hash, digest string) error {
+	m[hash] = digest
+	return nil
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -108,6 +108,7 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		alreadyBuilt = append(alreadyBuilt, build.Artifact{
 			ImageName: artifact.ImageName,
 			Tag:       uniqueTag,
+			Platforms: artifact.Platforms,
 		})
 	}
 
@@ -149,7 +150,17 @@ func maintainArtifactOrder(built []build.Artifact, artifacts []*latest.Artifact)
 func (c *cache) addArtifacts(ctx context.Context, bRes []build.Artifact, hashByName map[string]string) error {
 	for _, a := range bRes {
 		entry := ImageDetails{}
-		if c.imagesAreLocal {
+		ref, err := docker.ParseReference(a.Tag)
+		if err != nil {
+			return fmt.Errorf("parsing reference %q: %w", a.Tag, err)
+		}
+
+		switch {
+		case c.imagesAreLocal && ref.Digest != "":
+			// Images exported to an OCI tarball are referenced by digest and are not in the Docker daemon.
+			entry.Digest = ref.Digest
+
+		case c.imagesAreLocal:
 			imageID, err := c.client.ImageID(ctx, a.Tag)
 			if err != nil {
 				return err
@@ -158,12 +169,15 @@ func (c *cache) addArtifacts(ctx context.Context, bRes []build.Artifact, hashByN
 			if imageID != "" {
 				entry.ID = imageID
 			}
-		} else {
-			ref, err := docker.ParseReference(a.Tag)
-			if err != nil {
-				return fmt.Errorf("parsing reference %q: %w", a.Tag, err)
-			}
+
+		default:
 			entry.Digest = ref.Digest
+
+			if c.remoteStore != nil {
+				if err := c.remoteStore.Put(ctx, a.Tag, hashByName[a.ImageName], ref.Digest); err != nil {
+					logrus.Warnf("Unable to share %s in remote cache: %v", a.ImageName, err)
+				}
+			}
 		}
 		c.cacheMutex.Lock()
 		c.artifactCache[hashByName[a.ImageName]] = entry
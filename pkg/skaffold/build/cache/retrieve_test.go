@@ -338,9 +338,11 @@ func TestCacheFindMissing(t *testing.T) {
 type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	cacheFile             string
+	remoteCache           string
 	mode                  config.RunMode
 }
 
 func (c *mockConfig) CacheArtifacts() bool { return true }
 func (c *mockConfig) CacheFile() string    { return c.cacheFile }
+func (c *mockConfig) RemoteCache() string  { return c.remoteCache }
 func (c *mockConfig) Mode() config.RunMode { return c.mode }
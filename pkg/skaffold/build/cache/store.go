@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// RegistryStore is the `--remote-cache` value to store cache entries in the image registry.
+	RegistryStore = "registry"
+
+	// cacheTagPrefix prefixes the tags used to record artifact hashes in the image registry.
+	cacheTagPrefix = "skaffold-cache-"
+)
+
+// RemoteStore shares the mapping between artifact hashes and image digests
+// so that images built by someone else can be found in the remote registry.
+type RemoteStore interface {
+	// Get returns the digest of the image built for the given hash in the repository of `image`.
+	Get(ctx context.Context, image, hash string) (string, error)
+
+	// Put records the digest of the image built for the given hash in the repository of `image`.
+	Put(ctx context.Context, image, hash, digest string) error
+}
+
+// newRemoteStore creates the RemoteStore selected with `--remote-cache`, if any.
+func newRemoteStore(cfg Config) (RemoteStore, error) {
+	store := cfg.RemoteCache()
+	switch {
+	case store == "":
+		return nil, nil
+	case store == RegistryStore:
+		return &registryStore{cfg: cfg}, nil
+	case util.IsURL(store):
+		return &httpStore{url: strings.TrimSuffix(store, "/"), client: http.DefaultClient}, nil
+	default:
+		return nil, fmt.Errorf("invalid remote cache %q: should be %q or an http(s) URL", store, RegistryStore)
+	}
+}
+
+// registryStore records artifact hashes as tags in the repository of each image.
+type registryStore struct {
+	cfg docker.Config
+}
+
+func (s *registryStore) Get(_ context.Context, image, hash string) (string, error) {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	return docker.RemoteDigest(cacheTag(ref, hash), s.cfg)
+}
+
+func (s *registryStore) Put(_ context.Context, image, hash, digest string) error {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return err
+	}
+	return docker.AddRemoteTag(ref.BaseName+"@"+digest, cacheTag(ref, hash), s.cfg)
+}
+
+func cacheTag(ref *docker.ImageReference, hash string) string {
+	return ref.BaseName + ":" + cacheTagPrefix + hash
+}
+
+// httpStore records artifact hashes on an HTTP file server, at `<url>/<image repository>/<hash>`.
+// The server is expected to serve files with `GET` and to store them with `PUT`.
+type httpStore struct {
+	url    string
+	client *http.Client
+}
+
+func (s *httpStore) Get(ctx context.Context, image, hash string) (string, error) {
+	entry, err := s.entryURL(image, hash)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, entry, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", errors.New("not found in remote cache")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("getting %s: %s", entry, resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	digest := strings.TrimSpace(string(body))
+	if !strings.HasPrefix(digest, "sha256:") {
+		return "", fmt.Errorf("invalid digest %q in remote cache", digest)
+	}
+	return digest, nil
+}
+
+func (s *httpStore) Put(ctx context.Context, image, hash, digest string) error {
+	entry, err := s.entryURL(image, hash)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, entry, strings.NewReader(digest))
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("putting %s: %s", entry, resp.Status)
+	}
+	return nil
+}
+
+func (s *httpStore) entryURL(image, hash string) (string, error) {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	return s.url + "/" + url.PathEscape(ref.BaseName) + "/" + hash, nil
+}
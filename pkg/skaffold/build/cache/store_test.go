@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestNewRemoteStore(t *testing.T) {
+	tests := []struct {
+		description string
+		remoteCache string
+		expected    RemoteStore
+		shouldErr   bool
+	}{
+		{
+			description: "disabled",
+		},
+		{
+			description: "registry",
+			remoteCache: "registry",
+			expected:    &registryStore{},
+		},
+		{
+			description: "http",
+			remoteCache: "https://cache.example.com/skaffold/",
+			expected:    &httpStore{url: "https://cache.example.com/skaffold", client: http.DefaultClient},
+		},
+		{
+			description: "invalid",
+			remoteCache: "somewhere",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			store, err := newRemoteStore(&mockConfig{remoteCache: test.remoteCache})
+
+			t.CheckError(test.shouldErr, err)
+			if rs, ok := store.(*registryStore); ok {
+				rs.cfg = nil
+			}
+			t.CheckDeepEqual(test.expected, store, cmp.AllowUnexported(registryStore{}, httpStore{}))
+		})
+	}
+}
+
+func TestRegistryStoreGet(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&docker.RemoteDigest, func(identifier string, _ docker.Config) (string, error) {
+			if identifier == "gcr.io/team/app:skaffold-cache-thehash" {
+				return "sha256:abc", nil
+			}
+			return "", errors.New("unknown remote tag")
+		})
+		store := &registryStore{cfg: &mockConfig{}}
+
+		digest, err := store.Get(context.Background(), "gcr.io/team/app:v1", "thehash")
+		t.CheckNoError(err)
+		t.CheckDeepEqual("sha256:abc", digest)
+
+		_, err = store.Get(context.Background(), "gcr.io/team/app:v1", "otherhash")
+		t.CheckError(true, err)
+	})
+}
+
+func TestHTTPStore(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var mutex sync.Mutex
+		files := map[string]string{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			switch r.Method {
+			case http.MethodGet:
+				content, found := files[r.URL.EscapedPath()]
+				if !found {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(content))
+			case http.MethodPut:
+				content, _ := ioutil.ReadAll(r.Body)
+				files[r.URL.EscapedPath()] = string(content)
+				w.WriteHeader(http.StatusCreated)
+			}
+		}))
+		defer server.Close()
+
+		store, err := newRemoteStore(&mockConfig{remoteCache: server.URL + "/cache"})
+		t.CheckNoError(err)
+
+		_, err = store.Get(context.Background(), "gcr.io/team/app:v1", "thehash")
+		t.CheckError(true, err)
+
+		digest := "sha256:9b5b6f6ea4a3ac3e8b0c7a6b0ab4b0e0b3b7c5d0e6e9bb1cd1e8d0f9a4b1c2d3"
+		err = store.Put(context.Background(), "gcr.io/team/app:v1@"+digest, "thehash", digest)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(map[string]string{"/cache/gcr.io%2Fteam%2Fapp/thehash": digest}, files)
+
+		found, err := store.Get(context.Background(), "gcr.io/team/app:v2", "thehash")
+		t.CheckNoError(err)
+		t.CheckDeepEqual(digest, found)
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
@@ -37,7 +38,13 @@ import (
 
 const initContainer = "kaniko-init-container"
 
-func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest.KanikoArtifact, tag string, requiredImages map[string]*string) (string, error) {
+// for testing
+var (
+	createManifestList = docker.CreateManifestList
+	runKanikoPod       = (*Builder).runKanikoPod
+)
+
+func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest.KanikoArtifact, tag string, platform string, requiredImages map[string]*string) (string, error) {
 	generatedEnvs, err := generateEnvFromImage(tag)
 	if err != nil {
 		return "", fmt.Errorf("error processing generated env variables from image uri: %w", err)
@@ -64,6 +71,11 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 	if err != nil {
 		return "", err
 	}
+	if platform != "" {
+		if err := addPlatformNodeSelector(podSpec, platform); err != nil {
+			return "", err
+		}
+	}
 
 	pod, err := pods.Create(ctx, podSpec, metav1.CreateOptions{})
 	if err != nil {
@@ -77,21 +89,61 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 		}
 	}()
 
-	if err := b.copyKanikoBuildContext(ctx, workspace, artifactName, artifact, pods, pod.Name); err != nil {
-		return "", fmt.Errorf("copying sources: %w", err)
+	if err := runKanikoPod(b, ctx, out, workspace, artifactName, artifact, pods, pod.Name); err != nil {
+		return "", err
+	}
+
+	return docker.RemoteDigest(tag, b.cfg)
+}
+
+// runKanikoPod uploads the build context to a kaniko pod and waits for the build to complete.
+func (b *Builder) runKanikoPod(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest.KanikoArtifact, pods corev1.PodInterface, podName string) error {
+	if err := b.copyKanikoBuildContext(ctx, workspace, artifactName, artifact, pods, podName); err != nil {
+		return fmt.Errorf("copying sources: %w", err)
 	}
 
 	// Wait for the pods to succeed while streaming the logs
-	waitForLogs := streamLogs(ctx, out, pod.Name, pods)
+	waitForLogs := streamLogs(ctx, out, podName, pods)
+	defer waitForLogs()
 
-	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
-		waitForLogs()
-		return "", err
+	return kubernetes.WaitForPodSucceeded(ctx, pods, podName, b.timeout)
+}
+
+// buildWithKanikoForPlatforms builds the artifact in one kaniko pod per target platform,
+// each scheduled on nodes of that platform, and pushes a manifest list referencing all the images.
+func (b *Builder) buildWithKanikoForPlatforms(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, requiredImages map[string]*string) (string, error) {
+	var images []docker.PlatformImage
+	for _, platform := range a.Platforms {
+		platformTag, err := platformTag(tag, platform)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(out, "Building %s for platform %s\n", a.ImageName, platform)
+		digest, err := b.buildWithKaniko(ctx, out, a.Workspace, a.ImageName, a.KanikoArtifact, platformTag, platform, requiredImages)
+		if err != nil {
+			return "", fmt.Errorf("building for platform %q: %w", platform, err)
+		}
+
+		images = append(images, docker.PlatformImage{
+			Platform: platform,
+			Image:    platformTag + "@" + digest,
+		})
 	}
 
-	waitForLogs()
+	return createManifestList(tag, images, b.cfg)
+}
 
-	return docker.RemoteDigest(tag, b.cfg)
+// platformTag returns the tag of the single platform image, eg. `image:tag-linux-arm64`.
+func platformTag(tag string, platform string) (string, error) {
+	ref, err := docker.ParseReference(tag)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+	if ref.Tag == "" {
+		ref.Tag = "latest"
+	}
+	return fmt.Sprintf("%s:%s-%s", ref.BaseName, ref.Tag, strings.ReplaceAll(platform, "/", "-")), nil
 }
 
 // first copy over the buildcontext tarball into the init container tmp dir via kubectl cp
@@ -17,10 +17,21 @@ limitations under the License.
 package cluster
 
 import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -96,3 +107,132 @@ func TestEnvInterpolation_Latest(t *testing.T) {
 	}
 	testutil.CheckElementsMatch(t, expected, actual)
 }
+
+func TestPlatformTag(t *testing.T) {
+	tests := []struct {
+		description string
+		tag         string
+		platform    string
+		expected    string
+	}{
+		{
+			description: "tagged image",
+			tag:         "gcr.io/project/image:v1",
+			platform:    "linux/arm64",
+			expected:    "gcr.io/project/image:v1-linux-arm64",
+		},
+		{
+			description: "latest image",
+			tag:         "gcr.io/project/image",
+			platform:    "linux/amd64",
+			expected:    "gcr.io/project/image:latest-linux-amd64",
+		},
+		{
+			description: "platform with variant",
+			tag:         "localhost:5000/image:v1",
+			platform:    "linux/arm/v7",
+			expected:    "localhost:5000/image:v1-linux-arm-v7",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			actual, err := platformTag(test.tag, test.platform)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
+func TestBuildWithKanikoForPlatforms(t *testing.T) {
+	tests := []struct {
+		description    string
+		failedPlatform string
+		expectedImages []docker.PlatformImage
+		expectedPods   []map[string]string
+		shouldErr      bool
+	}{
+		{
+			description: "one pod per platform",
+			expectedImages: []docker.PlatformImage{
+				{Platform: "linux/amd64", Image: "gcr.io/project/image:v1-linux-amd64@sha256:linux-amd64"},
+				{Platform: "linux/arm64", Image: "gcr.io/project/image:v1-linux-arm64@sha256:linux-arm64"},
+			},
+			expectedPods: []map[string]string{
+				{"kubernetes.io/os": "linux", "kubernetes.io/arch": "amd64"},
+				{"kubernetes.io/os": "linux", "kubernetes.io/arch": "arm64"},
+			},
+		},
+		{
+			description:    "failed platform build",
+			failedPlatform: "linux/arm64",
+			expectedPods: []map[string]string{
+				{"kubernetes.io/os": "linux", "kubernetes.io/arch": "amd64"},
+				{"kubernetes.io/os": "linux", "kubernetes.io/arch": "arm64"},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset()
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+			t.Override(&docker.EvalBuildArgsWithEnv, func(config.RunMode, string, string, map[string]*string, map[string]*string, map[string]string) (map[string]*string, error) {
+				return nil, nil
+			})
+			t.Override(&docker.RemoteDigest, func(tag string, _ docker.Config) (string, error) {
+				ref, err := docker.ParseReference(tag)
+				if err != nil {
+					return "", err
+				}
+				return "sha256:" + ref.Tag[len("v1-"):], nil
+			})
+
+			var nodeSelectors []map[string]string
+			t.Override(&runKanikoPod, func(_ *Builder, ctx context.Context, _ io.Writer, _ string, _ string, _ *latest.KanikoArtifact, pods corev1.PodInterface, podName string) error {
+				pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+				if err != nil {
+					return err
+				}
+				nodeSelectors = append(nodeSelectors, pod.Spec.NodeSelector)
+				if pod.Spec.NodeSelector["kubernetes.io/arch"] == "arm64" && test.failedPlatform == "linux/arm64" {
+					return errors.New("build failed")
+				}
+				return nil
+			})
+
+			var images []docker.PlatformImage
+			t.Override(&createManifestList, func(tag string, platformImages []docker.PlatformImage, _ docker.Config) (string, error) {
+				t.CheckDeepEqual("gcr.io/project/image:v1", tag)
+				images = platformImages
+				return "sha256:list", nil
+			})
+
+			builder := &Builder{
+				cfg:            &mockConfig{},
+				ClusterDetails: &latest.ClusterDetails{Namespace: "ns"},
+			}
+			artifact := &latest.Artifact{
+				ImageName: "image",
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+				ArtifactType: latest.ArtifactType{
+					KanikoArtifact: &latest.KanikoArtifact{Image: "kaniko", DockerfilePath: "Dockerfile"},
+				},
+			}
+
+			digest, err := builder.buildWithKanikoForPlatforms(context.Background(), ioutil.Discard, artifact, "gcr.io/project/image:v1", nil)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedImages, images)
+			if !test.shouldErr {
+				t.CheckDeepEqual("sha256:list", digest)
+			}
+			t.CheckDeepEqual(test.expectedPods, nodeSelectors)
+
+			// the kaniko pods are deleted after each build
+			remaining, err := client.CoreV1().Pods("ns").List(context.Background(), metav1.ListOptions{})
+			t.CheckNoError(err)
+			t.CheckDeepEqual(0, len(remaining.Items))
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 )
@@ -190,6 +191,21 @@ func addHostPathVolume(pod *v1.Pod, name, mountPath, path string) {
 	})
 }
 
+// addPlatformNodeSelector schedules the pod on nodes of the given `os/arch` platform.
+func addPlatformNodeSelector(pod *v1.Pod, platform string) error {
+	p, err := docker.ParsePlatform(platform)
+	if err != nil {
+		return err
+	}
+
+	if pod.Spec.NodeSelector == nil {
+		pod.Spec.NodeSelector = map[string]string{}
+	}
+	pod.Spec.NodeSelector[v1.LabelOSStable] = p.OS
+	pod.Spec.NodeSelector[v1.LabelArchStable] = p.Architecture
+	return nil
+}
+
 func resourceRequirements(rr *latest.ResourceRequirements) v1.ResourceRequirements {
 	req := v1.ResourceRequirements{}
 
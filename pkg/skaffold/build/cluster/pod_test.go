@@ -434,3 +434,43 @@ func TestResourceRequirements(t *testing.T) {
 		})
 	}
 }
+
+func TestAddPlatformNodeSelector(t *testing.T) {
+	tests := []struct {
+		description string
+		platform    string
+		initial     map[string]string
+		expected    map[string]string
+		shouldErr   bool
+	}{
+		{
+			description: "no node selector",
+			platform:    "linux/arm64",
+			expected:    map[string]string{"kubernetes.io/os": "linux", "kubernetes.io/arch": "arm64"},
+		},
+		{
+			description: "existing node selector",
+			platform:    "linux/arm/v7",
+			initial:     map[string]string{"pool": "builds"},
+			expected:    map[string]string{"pool": "builds", "kubernetes.io/os": "linux", "kubernetes.io/arch": "arm"},
+		},
+		{
+			description: "invalid platform",
+			platform:    "arm64",
+			shouldErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pod := &v1.Pod{Spec: v1.PodSpec{NodeSelector: test.initial}}
+
+			err := addPlatformNodeSelector(pod, test.platform)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, pod.Spec.NodeSelector)
+			}
+		})
+	}
+}
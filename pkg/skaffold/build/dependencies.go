@@ -19,12 +19,14 @@ package build
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/bazel"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -78,5 +80,23 @@ func DependenciesForArtifact(ctx context.Context, a *latest.Artifact, cfg docker
 		return nil, err
 	}
 
-	return util.AbsolutePaths(a.Workspace, paths), nil
+	return withoutLogFiles(util.AbsolutePaths(a.Workspace, paths)), nil
+}
+
+// withoutLogFiles removes the container logs written with `--log-files` from a list of dependencies.
+// Otherwise, each log line would trigger a new build when the logs are written inside a workspace.
+func withoutLogFiles(paths []string) []string {
+	logsDir, err := filepath.Abs(filepath.Join(constants.DefaultSkaffoldDir, constants.DefaultLogsDir))
+	if err != nil {
+		return paths
+	}
+
+	var list []string
+	for _, path := range paths {
+		if abs, err := filepath.Abs(path); err == nil && util.IsSubPath(logsDir, abs) {
+			continue
+		}
+		list = append(list, path)
+	}
+	return list
 }
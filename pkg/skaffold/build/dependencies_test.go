@@ -0,0 +1,46 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDependenciesForArtifactIgnoreLogFiles(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.NewTempDir().
+			Write("main.go", "package main").
+			Write(".skaffold/logs/pod/container.log", "hello").
+			Chdir()
+
+		deps, err := DependenciesForArtifact(context.Background(), &latest.Artifact{
+			Workspace: ".",
+			ArtifactType: latest.ArtifactType{
+				CustomArtifact: &latest.CustomArtifact{
+					Dependencies: &latest.CustomDependencies{Paths: []string{"."}},
+				},
+			},
+		}, nil, nil)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"main.go"}, deps)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -45,6 +46,10 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 	}
 	opts := docker.BuildOptions{Tag: tag, Mode: b.mode, ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true)}
 
+	if len(a.Platforms) > 0 {
+		return b.buildxBuild(ctx, color.GetWriter(out), a, dockerfile, opts)
+	}
+
 	var imageID string
 
 	if b.useCLI || b.useBuildKit {
@@ -67,19 +72,9 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 }
 
 func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace string, dockerfilePath string, a *latest.DockerArtifact, opts docker.BuildOptions) (string, error) {
-	args := []string{"build", workspace, "--file", dockerfilePath, "-t", opts.Tag}
-	ba, err := docker.EvalBuildArgs(b.mode, workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs)
-	if err != nil {
-		return "", fmt.Errorf("unable to evaluate build args: %w", err)
-	}
-	cliArgs, err := docker.ToCLIBuildArgs(a, ba)
+	args, err := b.cliBuildArgs(workspace, dockerfilePath, a, opts)
 	if err != nil {
-		return "", fmt.Errorf("getting docker build args: %w", err)
-	}
-	args = append(args, cliArgs...)
-
-	if b.prune {
-		args = append(args, "--force-rm")
+		return "", err
 	}
 
 	cmd := exec.CommandContext(ctx, "docker", args...)
@@ -97,6 +92,59 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace s
 	return b.localDocker.ImageID(ctx, opts.Tag)
 }
 
+// buildxBuild builds the artifact for its target platforms with `docker buildx`.
+// Images built for more than one platform can't be loaded in the local daemon,
+// so they are pushed as a manifest list directly by buildx.
+func (b *Builder) buildxBuild(ctx context.Context, out io.Writer, a *latest.Artifact, dockerfilePath string, opts docker.BuildOptions) (string, error) {
+	if len(a.Platforms) > 1 && !b.pushImages {
+		return "", multiPlatformWithoutPushErr(a.ImageName, a.Platforms)
+	}
+
+	args, err := b.cliBuildArgs(a.Workspace, dockerfilePath, a.DockerArtifact, opts)
+	if err != nil {
+		return "", err
+	}
+	args = append([]string{"buildx"}, args...)
+	args = append(args, "--platform", strings.Join(a.Platforms, ","))
+	if b.pushImages {
+		args = append(args, "--push")
+	} else {
+		args = append(args, "--load")
+	}
+
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Env = append(util.OSEnviron(), b.localDocker.ExtraEnv()...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	if err := util.RunCmd(cmd); err != nil {
+		return "", newBuildError(fmt.Errorf("running buildx build: %w", err))
+	}
+
+	if b.pushImages {
+		return docker.RemoteDigest(opts.Tag, b.cfg)
+	}
+	return b.localDocker.ImageID(ctx, opts.Tag)
+}
+
+func (b *Builder) cliBuildArgs(workspace string, dockerfilePath string, a *latest.DockerArtifact, opts docker.BuildOptions) ([]string, error) {
+	args := []string{"build", workspace, "--file", dockerfilePath, "-t", opts.Tag}
+	ba, err := docker.EvalBuildArgs(b.mode, workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	cliArgs, err := docker.ToCLIBuildArgs(a, ba)
+	if err != nil {
+		return nil, fmt.Errorf("getting docker build args: %w", err)
+	}
+	args = append(args, cliArgs...)
+
+	if b.prune {
+		args = append(args, "--force-rm")
+	}
+	return args, nil
+}
+
 func (b *Builder) pullCacheFromImages(ctx context.Context, out io.Writer, a *latest.DockerArtifact) error {
 	if len(a.CacheFrom) == 0 {
 		return nil
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/docker/docker/api/types"
@@ -126,6 +127,63 @@ func TestDockerCLIBuild(t *testing.T) {
 	}
 }
 
+func TestBuildxBuild(t *testing.T) {
+	tests := []struct {
+		description string
+		platforms   []string
+		pushImages  bool
+		expectedCmd string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "single platform loaded in the local daemon",
+			platforms:   []string{"linux/arm64"},
+			expectedCmd: "docker buildx build . --file DOCKERFILE -t tag --platform linux/arm64 --load",
+		},
+		{
+			description: "multiple platforms pushed as a manifest list",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			pushImages:  true,
+			expectedCmd: "docker buildx build . --file DOCKERFILE -t tag --platform linux/amd64,linux/arm64 --push",
+			expected:    "sha256:manifestlist",
+		},
+		{
+			description: "multiple platforms without push",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			shouldErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("Dockerfile").Chdir()
+			dockerfilePath, _ := filepath.Abs("Dockerfile")
+			t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+				return args, nil
+			})
+			t.Override(&docker.DefaultAuthHelper, stubAuth{})
+			t.Override(&docker.RemoteDigest, func(string, docker.Config) (string, error) { return "sha256:manifestlist", nil })
+			t.Override(&util.DefaultExecCommand, testutil.CmdRun(strings.ReplaceAll(test.expectedCmd, "DOCKERFILE", dockerfilePath)))
+
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(nil), false, false, test.pushImages, false, config.RunModes.Build, nil, mockArtifactResolver{})
+			artifact := &latest.Artifact{
+				Workspace: ".",
+				Platforms: test.platforms,
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+					},
+				},
+			}
+
+			digest, err := builder.Build(context.Background(), ioutil.Discard, artifact, "tag")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, digest)
+		})
+	}
+}
+
 func fakeLocalDaemonWithExtraEnv(extraEnv []string) docker.LocalDaemon {
 	return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, extraEnv, false, nil)
 }
@@ -83,3 +83,17 @@ func jibToolErr(err error) error {
 			},
 		})
 }
+
+func multiPlatformWithoutPushErr(artifact string, platforms []string) error {
+	return sErrors.NewError(fmt.Errorf("building %q for platforms %v requires pushing the images", artifact, platforms),
+		proto.ActionableErr{
+			Message: fmt.Sprintf("artifact %s can't be built for multiple platforms without pushing it to a registry", artifact),
+			ErrCode: proto.StatusCode_BUILD_PLATFORM_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_FIX_PLATFORMS_CONFIG,
+					Action:         "Push the images with `local.push: true`, or build for a single platform with the `--platform` flag.",
+				},
+			},
+		})
+}
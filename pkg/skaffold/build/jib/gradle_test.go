@@ -345,6 +345,7 @@ func TestGenerateGradleBuildArgs(t *testing.T) {
 		in                 latest.JibArtifact
 		deps               []*latest.ArtifactDependency
 		image              string
+		platforms          []string
 		skipTests          bool
 		pushImages         bool
 		r                  ArtifactResolver
@@ -358,6 +359,7 @@ func TestGenerateGradleBuildArgs(t *testing.T) {
 		{description: "multi module without tests with insecure registries", in: latest.JibArtifact{Project: "project"}, image: "registry.tld/image", skipTests: true, insecureRegistries: map[string]bool{"registry.tld": true}, out: []string{"fake-gradleBuildArgs-for-project-for-testTask-skipTests", "-Djib.allowInsecureRegistries=true", "--image=registry.tld/image"}},
 		{description: "single module with custom base image", in: latest.JibArtifact{BaseImage: "docker://busybox"}, image: "image", out: []string{"fake-gradleBuildArgs-for-testTask", "-Djib.from.image=docker://busybox", "--image=image"}},
 		{description: "multi module with custom base image", in: latest.JibArtifact{Project: "project", BaseImage: "docker://busybox"}, image: "image", out: []string{"fake-gradleBuildArgs-for-project-for-testTask", "-Djib.from.image=docker://busybox", "--image=image"}},
+		{description: "single module with target platforms", image: "image", platforms: []string{"linux/amd64", "linux/arm64"}, out: []string{"fake-gradleBuildArgs-for-testTask", "-Djib.from.platforms=linux/amd64,linux/arm64", "--image=image"}},
 		{
 			description: "single module with local base image from required artifacts",
 			in:          latest.JibArtifact{BaseImage: "alias"},
@@ -395,7 +397,7 @@ func TestGenerateGradleBuildArgs(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&gradleBuildArgsFunc, getGradleBuildArgsFuncFake(t, MinimumJibGradleVersion))
-			command := GenerateGradleBuildArgs("testTask", test.image, &test.in, test.skipTests, test.pushImages, test.deps, test.r, test.insecureRegistries, false)
+			command := GenerateGradleBuildArgs("testTask", test.image, &test.in, test.platforms, test.skipTests, test.pushImages, test.deps, test.r, test.insecureRegistries, false)
 			t.CheckDeepEqual(test.out, command)
 		})
 	}
@@ -348,3 +348,11 @@ func baseImageArg(a *latest.JibArtifact, r ArtifactResolver, deps []*latest.Arti
 	}
 	return fmt.Sprintf("-Djib.from.image=%s", a.BaseImage), true
 }
+
+// platformsArg returns the Jib flag selecting the platforms of the base image to build from.
+func platformsArg(platforms []string) (string, bool) {
+	if len(platforms) == 0 {
+		return "", false
+	}
+	return fmt.Sprintf("-Djib.from.platforms=%s", strings.Join(platforms, ",")), true
+}
@@ -331,6 +331,7 @@ func TestGenerateMavenBuildArgs(t *testing.T) {
 		deps               []*latest.ArtifactDependency
 		image              string
 		r                  ArtifactResolver
+		platforms          []string
 		skipTests          bool
 		pushImages         bool
 		insecureRegistries map[string]bool
@@ -343,6 +344,7 @@ func TestGenerateMavenBuildArgs(t *testing.T) {
 		{description: "multi module without tests with insecure-registry", a: latest.JibArtifact{Project: "module"}, image: "registry.tld/image", skipTests: true, insecureRegistries: map[string]bool{"registry.tld": true}, out: []string{"fake-mavenBuildArgs-for-module-for-test-goal-skipTests", "-Djib.allowInsecureRegistries=true", "-Dimage=registry.tld/image"}},
 		{description: "single module with custom base image", a: latest.JibArtifact{BaseImage: "docker://busybox"}, image: "image", out: []string{"fake-mavenBuildArgs-for-test-goal", "-Djib.from.image=docker://busybox", "-Dimage=image"}},
 		{description: "multi module with custom base image", a: latest.JibArtifact{Project: "module", BaseImage: "docker://busybox"}, image: "image", out: []string{"fake-mavenBuildArgs-for-module-for-test-goal", "-Djib.from.image=docker://busybox", "-Dimage=image"}},
+		{description: "single module with target platforms", image: "image", platforms: []string{"linux/amd64", "linux/arm64"}, out: []string{"fake-mavenBuildArgs-for-test-goal", "-Djib.from.platforms=linux/amd64,linux/arm64", "-Dimage=image"}},
 		{
 			description: "single module with local base image from required artifacts",
 			a:           latest.JibArtifact{BaseImage: "alias"},
@@ -381,7 +383,7 @@ func TestGenerateMavenBuildArgs(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&mavenBuildArgsFunc, getMavenBuildArgsFuncFake(t, MinimumJibMavenVersion))
-			args := GenerateMavenBuildArgs("test-goal", test.image, &test.a, test.skipTests, test.pushImages, test.deps, test.r, test.insecureRegistries, false)
+			args := GenerateMavenBuildArgs("test-goal", test.image, &test.a, test.platforms, test.skipTests, test.pushImages, test.deps, test.r, test.insecureRegistries, false)
 			t.CheckDeepEqual(test.out, args)
 		})
 	}
@@ -61,6 +61,11 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 		return "", err
 	}
 
+	if b.usesBuildKit(a) {
+		// BuildKit returns the digest of the image, whether it's pushed or exported to a tarball.
+		return build.TagWithDigest(tag, digestOrImageID), nil
+	}
+
 	if b.pushImages {
 		// only track images for pruning when building with docker
 		// if we're pushing a bazel image, it was built directly to the registry
@@ -84,7 +89,7 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 }
 
 func (b *Builder) runBuildForArtifact(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
-	if !b.pushImages {
+	if !b.pushImages && !b.usesBuildKit(a) {
 		// All of the builders will rely on a local Docker:
 		// + Either to build the image,
 		// + Or to docker load it.
@@ -27,6 +27,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/bazel"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildkit"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	dockerbuilder "github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/docker"
@@ -336,6 +337,7 @@ func TestGetArtifactBuilder(t *testing.T) {
 	tests := []struct {
 		description string
 		artifact    *latest.Artifact
+		buildkit    *latest.BuildKitConfig
 		expected    string
 		shouldErr   bool
 	}{
@@ -349,6 +351,28 @@ func TestGetArtifactBuilder(t *testing.T) {
 			},
 			expected: "docker",
 		},
+		{
+			description: "buildkit builder",
+			artifact: &latest.Artifact{
+				ImageName: "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{
+					DockerArtifact: &latest.DockerArtifact{},
+				},
+			},
+			buildkit: &latest.BuildKitConfig{},
+			expected: "buildkit",
+		},
+		{
+			description: "jib builder with buildkit",
+			artifact: &latest.Artifact{
+				ImageName: "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{
+					JibArtifact: &latest.JibArtifact{},
+				},
+			},
+			buildkit: &latest.BuildKitConfig{},
+			expected: "jib",
+		},
 		{
 			description: "jib builder",
 			artifact: &latest.Artifact{
@@ -402,6 +426,7 @@ func TestGetArtifactBuilder(t *testing.T) {
 			b, err := NewBuilder(&mockConfig{
 				local: latest.LocalBuild{
 					Concurrency: &constants.DefaultLocalConcurrency,
+					BuildKit:    test.buildkit,
 				},
 			})
 			t.CheckNoError(err)
@@ -413,6 +438,8 @@ func TestGetArtifactBuilder(t *testing.T) {
 			switch builder.(type) {
 			case *dockerbuilder.Builder:
 				t.CheckDeepEqual(test.expected, "docker")
+			case *buildkit.Builder:
+				t.CheckDeepEqual(test.expected, "buildkit")
 			case *bazel.Builder:
 				t.CheckDeepEqual(test.expected, "bazel")
 			case *buildpacks.Builder:
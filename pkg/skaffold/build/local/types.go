@@ -25,6 +25,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/bazel"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildkit"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	dockerbuilder "github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/docker"
@@ -119,6 +120,18 @@ func (b *Builder) TryImportMissing() bool {
 	return b.tryImportMissing
 }
 
+// ImagesAreLocal tells whether the built images are only available locally.
+// When they are not pushed, images built by a standalone BuildKit daemon are
+// exported to OCI tarballs instead of the local Docker daemon.
+func (b *Builder) ImagesAreLocal() bool {
+	return !b.pushImages
+}
+
+// usesBuildKit tells whether an artifact is built by a standalone BuildKit daemon.
+func (b *Builder) usesBuildKit(a *latest.Artifact) bool {
+	return a.DockerArtifact != nil && b.local.BuildKit != nil
+}
+
 // Prune uses the docker API client to remove all images built with Skaffold
 func (b *Builder) Prune(ctx context.Context, out io.Writer) error {
 	var toPrune []string
@@ -142,8 +155,11 @@ type artifactBuilder interface {
 // newPerArtifactBuilder returns an instance of `artifactBuilder`
 func newPerArtifactBuilder(b *Builder, a *latest.Artifact) (artifactBuilder, error) {
 	switch {
+	case b.usesBuildKit(a):
+		return buildkit.NewArtifactBuilder(*b.local.BuildKit, b.pushImages, b.cfg.Mode(), b.cfg, b.artifactStore), nil
+
 	case a.DockerArtifact != nil:
-		return dockerbuilder.NewArtifactBuilder(b.localDocker, b.local.UseDockerCLI, b.local.UseBuildkit, b.pushImages, b.prune, b.cfg.Mode(), b.cfg.GetInsecureRegistries(), b.artifactStore), nil
+		return dockerbuilder.NewArtifactBuilder(b.localDocker, b.local.UseDockerCLI, b.local.UseBuildkit, b.pushImages, b.prune, b.cfg.Mode(), b.cfg, b.artifactStore), nil
 
 	case a.BazelArtifact != nil:
 		return bazel.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages), nil
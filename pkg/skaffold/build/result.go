@@ -169,7 +169,7 @@ func (ba *artifactStoreImpl) GetArtifacts(s []*latest.Artifact) ([]Artifact, err
 		if !found {
 			return nil, fmt.Errorf("failed to retrieve build result for image %s", a.ImageName)
 		}
-		builds = append(builds, Artifact{ImageName: a.ImageName, Tag: t})
+		builds = append(builds, Artifact{ImageName: a.ImageName, Tag: t, Platforms: a.Platforms})
 	}
 	return builds, nil
 }
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+const (
+	payloadMediaType    = "application/vnd.dev.cosign.simplesigning.v1+json"
+	signatureAnnotation = "dev.cosignproject.cosign/signature"
+	signatureType       = "cosign container image signature"
+)
+
+// for testing
+var (
+	retrieveRemoteImage = docker.RetrieveRemoteImage
+	remoteDigest        = docker.RemoteDigest
+	appendAttachment    = docker.AppendAttachment
+)
+
+type Config interface {
+	docker.Config
+
+	Pipeline() latest.Pipeline
+}
+
+// Signer signs the pushed images.
+type Signer struct {
+	cfg         Config
+	key         *ecdsa.PrivateKey
+	annotations map[string]string
+}
+
+// payload is the simple signing payload signed by cosign.
+type payload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]string `json:"optional"`
+}
+
+// NewSigner returns a Signer, or nil if images are not signed.
+func NewSigner(cfg Config, imagesAreLocal bool) (*Signer, error) {
+	sc := cfg.Pipeline().Build.Sign
+	if sc == nil {
+		return nil, nil
+	}
+	if imagesAreLocal {
+		logrus.Warnln("Images are not pushed to a remote registry, skipping image signing")
+		return nil, nil
+	}
+
+	key, err := loadPrivateKey(sc.Key)
+	if err != nil {
+		return nil, fmt.Errorf("loading signing key: %w", err)
+	}
+	return &Signer{
+		cfg:         cfg,
+		key:         key,
+		annotations: sc.Annotations,
+	}, nil
+}
+
+// Sign signs the digest of each pushed image and records the reference of its signature.
+func (s *Signer) Sign(out io.Writer, builds []build.Artifact) ([]build.Artifact, error) {
+	fmt.Fprintln(out, "Signing images...")
+
+	signed := make([]build.Artifact, len(builds))
+	for i, b := range builds {
+		signature, err := s.sign(b.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("signing %q: %w", b.Tag, err)
+		}
+		fmt.Fprintf(out, " - %s -> %s\n", b.ImageName, signature)
+
+		signed[i] = b
+		signed[i].Signature = signature
+	}
+	return signed, nil
+}
+
+func (s *Signer) sign(tag string) (string, error) {
+	digest, err := imageDigest(tag, s.cfg)
+	if err != nil {
+		return "", err
+	}
+	sigTag, err := docker.AttachmentTag(tag, digest, "sig")
+	if err != nil {
+		return "", err
+	}
+	p, err := s.payload(tag, digest)
+	if err != nil {
+		return "", err
+	}
+
+	if sigDigest, found := s.existingSignature(sigTag, p); found {
+		logrus.Debugf("%s is already signed", tag)
+		return sigTag + "@" + sigDigest, nil
+	}
+
+	h := sha256.Sum256(p)
+	sig, err := ecdsa.SignASN1(rand.Reader, s.key, h[:])
+	if err != nil {
+		return "", err
+	}
+
+	sigDigest, err := appendAttachment(sigTag, p, payloadMediaType, map[string]string{
+		signatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	}, s.cfg)
+	if err != nil {
+		return "", err
+	}
+	return sigTag + "@" + sigDigest, nil
+}
+
+func (s *Signer) payload(tag, digest string) ([]byte, error) {
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	if err != nil {
+		return nil, fmt.Errorf("parsing reference %q: %w", tag, err)
+	}
+
+	var p payload
+	p.Critical.Identity.DockerReference = ref.Context().Name()
+	p.Critical.Image.DockerManifestDigest = digest
+	p.Critical.Type = signatureType
+	p.Optional = s.annotations
+	return json.Marshal(p)
+}
+
+// existingSignature looks for a valid signature of the same payload made with the same key.
+// Signing again would add a new signature to the signature image on every build.
+func (s *Signer) existingSignature(sigTag string, p []byte) (string, bool) {
+	img, err := retrieveRemoteImage(sigTag, s.cfg)
+	if err != nil {
+		return "", false
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return "", false
+	}
+
+	payloadDigest, _, _ := v1.SHA256(bytes.NewReader(p))
+	h := sha256.Sum256(p)
+	for _, l := range manifest.Layers {
+		if l.Digest != payloadDigest {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(l.Annotations[signatureAnnotation])
+		if err != nil {
+			continue
+		}
+		if ecdsa.VerifyASN1(&s.key.PublicKey, h[:], sig) {
+			d, err := img.Digest()
+			if err != nil {
+				return "", false
+			}
+			return d.String(), true
+		}
+	}
+	return "", false
+}
+
+// imageDigest returns the digest of a pushed image.
+func imageDigest(tag string, cfg docker.Config) (string, error) {
+	if parts := strings.SplitN(tag, "@", 2); len(parts) == 2 {
+		return parts[1], nil
+	}
+	return remoteDigest(tag, cfg)
+}
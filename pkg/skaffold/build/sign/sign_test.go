@@ -0,0 +1,291 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const testDigest = "sha256:9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a"
+
+type mockConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	sign                  *latest.SignConfig
+}
+
+func (c *mockConfig) Pipeline() latest.Pipeline {
+	var pipeline latest.Pipeline
+	pipeline.Build.Sign = c.sign
+	return pipeline
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	testutil.CheckError(t, false, err)
+	ecDER, err := x509.MarshalECPrivateKey(key)
+	testutil.CheckError(t, false, err)
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(key)
+	testutil.CheckError(t, false, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	testutil.CheckError(t, false, err)
+	rsaDER, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	testutil.CheckError(t, false, err)
+
+	tests := []struct {
+		description string
+		block       *pem.Block
+		password    string
+		shouldErr   bool
+	}{
+		{
+			description: "ec private key",
+			block:       &pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDER},
+		},
+		{
+			description: "pkcs8 private key",
+			block:       &pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER},
+		},
+		{
+			description: "cosign encrypted key",
+			block:       &pem.Block{Type: cosignKeyType, Bytes: encrypt(t, pkcs8DER, "s3cr3t")},
+			password:    "s3cr3t",
+		},
+		{
+			description: "wrong password",
+			block:       &pem.Block{Type: cosignKeyType, Bytes: encrypt(t, pkcs8DER, "s3cr3t")},
+			password:    "wrong",
+			shouldErr:   true,
+		},
+		{
+			description: "rsa key",
+			block:       &pem.Block{Type: "PRIVATE KEY", Bytes: rsaDER},
+			shouldErr:   true,
+		},
+		{
+			description: "unsupported type",
+			block:       &pem.Block{Type: "CERTIFICATE", Bytes: []byte("cert")},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{passwordEnvVar: test.password})
+			tmpDir := t.NewTempDir().Write("cosign.key", string(pem.EncodeToMemory(test.block)))
+
+			loaded, err := loadPrivateKey(tmpDir.Path("cosign.key"))
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckTrue(key.Equal(loaded))
+			}
+		})
+	}
+}
+
+func TestNewSigner(t *testing.T) {
+	tests := []struct {
+		description    string
+		sign           *latest.SignConfig
+		imagesAreLocal bool
+		expected       bool
+		shouldErr      bool
+	}{
+		{
+			description: "not configured",
+		},
+		{
+			description:    "local images",
+			sign:           &latest.SignConfig{Key: "cosign.key"},
+			imagesAreLocal: true,
+		},
+		{
+			description: "missing key",
+			sign:        &latest.SignConfig{Key: "missing.key"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			signer, err := NewSigner(&mockConfig{sign: test.sign}, test.imagesAreLocal)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expected, signer != nil)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	testutil.CheckError(t, false, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	testutil.CheckError(t, false, err)
+
+	expectedPayload := `{"critical":{"identity":{"docker-reference":"gcr.io/project/app"},"image":{"docker-manifest-digest":"` + testDigest + `"},` +
+		`"type":"cosign container image signature"},"optional":{"team":"backend"}}`
+	sigTag := "gcr.io/project/app:sha256-9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a.sig"
+
+	tests := []struct {
+		description    string
+		tag            string
+		existingSigner *ecdsa.PrivateKey
+		expectedPushed bool
+	}{
+		{
+			description:    "new signature",
+			tag:            "gcr.io/project/app:v1@" + testDigest,
+			expectedPushed: true,
+		},
+		{
+			description:    "digest resolved from the registry",
+			tag:            "gcr.io/project/app:v1",
+			expectedPushed: true,
+		},
+		{
+			description:    "already signed",
+			tag:            "gcr.io/project/app:v1@" + testDigest,
+			existingSigner: key,
+		},
+		{
+			description:    "signed with another key",
+			tag:            "gcr.io/project/app:v1@" + testDigest,
+			existingSigner: otherKey,
+			expectedPushed: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&remoteDigest, func(string, docker.Config) (string, error) { return testDigest, nil })
+			t.Override(&retrieveRemoteImage, func(tag string, _ docker.Config) (v1.Image, error) {
+				t.CheckDeepEqual(sigTag, tag)
+				if test.existingSigner == nil {
+					return nil, errors.New("not found")
+				}
+				return signatureImage(t, test.existingSigner, []byte(expectedPayload))
+			})
+			pushed := false
+			t.Override(&appendAttachment, func(tag string, content []byte, mediaType types.MediaType, annotations map[string]string, _ docker.Config) (string, error) {
+				pushed = true
+				t.CheckDeepEqual(sigTag, tag)
+				t.CheckDeepEqual(expectedPayload, string(content))
+				t.CheckDeepEqual(types.MediaType(payloadMediaType), mediaType)
+				t.CheckTrue(verify(&key.PublicKey, content, annotations[signatureAnnotation]))
+				return "sha256:sig", nil
+			})
+
+			signer := &Signer{cfg: &mockConfig{}, key: key, annotations: map[string]string{"team": "backend"}}
+			signed, err := signer.Sign(&bytes.Buffer{}, []build.Artifact{{ImageName: "gcr.io/project/app", Tag: test.tag}})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedPushed, pushed)
+			t.CheckDeepEqual(1, len(signed))
+			t.CheckDeepEqual(test.tag, signed[0].Tag)
+			if test.expectedPushed {
+				t.CheckDeepEqual(sigTag+"@sha256:sig", signed[0].Signature)
+			} else {
+				t.CheckTrue(len(signed[0].Signature) > len(sigTag+"@sha256:"))
+			}
+		})
+	}
+}
+
+// encrypt encrypts a key like `cosign generate-key-pair`.
+func encrypt(t *testing.T, der []byte, password string) []byte {
+	var k encryptedKey
+	k.KDF.Name = "scrypt"
+	k.KDF.Params.N = 32768
+	k.KDF.Params.R = 8
+	k.KDF.Params.P = 1
+	k.KDF.Salt = []byte("0123456789abcdef0123456789abcdef")
+	k.Cipher.Name = "nacl/secretbox"
+	k.Cipher.Nonce = []byte("0123456789abcdef01234567")
+
+	derived, err := scrypt.Key([]byte(password), k.KDF.Salt, k.KDF.Params.N, k.KDF.Params.R, k.KDF.Params.P, 32)
+	testutil.CheckError(t, false, err)
+	var secret [32]byte
+	var nonce [24]byte
+	copy(secret[:], derived)
+	copy(nonce[:], k.Cipher.Nonce)
+	k.Ciphertext = secretbox.Seal(nil, der, &nonce, &secret)
+
+	b, err := json.Marshal(k)
+	testutil.CheckError(t, false, err)
+	return b
+}
+
+func signatureImage(t *testutil.T, key *ecdsa.PrivateKey, payload []byte) (v1.Image, error) {
+	h := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+	t.CheckNoError(err)
+
+	layer := staticLayer(payload)
+	return mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       layer,
+		Annotations: map[string]string{signatureAnnotation: base64.StdEncoding.EncodeToString(sig)},
+	})
+}
+
+func verify(pub *ecdsa.PublicKey, payload []byte, signature string) bool {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	h := sha256.Sum256(payload)
+	return ecdsa.VerifyASN1(pub, h[:], sig)
+}
+
+// payloadLayer is an uncompressed layer, like the ones pushed by cosign.
+type payloadLayer struct{ content []byte }
+
+func staticLayer(content []byte) v1.Layer { return &payloadLayer{content: content} }
+
+func (l *payloadLayer) Digest() (v1.Hash, error) {
+	h, _, err := v1.SHA256(bytes.NewReader(l.content))
+	return h, err
+}
+func (l *payloadLayer) DiffID() (v1.Hash, error)            { return l.Digest() }
+func (l *payloadLayer) Size() (int64, error)                { return int64(len(l.content)), nil }
+func (l *payloadLayer) MediaType() (types.MediaType, error) { return payloadMediaType, nil }
+func (l *payloadLayer) Compressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
+func (l *payloadLayer) Uncompressed() (io.ReadCloser, error) { return l.Compressed() }
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// DependencyLister lists the files an artifact depends on.
+type DependencyLister func(ctx context.Context, a *latest.Artifact) ([]string, error)
+
+// inputDigestTagger tags images with a digest of their inputs.
+// Files are identified by their path relative to the artifact's workspace,
+// so that the tag is the same on every machine and for every commit that
+// doesn't change the artifact's inputs.
+type inputDigestTagger struct {
+	artifacts map[string]*latest.Artifact
+	lister    DependencyLister
+}
+
+// NewInputDigestTagger creates a tagger for the given artifacts.
+func NewInputDigestTagger(artifacts []*latest.Artifact, lister DependencyLister) Tagger {
+	byName := map[string]*latest.Artifact{}
+	for _, a := range artifacts {
+		byName[a.ImageName] = a
+	}
+
+	return &inputDigestTagger{
+		artifacts: byName,
+		lister:    lister,
+	}
+}
+
+// GenerateTag returns the digest of the artifact's inputs.
+func (t *inputDigestTagger) GenerateTag(_, imageName string) (string, error) {
+	a, found := t.artifacts[imageName]
+	if !found {
+		return "", fmt.Errorf("no artifact found for image %q", imageName)
+	}
+
+	return t.digest(context.Background(), a)
+}
+
+func (t *inputDigestTagger) digest(ctx context.Context, a *latest.Artifact) (string, error) {
+	h := sha256.New()
+
+	config, err := json.Marshal(a.ArtifactType)
+	if err != nil {
+		return "", fmt.Errorf("marshalling the artifact's configuration for %q: %w", a.ImageName, err)
+	}
+	h.Write(config)
+
+	platforms := append([]string{}, a.Platforms...)
+	sort.Strings(platforms)
+	for _, p := range platforms {
+		fmt.Fprintf(h, "\nplatform:%s", p)
+	}
+
+	deps, err := t.lister(ctx, a)
+	if err != nil {
+		return "", fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
+	}
+	workspace, err := filepath.Abs(a.Workspace)
+	if err != nil {
+		return "", err
+	}
+	files := map[string]string{}
+	for _, dep := range deps {
+		abs, err := filepath.Abs(dep)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(workspace, abs)
+		if err != nil {
+			rel = abs
+		}
+		files[filepath.ToSlash(rel)] = abs
+	}
+	paths := make([]string, 0, len(files))
+	for rel := range files {
+		paths = append(paths, rel)
+	}
+	sort.Strings(paths)
+	for _, rel := range paths {
+		fd, err := fileDigest(files[rel])
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("getting digest for %q: %w", files[rel], err)
+		}
+		fmt.Fprintf(h, "\nfile:%s:%s", rel, fd)
+	}
+
+	required := append([]*latest.ArtifactDependency{}, a.Dependencies...)
+	sort.Slice(required, func(i, j int) bool {
+		return required[i].ImageName < required[j].ImageName
+	})
+	for _, r := range required {
+		dep, found := t.artifacts[r.ImageName]
+		if !found {
+			continue
+		}
+		d, err := t.digest(ctx, dep)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "\nrequires:%s:%s", r.Alias, d)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// fileDigest hashes the content of a file and whether it's executable.
+// Other permission bits depend on the umask and are ignored.
+func fileDigest(path string) (string, error) {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	switch {
+	case fi.Mode()&os.ModeSymlink != 0:
+		target, err := os.Readlink(path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "symlink:%s", target)
+	case fi.Mode().IsRegular():
+		fmt.Fprintf(h, "executable:%t:", fi.Mode()&0111 != 0)
+		f, err := os.Open(path)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+		if _, err := io.Copy(h, f); err != nil {
+			return "", err
+		}
+	default:
+		fmt.Fprintf(h, "mode:%s", fi.Mode()&os.ModeType)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestInputDigest_GenerateTag(t *testing.T) {
+	listFiles := func(_ context.Context, a *latest.Artifact) ([]string, error) {
+		return []string{a.Workspace + "/Dockerfile", a.Workspace + "/app.sh", a.Workspace + "/missing"}, nil
+	}
+	artifact := func(workspace string) *latest.Artifact {
+		return &latest.Artifact{
+			ImageName: "app",
+			Workspace: workspace,
+			ArtifactType: latest.ArtifactType{
+				DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			},
+		}
+	}
+
+	testutil.Run(t, "same inputs in different workspaces", func(t *testutil.T) {
+		dir1 := t.NewTempDir().Write("Dockerfile", "FROM scratch").Write("app.sh", "echo")
+		dir2 := t.NewTempDir().Write("Dockerfile", "FROM scratch").Write("app.sh", "echo")
+
+		tag1, err1 := NewInputDigestTagger([]*latest.Artifact{artifact(dir1.Root())}, listFiles).GenerateTag(".", "app")
+		tag2, err2 := NewInputDigestTagger([]*latest.Artifact{artifact(dir2.Root())}, listFiles).GenerateTag(".", "app")
+
+		t.CheckNoError(err1)
+		t.CheckNoError(err2)
+		t.CheckDeepEqual(tag1, tag2)
+		t.CheckDeepEqual(64, len(tag1))
+	})
+
+	testutil.Run(t, "content change", func(t *testutil.T) {
+		dir := t.NewTempDir().Write("Dockerfile", "FROM scratch").Write("app.sh", "echo")
+		tagger := NewInputDigestTagger([]*latest.Artifact{artifact(dir.Root())}, listFiles)
+
+		before, err := tagger.GenerateTag(".", "app")
+		t.CheckNoError(err)
+		dir.Write("app.sh", "echo changed")
+		after, err := tagger.GenerateTag(".", "app")
+		t.CheckNoError(err)
+
+		t.CheckTrue(before != after)
+	})
+
+	testutil.Run(t, "executable bit", func(t *testutil.T) {
+		dir := t.NewTempDir().Write("Dockerfile", "FROM scratch").Write("app.sh", "echo")
+		tagger := NewInputDigestTagger([]*latest.Artifact{artifact(dir.Root())}, listFiles)
+
+		t.CheckNoError(os.Chmod(dir.Path("app.sh"), 0644))
+		before, err := tagger.GenerateTag(".", "app")
+		t.CheckNoError(err)
+		t.CheckNoError(os.Chmod(dir.Path("app.sh"), 0755))
+		after, err := tagger.GenerateTag(".", "app")
+		t.CheckNoError(err)
+
+		t.CheckTrue(before != after)
+	})
+
+	testutil.Run(t, "builder config change", func(t *testutil.T) {
+		dir := t.NewTempDir().Write("Dockerfile", "FROM scratch").Write("app.sh", "echo")
+		a := artifact(dir.Root())
+
+		before, err := NewInputDigestTagger([]*latest.Artifact{a}, listFiles).GenerateTag(".", "app")
+		t.CheckNoError(err)
+		a.DockerArtifact.Target = "prod"
+		after, err := NewInputDigestTagger([]*latest.Artifact{a}, listFiles).GenerateTag(".", "app")
+		t.CheckNoError(err)
+
+		t.CheckTrue(before != after)
+	})
+
+	testutil.Run(t, "required artifact change", func(t *testutil.T) {
+		appDir := t.NewTempDir().Write("Dockerfile", "FROM base").Write("app.sh", "echo")
+		baseDir := t.NewTempDir().Write("Dockerfile", "FROM scratch").Write("app.sh", "echo")
+		app := artifact(appDir.Root())
+		app.Dependencies = []*latest.ArtifactDependency{{ImageName: "base", Alias: "BASE"}}
+		base := artifact(baseDir.Root())
+		base.ImageName = "base"
+		tagger := NewInputDigestTagger([]*latest.Artifact{app, base}, listFiles)
+
+		before, err := tagger.GenerateTag(".", "app")
+		t.CheckNoError(err)
+		baseDir.Write("Dockerfile", "FROM busybox")
+		after, err := tagger.GenerateTag(".", "app")
+		t.CheckNoError(err)
+
+		t.CheckTrue(before != after)
+	})
+
+	testutil.Run(t, "unknown image", func(t *testutil.T) {
+		_, err := NewInputDigestTagger(nil, listFiles).GenerateTag(".", "other")
+
+		t.CheckErrorContains(`no artifact found for image "other"`, err)
+	})
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compose
+
+import (
+	"path/filepath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// AddArtifacts adds an artifact for each Compose service that has a build section and isn't already built by the pipeline.
+// The paths of the Compose files are relative to dir, but the workspaces of the artifacts are left relative to dir
+// just like the other paths of the pipeline.
+func AddArtifacts(p *latest.Pipeline, dir string) error {
+	if p.Deploy.ComposeDeploy == nil {
+		return nil
+	}
+
+	paths := p.Deploy.ComposeDeploy.Paths
+	if len(paths) == 0 {
+		paths = constants.DefaultComposePaths
+	}
+
+	for _, path := range paths {
+		project, err := Load(filepath.Join(dir, path))
+		if err != nil {
+			return err
+		}
+
+		for _, name := range project.ServiceNames() {
+			s := project.Services[name]
+			if s.Build == nil || hasArtifact(p, ImageName(name, s)) {
+				continue
+			}
+			p.Build.Artifacts = append(p.Build.Artifacts, artifact(name, s, filepath.Dir(path)))
+		}
+	}
+	return nil
+}
+
+func artifact(name string, s *Service, dir string) *latest.Artifact {
+	args := map[string]*string{}
+	for k := range s.Build.Args {
+		v := s.Build.Args[k]
+		args[k] = &v
+	}
+
+	docker := &latest.DockerArtifact{
+		DockerfilePath: s.Build.Dockerfile,
+		Target:         s.Build.Target,
+	}
+	if len(args) > 0 {
+		docker.BuildArgs = args
+	}
+
+	return &latest.Artifact{
+		ImageName: ImageName(name, s),
+		Workspace: filepath.Join(dir, s.Build.Context),
+		ArtifactType: latest.ArtifactType{
+			DockerArtifact: docker,
+		},
+	}
+}
+
+func hasArtifact(p *latest.Pipeline, image string) bool {
+	for _, a := range p.Build.Artifacts {
+		if a.ImageName == image {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compose
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestAddArtifacts(t *testing.T) {
+	tests := []struct {
+		description string
+		paths       []string
+		existing    []*latest.Artifact
+		expected    []*latest.Artifact
+	}{
+		{
+			description: "default compose file",
+			expected: []*latest.Artifact{{
+				ImageName:    "api",
+				Workspace:    "api",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+			}, {
+				ImageName: "gcr.io/project/web",
+				Workspace: "web",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+					DockerfilePath: "Dockerfile.dev",
+					BuildArgs:      map[string]*string{"VERSION": stringPtr("1")},
+				}},
+			}},
+		},
+		{
+			description: "compose file in a sub folder",
+			paths:       []string{filepath.Join("sub", "compose.yaml")},
+			expected: []*latest.Artifact{{
+				ImageName:    "app",
+				Workspace:    filepath.Join("sub", "app"),
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+			}},
+		},
+		{
+			description: "artifacts from skaffold.yaml take precedence",
+			existing:    []*latest.Artifact{{ImageName: "api", Workspace: "other"}},
+			expected: []*latest.Artifact{{
+				ImageName: "api",
+				Workspace: "other",
+			}, {
+				ImageName: "gcr.io/project/web",
+				Workspace: "web",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+					DockerfilePath: "Dockerfile.dev",
+					BuildArgs:      map[string]*string{"VERSION": stringPtr("1")},
+				}},
+			}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().
+				Write("docker-compose.yaml", `services:
+  api:
+    build: ./api
+  web:
+    image: gcr.io/project/web
+    build:
+      context: web
+      dockerfile: Dockerfile.dev
+      args: ["VERSION=1"]
+  db:
+    image: postgres`).
+				Write("sub/compose.yaml", `services:
+  app:
+    build: app`)
+
+			p := &latest.Pipeline{
+				Build:  latest.BuildConfig{Artifacts: test.existing},
+				Deploy: latest.DeployConfig{DeployType: latest.DeployType{ComposeDeploy: &latest.ComposeDeploy{Paths: test.paths}}},
+			}
+			err := AddArtifacts(p, tmpDir.Root())
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, p.Build.Artifacts)
+		})
+	}
+}
+
+func TestAddArtifactsWithoutCompose(t *testing.T) {
+	p := &latest.Pipeline{}
+
+	err := AddArtifacts(p, "")
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, []*latest.Artifact(nil), p.Build.Artifacts)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
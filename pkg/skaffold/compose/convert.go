@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compose
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+)
+
+// ServiceLabel is set on the Kubernetes objects translated from a Compose service.
+const ServiceLabel = "skaffold.dev/compose-service"
+
+// Manifests translates the services to Kubernetes objects: a Deployment per service,
+// and a Service for those that have ports.
+func (p *Project) Manifests() ([][]byte, error) {
+	var manifests [][]byte
+	for _, name := range p.ServiceNames() {
+		objects := []interface{}{deployment(name, p.Services[name])}
+		if svc := service(name, p.Services[name]); svc != nil {
+			objects = append(objects, svc)
+		}
+
+		for _, obj := range objects {
+			m, err := yaml.Marshal(obj)
+			if err != nil {
+				return nil, fmt.Errorf("translating compose service %q: %w", name, err)
+			}
+			manifests = append(manifests, m)
+		}
+	}
+	return manifests, nil
+}
+
+func deployment(name string, s *Service) *appsv1.Deployment {
+	if len(s.Volumes) > 0 {
+		logrus.Warnf("Ignoring the volumes of compose service %q: volumes are not supported", name)
+	}
+
+	labels := map[string]string{ServiceLabel: name}
+
+	var ports []v1.ContainerPort
+	for _, p := range s.Ports {
+		ports = append(ports, v1.ContainerPort{ContainerPort: p.Target, Protocol: protocol(p)})
+	}
+
+	var env []v1.EnvVar
+	for _, k := range sortedKeys(s.Environment) {
+		env = append(env, v1.EnvVar{Name: k, Value: s.Environment[k]})
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: objectName(name), Labels: labels},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: s.Deploy.Replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:       objectName(name),
+						Image:      ImageName(name, s),
+						Command:    s.Entrypoint,
+						Args:       s.Command,
+						Env:        env,
+						Ports:      ports,
+						WorkingDir: s.WorkingDir,
+					}},
+				},
+			},
+		},
+	}
+}
+
+func service(name string, s *Service) *v1.Service {
+	if len(s.Ports) == 0 {
+		return nil
+	}
+
+	var ports []v1.ServicePort
+	for _, p := range s.Ports {
+		port := p.Published
+		if port == 0 {
+			port = p.Target
+		}
+		ports = append(ports, v1.ServicePort{
+			Name:       fmt.Sprintf("%d-%s", port, strings.ToLower(string(protocol(p)))),
+			Port:       port,
+			TargetPort: intstr.FromInt(int(p.Target)),
+			Protocol:   protocol(p),
+		})
+	}
+
+	return &v1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: objectName(name), Labels: map[string]string{ServiceLabel: name}},
+		Spec: v1.ServiceSpec{
+			Selector: map[string]string{ServiceLabel: name},
+			Ports:    ports,
+		},
+	}
+}
+
+func protocol(p Port) v1.Protocol {
+	if p.Protocol == "" {
+		return v1.ProtocolTCP
+	}
+	return v1.Protocol(strings.ToUpper(p.Protocol))
+}
+
+// objectName turns a Compose service name into a valid Kubernetes object name.
+func objectName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
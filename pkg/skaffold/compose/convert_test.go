@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compose
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestManifests(t *testing.T) {
+	tests := []struct {
+		description string
+		services    map[string]*Service
+		expected    []string
+	}{
+		{
+			description: "deployment only",
+			services: map[string]*Service{
+				"worker_1": {Build: &Build{Context: "."}, Command: Command{"run"}, Environment: Environment{"B": "2", "A": "1"}},
+			},
+			expected: []string{`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  creationTimestamp: null
+  labels:
+    skaffold.dev/compose-service: worker_1
+  name: worker-1
+spec:
+  selector:
+    matchLabels:
+      skaffold.dev/compose-service: worker_1
+  strategy: {}
+  template:
+    metadata:
+      creationTimestamp: null
+      labels:
+        skaffold.dev/compose-service: worker_1
+    spec:
+      containers:
+      - args:
+        - run
+        env:
+        - name: A
+          value: "1"
+        - name: B
+          value: "2"
+        image: worker_1
+        name: worker-1
+        resources: {}
+status: {}
+`},
+		},
+		{
+			description: "deployment and service",
+			services: map[string]*Service{
+				"web": {Image: "web", Ports: []Port{{Target: 8080, Published: 80}, {Target: 53, Protocol: "udp"}}},
+			},
+			expected: []string{`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  creationTimestamp: null
+  labels:
+    skaffold.dev/compose-service: web
+  name: web
+spec:
+  selector:
+    matchLabels:
+      skaffold.dev/compose-service: web
+  strategy: {}
+  template:
+    metadata:
+      creationTimestamp: null
+      labels:
+        skaffold.dev/compose-service: web
+    spec:
+      containers:
+      - image: web
+        name: web
+        ports:
+        - containerPort: 8080
+          protocol: TCP
+        - containerPort: 53
+          protocol: UDP
+        resources: {}
+status: {}
+`, `apiVersion: v1
+kind: Service
+metadata:
+  creationTimestamp: null
+  labels:
+    skaffold.dev/compose-service: web
+  name: web
+spec:
+  ports:
+  - name: 80-tcp
+    port: 80
+    protocol: TCP
+    targetPort: 8080
+  - name: 53-udp
+    port: 53
+    protocol: UDP
+    targetPort: 53
+  selector:
+    skaffold.dev/compose-service: web
+status:
+  loadBalancer: {}
+`},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			project := &Project{Services: test.services}
+
+			manifests, err := project.Manifests()
+
+			t.CheckNoError(err)
+			var actual []string
+			for _, m := range manifests {
+				actual = append(actual, string(m))
+			}
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
+func TestObjectName(t *testing.T) {
+	testutil.CheckDeepEqual(t, "my-service", objectName("My_Service"))
+	testutil.CheckDeepEqual(t, "web", objectName("web"))
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compose
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	shell "github.com/kballard/go-shellquote"
+	"gopkg.in/yaml.v3"
+)
+
+// Project is the content of a Docker Compose file.
+type Project struct {
+	// Dir is the folder containing the Compose file.
+	Dir      string
+	Services map[string]*Service `yaml:"services"`
+}
+
+// Service is a service of a Docker Compose file.
+// Only the fields that can be translated to Kubernetes objects are read.
+type Service struct {
+	Image       string      `yaml:"image"`
+	Build       *Build      `yaml:"build"`
+	Command     Command     `yaml:"command"`
+	Entrypoint  Command     `yaml:"entrypoint"`
+	Environment Environment `yaml:"environment"`
+	Ports       []Port      `yaml:"ports"`
+	WorkingDir  string      `yaml:"working_dir"`
+	Volumes     []yaml.Node `yaml:"volumes"`
+	Deploy      Deploy      `yaml:"deploy"`
+}
+
+// Deploy is the deploy section of a service.
+type Deploy struct {
+	Replicas *int32 `yaml:"replicas"`
+}
+
+// Build is the build section of a service, given either as a context folder or as an object.
+type Build struct {
+	Context    string
+	Dockerfile string
+	Args       map[string]string
+	Target     string
+}
+
+// Command is a command, given either as a string or as a list.
+type Command []string
+
+// Environment lists environment variables, given either as a map or as a list of `KEY=VALUE`.
+type Environment map[string]string
+
+// Port is a port of a service, given with either the short or the long syntax.
+type Port struct {
+	Target    int32  `yaml:"target"`
+	Published int32  `yaml:"published"`
+	Protocol  string `yaml:"protocol"`
+}
+
+// Load reads a Docker Compose file. Variables are replaced with the values from
+// the environment, or from a `.env` file next to the Compose file.
+func Load(path string) (*Project, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading compose file %q: %w", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	env, err := readEnvFile(filepath.Join(dir, ".env"))
+	if err != nil {
+		return nil, err
+	}
+
+	project := &Project{Dir: dir}
+	if err := yaml.Unmarshal([]byte(interpolate(string(content), env)), project); err != nil {
+		return nil, fmt.Errorf("parsing compose file %q: %w", path, err)
+	}
+	return project, nil
+}
+
+// ServiceNames returns the names of the services, sorted.
+func (p *Project) ServiceNames() []string {
+	var names []string
+	for name := range p.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ImageName returns the image of a service. Services without an image
+// are built with an image named after the service.
+func ImageName(name string, s *Service) string {
+	if s.Image != "" {
+		return s.Image
+	}
+	return name
+}
+
+func (b *Build) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		b.Context = node.Value
+		return nil
+	}
+
+	var build struct {
+		Context    string      `yaml:"context"`
+		Dockerfile string      `yaml:"dockerfile"`
+		Args       Environment `yaml:"args"`
+		Target     string      `yaml:"target"`
+	}
+	if err := node.Decode(&build); err != nil {
+		return err
+	}
+	*b = Build{
+		Context:    build.Context,
+		Dockerfile: build.Dockerfile,
+		Args:       build.Args,
+		Target:     build.Target,
+	}
+	return nil
+}
+
+func (c *Command) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		args, err := shell.Split(node.Value)
+		if err != nil {
+			return fmt.Errorf("parsing command %q: %w", node.Value, err)
+		}
+		*c = args
+		return nil
+	}
+
+	var args []string
+	if err := node.Decode(&args); err != nil {
+		return err
+	}
+	*c = args
+	return nil
+}
+
+func (e *Environment) UnmarshalYAML(node *yaml.Node) error {
+	env := Environment{}
+	switch node.Kind {
+	case yaml.MappingNode:
+		var m map[string]*string
+		if err := node.Decode(&m); err != nil {
+			return err
+		}
+		for k, v := range m {
+			if v == nil {
+				env[k] = os.Getenv(k)
+			} else {
+				env[k] = *v
+			}
+		}
+	default:
+		var list []string
+		if err := node.Decode(&list); err != nil {
+			return err
+		}
+		for _, kv := range list {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) == 1 {
+				env[parts[0]] = os.Getenv(parts[0])
+			} else {
+				env[parts[0]] = parts[1]
+			}
+		}
+	}
+	*e = env
+	return nil
+}
+
+func (p *Port) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.MappingNode {
+		type plain Port
+		return node.Decode((*plain)(p))
+	}
+
+	port, err := parsePort(node.Value)
+	if err != nil {
+		return err
+	}
+	*p = port
+	return nil
+}
+
+// parsePort parses the short syntax of ports: `[[ip:]published:]target[/protocol]`.
+func parsePort(spec string) (Port, error) {
+	var port Port
+	if i := strings.LastIndex(spec, "/"); i >= 0 {
+		port.Protocol = spec[i+1:]
+		spec = spec[:i]
+	}
+
+	parts := strings.Split(spec, ":")
+	target, err := strconv.ParseInt(parts[len(parts)-1], 10, 32)
+	if err != nil {
+		return Port{}, fmt.Errorf("unsupported port %q: port ranges are not supported", spec)
+	}
+	port.Target = int32(target)
+
+	if len(parts) > 1 && parts[len(parts)-2] != "" {
+		published, err := strconv.ParseInt(parts[len(parts)-2], 10, 32)
+		if err != nil {
+			return Port{}, fmt.Errorf("unsupported port %q: port ranges are not supported", spec)
+		}
+		port.Published = int32(published)
+	}
+	return port, nil
+}
+
+var variable = regexp.MustCompile(`\$\$|\$\{([a-zA-Z_][a-zA-Z0-9_]*)(?:(:?[-?])([^}]*))?\}|\$([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// interpolate replaces `$VAR`, `${VAR}`, `${VAR:-default}` and `${VAR-default}` like Docker Compose does.
+// `$$` is an escaped `$`.
+func interpolate(content string, env map[string]string) string {
+	lookup := func(name string) (string, bool) {
+		if v, found := os.LookupEnv(name); found {
+			return v, true
+		}
+		v, found := env[name]
+		return v, found
+	}
+
+	return variable.ReplaceAllStringFunc(content, func(match string) string {
+		if match == "$$" {
+			return "$"
+		}
+
+		groups := variable.FindStringSubmatch(match)
+		if groups[4] != "" {
+			v, _ := lookup(groups[4])
+			return v
+		}
+
+		v, found := lookup(groups[1])
+		switch groups[2] {
+		case ":-":
+			if v == "" {
+				return groups[3]
+			}
+		case "-":
+			if !found {
+				return groups[3]
+			}
+		}
+		return v
+	})
+}
+
+// readEnvFile reads the `KEY=VALUE` lines of an optional `.env` file.
+func readEnvFile(path string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %w", path, err)
+	}
+
+	env := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) == 2 {
+			env[strings.TrimSpace(parts[0])] = strings.Trim(strings.TrimSpace(parts[1]), `"'`)
+		}
+	}
+	return env, scanner.Err()
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compose
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		description string
+		compose     string
+		envFile     string
+		env         map[string]string
+		expected    map[string]*Service
+		shouldErr   bool
+	}{
+		{
+			description: "short syntax",
+			compose: `services:
+  web:
+    build: ./web
+    command: npm start --port 8080
+    environment:
+    - PORT=8080
+    - DEBUG
+    ports:
+    - "8080"
+    - "9000:8080"
+    - "127.0.0.1:5353:53/udp"`,
+			env: map[string]string{"DEBUG": "1"},
+			expected: map[string]*Service{
+				"web": {
+					Build:       &Build{Context: "./web"},
+					Command:     Command{"npm", "start", "--port", "8080"},
+					Environment: Environment{"PORT": "8080", "DEBUG": "1"},
+					Ports:       []Port{{Target: 8080}, {Target: 8080, Published: 9000}, {Target: 53, Published: 5353, Protocol: "udp"}},
+				},
+			},
+		},
+		{
+			description: "long syntax",
+			compose: `services:
+  web:
+    image: gcr.io/project/web
+    build:
+      context: web
+      dockerfile: Dockerfile.dev
+      args:
+        VERSION: "1"
+    entrypoint: ["/app"]
+    environment:
+      PORT: "8080"
+    ports:
+    - target: 8080
+      published: 80
+    deploy:
+      replicas: 2`,
+			expected: map[string]*Service{
+				"web": {
+					Image:       "gcr.io/project/web",
+					Build:       &Build{Context: "web", Dockerfile: "Dockerfile.dev", Args: map[string]string{"VERSION": "1"}},
+					Entrypoint:  Command{"/app"},
+					Environment: Environment{"PORT": "8080"},
+					Ports:       []Port{{Target: 8080, Published: 80}},
+					Deploy:      Deploy{Replicas: int32Ptr(2)},
+				},
+			},
+		},
+		{
+			description: "variables",
+			compose: `services:
+  db:
+    image: postgres:${PG_VERSION}
+    environment:
+      USER: ${DB_USER:-admin}
+      PASSWORD: $$secret
+      HOST: $DB_HOST`,
+			envFile: "PG_VERSION=13\nDB_HOST=localhost\n",
+			env:     map[string]string{"DB_HOST": "db"},
+			expected: map[string]*Service{
+				"db": {
+					Image:       "postgres:13",
+					Environment: Environment{"USER": "admin", "PASSWORD": "$secret", "HOST": "db"},
+				},
+			},
+		},
+		{
+			description: "port ranges are not supported",
+			compose: `services:
+  web:
+    ports: ["3000-3005"]`,
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("docker-compose.yaml", test.compose)
+			if test.envFile != "" {
+				tmpDir.Write(".env", test.envFile)
+			}
+			t.SetEnvs(test.env)
+
+			project, err := Load(tmpDir.Path("docker-compose.yaml"))
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(filepath.Dir(tmpDir.Path("docker-compose.yaml")), project.Dir)
+				for _, s := range project.Services {
+					s.Volumes = nil
+				}
+				t.CheckDeepEqual(test.expected, project.Services)
+			}
+		})
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -46,9 +46,11 @@ type SkaffoldOptions struct {
 	Cleanup               bool
 	Notification          bool
 	Tail                  bool
+	LogFiles              bool
 	SkipTests             bool
 	CacheArtifacts        bool
 	EnableRPC             bool
+	AcceptUploads         bool
 	Force                 bool
 	NoPrune               bool
 	NoPruneChildren       bool
@@ -58,6 +60,7 @@ type SkaffoldOptions struct {
 	AutoDeploy            bool
 	RenderOnly            bool
 	RenderOutput          string
+	BuildOutputFile       string
 	ProfileAutoActivation bool
 	DryRun                bool
 	SkipRender            bool
@@ -73,7 +76,12 @@ type SkaffoldOptions struct {
 	CustomTag          string
 	Namespace          string
 	CacheFile          string
+	RemoteCache        string
 	Trigger            string
+	LogFormat          string
+	DebugMode          string
+	AutoProbeTimeout   string
+	IDEConfig          string
 	KubeContext        string
 	KubeConfig         string
 	DigestSource       string
@@ -82,11 +90,18 @@ type SkaffoldOptions struct {
 	CustomLabels       []string
 	TargetImages       []string
 	Profiles           []string
+	Modules            []string
+	Platforms          []string
 	InsecureRegistries []string
 	Muted              Muted
 	Command            string
+	RPCAddress         string
 	RPCPort            int
 	RPCHTTPPort        int
+	RPCTLSCert         string
+	RPCTLSKey          string
+	RPCToken           string
+	Remote             string
 
 	// TODO(https://github.com/GoogleContainerTools/skaffold/issues/3668):
 	// remove minikubeProfile from here and instead detect it by matching the
@@ -46,8 +46,12 @@ const (
 
 	DefaultSkaffoldDir = ".skaffold"
 	DefaultCacheFile   = "cache"
+	DefaultDeployState = "deploy-state"
 	DefaultMetricFile  = "metrics"
 
+	// DefaultLogsDir is where `--log-files` writes the container logs, under the DefaultSkaffoldDir of the working directory.
+	DefaultLogsDir = "logs"
+
 	DefaultRPCPort     = 50051
 	DefaultRPCHTTPPort = 50052
 
@@ -106,6 +110,8 @@ var ImageRef = struct {
 }
 var DefaultKubectlManifests = []string{"k8s/*.yaml"}
 
+var DefaultComposePaths = []string{"docker-compose.yaml"}
+
 var Labels = struct {
 	TagPolicy        string
 	Deployer         string
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+)
+
+const (
+	// ModeTransform rewrites the workloads' manifests so that their containers start under a debugger.
+	ModeTransform = "transform"
+
+	// ModeAttach deploys the workloads unchanged and attaches debuggers to the running containers
+	// through ephemeral containers.
+	ModeAttach = "attach"
+
+	// ephemeralContainerPrefix prefixes the names of the ephemeral containers running the debuggers
+	ephemeralContainerPrefix = "skaffold-debug-"
+)
+
+// attacher describes how to attach a debugger to the main process of a running container.
+// The ephemeral container shares the process namespace of its target, in which the target's
+// main process has the PID 1.
+type attacher struct {
+	// image is relative to the debug helpers registry, unless it is a full image reference
+	image string
+	// portName and port are the port the debugger listens on, if any
+	portName string
+	port     int32
+	// command returns the command-line of the debugger, listening on the given port
+	command func(port int32) []string
+}
+
+// attachers are keyed by runtime
+var attachers = map[string]attacher{
+	"go": {
+		// the support image holds the files that it installs under `/duct-tape`
+		image:    "go",
+		portName: "dlv",
+		port:     defaultDlvPort,
+		command: func(port int32) []string {
+			return []string{"/duct-tape/go/bin/dlv", "attach", "1", "--headless", "--continue", "--accept-multiclient", "--api-version=2", "--listen=:" + strconv.Itoa(int(port))}
+		},
+	},
+}
+
+// Attacher attaches debuggers to the containers of running pods through ephemeral containers.
+type Attacher struct {
+	insecureRegistries   map[string]bool
+	debugHelpersRegistry string
+
+	builds      []build.Artifact
+	buildsMutex sync.Mutex
+}
+
+// NewAttacher creates an Attacher for the containers running the given builds.
+func NewAttacher(builds []build.Artifact, insecureRegistries map[string]bool, debugHelpersRegistry string) *Attacher {
+	return &Attacher{
+		builds:               builds,
+		insecureRegistries:   insecureRegistries,
+		debugHelpersRegistry: debugHelpersRegistry,
+	}
+}
+
+// UpdateBuilds sets the images to attach debuggers to after each build.
+func (a *Attacher) UpdateBuilds(builds []build.Artifact) {
+	if a == nil {
+		return
+	}
+
+	a.buildsMutex.Lock()
+	a.builds = builds
+	a.buildsMutex.Unlock()
+}
+
+// EphemeralContainers returns the ephemeral containers that attach debuggers to the containers of a pod,
+// along with the debug configuration of each target container.
+// Containers that already have a debugger attached are skipped.
+func (a *Attacher) EphemeralContainers(ctx context.Context, pod *v1.Pod) ([]v1.EphemeralContainer, map[string]ContainerDebugConfiguration) {
+	a.buildsMutex.Lock()
+	builds := a.builds
+	a.buildsMutex.Unlock()
+
+	retriever := func(image string) (imageConfiguration, error) {
+		if artifact := findArtifact(image, builds); artifact != nil {
+			return retrieveImageConfiguration(ctx, artifact, a.insecureRegistries)
+		}
+		return imageConfiguration{}, fmt.Errorf("no build artifact for %q", image)
+	}
+	return ephemeralContainers(pod, retriever, a.debugHelpersRegistry)
+}
+
+func ephemeralContainers(pod *v1.Pod, retrieveImageConfiguration configurationRetriever, debugHelpersRegistry string) ([]v1.EphemeralContainer, map[string]ContainerDebugConfiguration) {
+	attached := make(map[string]bool)
+	for _, c := range pod.Spec.EphemeralContainers {
+		attached[c.TargetContainerName] = true
+	}
+
+	// ports are shared by all the containers of the pod, including the debuggers that are already attached
+	podSpec := pod.Spec.DeepCopy()
+	if annotation, found := pod.Annotations[DebugConfigAnnotation]; found {
+		var configurations map[string]ContainerDebugConfiguration
+		if err := json.Unmarshal([]byte(annotation), &configurations); err == nil {
+			for _, configuration := range configurations {
+				for _, port := range configuration.Ports {
+					podSpec.Containers = append(podSpec.Containers, v1.Container{Ports: []v1.ContainerPort{{ContainerPort: int32(port)}}})
+				}
+			}
+		}
+	}
+
+	var containers []v1.EphemeralContainer
+	configurations := make(map[string]ContainerDebugConfiguration)
+	for _, container := range pod.Spec.Containers {
+		if attached[container.Name] {
+			continue
+		}
+
+		// the usual retriever returns an error for non-build artifacts
+		imageConfig, err := retrieveImageConfiguration(container.Image)
+		if err != nil {
+			continue
+		}
+		imageConfig = containerConfiguration(container, imageConfig)
+
+		runtime := attachableRuntime(imageConfig)
+		attacher, found := attachers[runtime]
+		if !found {
+			logrus.Warnf("Image %q not configured for debugging: unable to attach a debugger to %q", container.Name, imageConfig.artifact)
+			continue
+		}
+
+		configuration := ContainerDebugConfiguration{
+			Artifact:   imageConfig.artifact,
+			Runtime:    runtime,
+			WorkingDir: imageConfig.workingDir,
+		}
+		var port int32
+		if attacher.portName != "" {
+			port = allocatePort(podSpec, attacher.port)
+			// reserve the port for the next containers
+			podSpec.Containers = append(podSpec.Containers, v1.Container{Ports: []v1.ContainerPort{{ContainerPort: port}}})
+			configuration.Ports = map[string]uint32{attacher.portName: uint32(port)}
+		}
+
+		logrus.Infof("Attaching a %s debugger to %q", runtime, container.Name)
+		containers = append(containers, v1.EphemeralContainer{
+			TargetContainerName: container.Name,
+			EphemeralContainerCommon: v1.EphemeralContainerCommon{
+				Name:    ephemeralContainerPrefix + container.Name,
+				Image:   supportImage(attacher.image, debugHelpersRegistry),
+				Command: attacher.command(port),
+				SecurityContext: &v1.SecurityContext{
+					// debuggers trace the processes of the target container
+					Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_PTRACE"}},
+				},
+			},
+		})
+		configurations[container.Name] = configuration
+	}
+
+	return containers, configurations
+}
+
+// attachableRuntime returns the runtime of a container, if a debugger can be attached to it.
+func attachableRuntime(config imageConfiguration) string {
+	for _, transform := range containerTransforms {
+		if !transform.IsApplicable(config) {
+			continue
+		}
+		switch transform.(type) {
+		case dlvTransformer:
+			return "go"
+		}
+		return ""
+	}
+	return ""
+}
+
+// MergeConfigurations adds the debug configurations of containers to the value of a
+// `debug.cloud.google.com/config` annotation.
+func MergeConfigurations(annotation string, configurations map[string]ContainerDebugConfiguration) string {
+	merged := make(map[string]ContainerDebugConfiguration)
+	if annotation != "" {
+		if err := json.Unmarshal([]byte(annotation), &merged); err != nil {
+			logrus.Warnf("Unable to parse debug-config %q: %v", annotation, err)
+		}
+	}
+	for name, configuration := range configurations {
+		merged[name] = configuration
+	}
+	return encodeConfigurations(merged)
+}
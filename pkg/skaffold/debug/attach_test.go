@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestEphemeralContainers(t *testing.T) {
+	configs := map[string]imageConfiguration{
+		"go":     {artifact: "go-app", env: map[string]string{"GOTRACEBACK": "all"}, workingDir: "/app"},
+		"python": {artifact: "py-app", arguments: []string{"python", "app.py"}},
+		"node":   {artifact: "node-app", env: map[string]string{"NODE_VERSION": "14"}},
+	}
+	retriever := func(image string) (imageConfiguration, error) {
+		if config, found := configs[image]; found {
+			return config, nil
+		}
+		return imageConfiguration{}, fmt.Errorf("no build artifact for %q", image)
+	}
+	securityContext := &v1.SecurityContext{Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_PTRACE"}}}
+
+	tests := []struct {
+		description    string
+		pod            v1.Pod
+		containers     []v1.EphemeralContainer
+		configurations map[string]ContainerDebugConfiguration
+	}{
+		{
+			description: "go, and python without attacher",
+			pod: v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{
+				{Name: "web", Image: "go", Ports: []v1.ContainerPort{{ContainerPort: 56268}}},
+				{Name: "worker", Image: "python"},
+				{Name: "sidecar", Image: "envoy"},
+			}}},
+			containers: []v1.EphemeralContainer{
+				{
+					TargetContainerName: "web",
+					EphemeralContainerCommon: v1.EphemeralContainerCommon{
+						Name:            "skaffold-debug-web",
+						Image:           "HELPERS/go",
+						Command:         []string{"/duct-tape/go/bin/dlv", "attach", "1", "--headless", "--continue", "--accept-multiclient", "--api-version=2", "--listen=:56269"},
+						SecurityContext: securityContext,
+					},
+				},
+			},
+			configurations: map[string]ContainerDebugConfiguration{
+				"web": {Artifact: "go-app", Runtime: "go", WorkingDir: "/app", Ports: map[string]uint32{"dlv": 56269}},
+			},
+		},
+		{
+			description:    "runtime without attacher",
+			pod:            v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "web", Image: "node"}}}},
+			configurations: map[string]ContainerDebugConfiguration{},
+		},
+		{
+			description: "already attached",
+			pod: v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"debug.cloud.google.com/config": `{"web":{"runtime":"go","ports":{"dlv":56268}}}`},
+				},
+				Spec: v1.PodSpec{
+					Containers:          []v1.Container{{Name: "web", Image: "go"}, {Name: "api", Image: "go"}},
+					EphemeralContainers: []v1.EphemeralContainer{{TargetContainerName: "web"}},
+				},
+			},
+			containers: []v1.EphemeralContainer{{
+				TargetContainerName: "api",
+				EphemeralContainerCommon: v1.EphemeralContainerCommon{
+					Name:            "skaffold-debug-api",
+					Image:           "HELPERS/go",
+					Command:         []string{"/duct-tape/go/bin/dlv", "attach", "1", "--headless", "--continue", "--accept-multiclient", "--api-version=2", "--listen=:56269"},
+					SecurityContext: securityContext,
+				},
+			}},
+			configurations: map[string]ContainerDebugConfiguration{
+				"api": {Artifact: "go-app", Runtime: "go", WorkingDir: "/app", Ports: map[string]uint32{"dlv": 56269}},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			containers, configurations := ephemeralContainers(&test.pod, retriever, "HELPERS")
+
+			t.CheckDeepEqual(test.containers, containers)
+			t.CheckDeepEqual(test.configurations, configurations)
+		})
+	}
+}
+
+func TestMergeConfigurations(t *testing.T) {
+	tests := []struct {
+		description string
+		annotation  string
+		expected    string
+	}{
+		{
+			description: "no annotation",
+			expected:    `{"web":{"runtime":"go","ports":{"dlv":56268}}}`,
+		},
+		{
+			description: "existing annotation",
+			annotation:  `{"api":{"runtime":"jvm","ports":{"jdwp":5005}}}`,
+			expected:    `{"api":{"runtime":"jvm","ports":{"jdwp":5005}},"web":{"runtime":"go","ports":{"dlv":56268}}}`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			merged := MergeConfigurations(test.annotation, map[string]ContainerDebugConfiguration{
+				"web": {Runtime: "go", Ports: map[string]uint32{"dlv": 56268}},
+			})
+
+			t.CheckDeepEqual(test.expected, merged)
+		})
+	}
+}
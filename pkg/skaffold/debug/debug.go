@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,6 +33,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 var (
@@ -49,26 +51,33 @@ var (
 )
 
 // ApplyDebuggingTransforms applies language-platform-specific transforms to a list of manifests.
-func ApplyDebuggingTransforms(l manifest.ManifestList, builds []build.Artifact, registries manifest.Registries) (manifest.ManifestList, error) {
+// The debug recipes of the `artifacts` take precedence over the detected runtimes.
+// The probes of the debugged containers are relaxed according to `probeTimeout` (see `ParseProbeTimeout`).
+func ApplyDebuggingTransforms(l manifest.ManifestList, builds []build.Artifact, artifacts []*latest.Artifact, registries manifest.Registries, probeTimeout time.Duration) (manifest.ManifestList, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	retriever := func(image string) (imageConfiguration, error) {
 		if artifact := findArtifact(image, builds); artifact != nil {
-			return retrieveImageConfiguration(ctx, artifact, registries.InsecureRegistries)
+			config, err := retrieveImageConfiguration(ctx, artifact, registries.InsecureRegistries)
+			if err != nil {
+				return imageConfiguration{}, err
+			}
+			config.recipe = findRecipe(artifact.ImageName, artifacts)
+			return config, nil
 		}
 		return imageConfiguration{}, fmt.Errorf("no build artifact for %q", image)
 	}
-	return applyDebuggingTransforms(l, retriever, registries.DebugHelpersRegistry)
+	return applyDebuggingTransforms(l, retriever, registries.DebugHelpersRegistry, probeTimeout)
 }
 
-func applyDebuggingTransforms(l manifest.ManifestList, retriever configurationRetriever, debugHelpersRegistry string) (manifest.ManifestList, error) {
+func applyDebuggingTransforms(l manifest.ManifestList, retriever configurationRetriever, debugHelpersRegistry string, probeTimeout time.Duration) (manifest.ManifestList, error) {
 	var updated manifest.ManifestList
 	for _, manifest := range l {
 		obj, _, err := decodeFromYaml(manifest, nil, nil)
 		if err != nil {
 			logrus.Debugf("Unable to interpret manifest for debugging: %v\n", err)
-		} else if transformManifest(obj, retriever, debugHelpersRegistry) {
+		} else if transformManifest(obj, retriever, debugHelpersRegistry, probeTimeout) {
 			manifest, err = encodeAsYaml(obj)
 			if err != nil {
 				return nil, fmt.Errorf("marshalling yaml: %w", err)
@@ -99,6 +108,16 @@ func findArtifact(image string, builds []build.Artifact) *build.Artifact {
 	return nil
 }
 
+// findRecipe finds the debug recipe of the artifact with the given image name, if any.
+func findRecipe(imageName string, artifacts []*latest.Artifact) *latest.DebugRecipe {
+	for _, a := range artifacts {
+		if a.ImageName == imageName {
+			return a.Debug
+		}
+	}
+	return nil
+}
+
 // retrieveImageConfiguration retrieves the image container configuration for
 // the given build artifact
 func retrieveImageConfiguration(ctx context.Context, artifact *build.Artifact, insecureRegistries map[string]bool) (imageConfiguration, error) {
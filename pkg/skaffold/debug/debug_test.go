@@ -594,7 +594,7 @@ status: {}`,
 
 			l, err := manifest.Load(bytes.NewReader([]byte(test.in)))
 			t.CheckError(false, err)
-			result, err := applyDebuggingTransforms(l, retriever, "HELPERS")
+			result, err := applyDebuggingTransforms(l, retriever, "HELPERS", 0)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.out, result.String())
 		})
@@ -614,7 +614,7 @@ func TestWorkingDir(t *testing.T) {
 		return imageConfiguration{workingDir: "/a/dir"}, nil
 	}
 
-	result := transformManifest(pod, retriever, "HELPERS")
+	result := transformManifest(pod, retriever, "HELPERS", 0)
 	testutil.CheckDeepEqual(t, true, result)
 	debugConfig := pod.ObjectMeta.Annotations["debug.cloud.google.com/config"]
 	testutil.CheckDeepEqual(t, true, strings.Contains(debugConfig, `"workingDir":"/a/dir"`))
@@ -633,7 +633,7 @@ func TestArtifactImage(t *testing.T) {
 		return imageConfiguration{artifact: "gcr.io/random/image"}, nil
 	}
 
-	result := transformManifest(pod, retriever, "HELPERS")
+	result := transformManifest(pod, retriever, "HELPERS", 0)
 	testutil.CheckDeepEqual(t, true, result)
 	debugConfig := pod.ObjectMeta.Annotations["debug.cloud.google.com/config"]
 	testutil.CheckDeepEqual(t, true, strings.Contains(debugConfig, `"artifact":"gcr.io/random/image"`))
@@ -655,7 +655,7 @@ func TestTransformPodSpecSkips(t *testing.T) {
 	}
 
 	copy := pod
-	result := transformManifest(&pod, retriever, "HELPERS")
+	result := transformManifest(&pod, retriever, "HELPERS", 0)
 	testutil.CheckDeepEqual(t, false, result)
 	testutil.CheckDeepEqual(t, copy, pod) // should be unchanged
 }
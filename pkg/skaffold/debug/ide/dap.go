@@ -0,0 +1,37 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ide
+
+import (
+	"encoding/json"
+)
+
+// writeDAPConfigurations writes the attach targets as a JSON list, for editors and
+// Debug Adapter Protocol clients that don't read VS Code's `launch.json`.
+func writeDAPConfigurations(path string, targets []attachTarget) error {
+	if targets == nil {
+		targets = []attachTarget{}
+	}
+
+	content, err := json.MarshalIndent(struct {
+		Configurations []attachTarget `json:"configurations"`
+	}{targets}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeIfChanged(path, append(content, '\n'))
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ide writes the launch configurations that IDEs use to attach to the containers
+// of a `skaffold debug` session, from the debugging containers and the forwarded ports.
+package ide
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+const (
+	// VSCode writes the configurations to `.vscode/launch.json`.
+	VSCode = "vscode"
+	// JetBrains writes shared run configurations to `.run/`.
+	JetBrains = "jetbrains"
+	// DAP writes adapter-agnostic attach configurations to `.skaffold/dap.json`.
+	DAP = "dap"
+
+	// namePrefix prefixes the names of the generated configurations, to tell them apart from the user's own.
+	namePrefix = "Skaffold: "
+
+	// localhost is the address that the debug ports are forwarded to.
+	localhost = "localhost"
+
+	// xdebugPort is the port that Xdebug connects to by default.
+	xdebugPort = 9003
+)
+
+// attachTarget describes how to attach a debugger to a debuggable container.
+type attachTarget struct {
+	Name       string `json:"name"`
+	Runtime    string `json:"runtime"`
+	Host       string `json:"host"`
+	Port       int32  `json:"port"`
+	PortName   string `json:"portName"`
+	Namespace  string `json:"namespace"`
+	PodName    string `json:"podName"`
+	Container  string `json:"containerName"`
+	LocalRoot  string `json:"localRoot,omitempty"`
+	RemoteRoot string `json:"remoteRoot,omitempty"`
+}
+
+// ConfigWriter keeps the launch configurations of an IDE up to date
+// as debuggable containers start and stop and their ports are forwarded.
+type ConfigWriter struct {
+	format     string
+	dir        string
+	workspaces map[string]string
+
+	lock    sync.Mutex
+	stopped bool
+	// lastErr is the last error that was reported, to avoid repeating it on each event
+	lastErr string
+}
+
+// NewConfigWriter creates a ConfigWriter for the given IDE (`VSCode`, `JetBrains` or `DAP`), writing in the
+// project directory `dir`. Source paths are mapped from the containers' working directories to the workspaces
+// of the `artifacts`.
+func NewConfigWriter(format, dir string, artifacts []*latest.Artifact) (*ConfigWriter, error) {
+	workspaces := make(map[string]string)
+	for _, a := range artifacts {
+		workspace, err := filepath.Abs(a.Workspace)
+		if err != nil {
+			return nil, fmt.Errorf("resolving workspace of %q: %w", a.ImageName, err)
+		}
+		workspaces[a.ImageName] = workspace
+	}
+
+	return &ConfigWriter{
+		format:     format,
+		dir:        dir,
+		workspaces: workspaces,
+	}, nil
+}
+
+// Start updates the launch configurations on each change of the debugging containers or forwarded ports.
+func (w *ConfigWriter) Start(ctx context.Context) {
+	if w == nil {
+		// no IDE configuration requested
+		return
+	}
+
+	go event.ForEachEvent(func(entry *proto.LogEntry) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		switch entry.GetEvent().GetEventType().(type) {
+		case *proto.Event_PortEvent, *proto.Event_DebuggingContainerEvent:
+			state, err := event.GetState()
+			if err != nil {
+				return err
+			}
+			w.update(attachTargets(state, w.workspaces))
+		}
+		return nil
+	})
+}
+
+// Stop removes the generated launch configurations, as the containers they attach to are going away.
+func (w *ConfigWriter) Stop() {
+	if w == nil {
+		return
+	}
+
+	w.update(nil)
+
+	w.lock.Lock()
+	w.stopped = true
+	w.lock.Unlock()
+}
+
+func (w *ConfigWriter) update(targets []attachTarget) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.stopped {
+		return
+	}
+
+	err := w.write(targets)
+	switch {
+	case err == nil:
+		w.lastErr = ""
+	case err.Error() != w.lastErr:
+		w.lastErr = err.Error()
+		logrus.Warnf("Unable to write %s launch configurations: %v", w.format, err)
+	}
+}
+
+func (w *ConfigWriter) write(targets []attachTarget) error {
+	switch w.format {
+	case VSCode:
+		return writeVSCodeConfigurations(filepath.Join(w.dir, ".vscode", "launch.json"), targets)
+	case JetBrains:
+		return writeJetBrainsConfigurations(filepath.Join(w.dir, ".run"), targets)
+	default:
+		return writeDAPConfigurations(filepath.Join(w.dir, ".skaffold", "dap.json"), targets)
+	}
+}
+
+// attachTargets matches the ports of the debugging containers with the local ports they are forwarded to.
+func attachTargets(state *proto.State, workspaces map[string]string) []attachTarget {
+	var targets []attachTarget
+	seen := make(map[string]bool)
+
+	for _, c := range sortedContainers(state.DebuggingContainers) {
+		debugPorts := c.DebugPorts
+		if c.Runtime == "php" && len(debugPorts) == 0 {
+			// Xdebug connects to the IDE, which listens on Xdebug's default port
+			debugPorts = map[string]uint32{"dbgp": xdebugPort}
+		}
+
+		for _, portName := range sortedKeys(debugPorts) {
+			port := int32(debugPorts[portName])
+
+			localPort, found := forwardedPort(state.ForwardedPorts, c, port)
+			if !found {
+				// the configuration is written once the port is forwarded
+				continue
+			}
+
+			name := namePrefix + c.ContainerName
+			if c.Artifact != "" && c.Artifact != c.ContainerName {
+				name += " (" + c.Artifact + ")"
+			}
+			if len(debugPorts) > 1 {
+				name += " " + portName
+			}
+			// replicas of a workload share the same configuration
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			targets = append(targets, attachTarget{
+				Name:       name,
+				Runtime:    c.Runtime,
+				Host:       localhost,
+				Port:       localPort,
+				PortName:   portName,
+				Namespace:  c.Namespace,
+				PodName:    c.PodName,
+				Container:  c.ContainerName,
+				LocalRoot:  workspaces[c.Artifact],
+				RemoteRoot: c.WorkingDir,
+			})
+		}
+	}
+
+	return targets
+}
+
+// forwardedPort finds the local port that a container's port is forwarded to.
+func forwardedPort(forwarded map[int32]*proto.PortEvent, c *proto.DebuggingContainerEvent, port int32) (int32, bool) {
+	// Xdebug connects to the IDE, so its port isn't forwarded
+	if c.Runtime == "php" {
+		return port, true
+	}
+
+	for _, pe := range forwarded {
+		if pe.Namespace == c.Namespace && pe.PodName == c.PodName && pe.ContainerName == c.ContainerName && pe.RemotePort == port {
+			return pe.LocalPort, true
+		}
+	}
+	return 0, false
+}
+
+func sortedContainers(containers []*proto.DebuggingContainerEvent) []*proto.DebuggingContainerEvent {
+	sorted := append([]*proto.DebuggingContainerEvent(nil), containers...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Namespace != sorted[j].Namespace {
+			return sorted[i].Namespace < sorted[j].Namespace
+		}
+		if sorted[i].PodName != sorted[j].PodName {
+			return sorted[i].PodName < sorted[j].PodName
+		}
+		return sorted[i].ContainerName < sorted[j].ContainerName
+	})
+	return sorted
+}
+
+func sortedKeys(m map[string]uint32) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeIfChanged writes a file, unless it already has the given content.
+func writeIfChanged(path string, content []byte) error {
+	if existing, err := ioutil.ReadFile(path); err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	logrus.Debugf("Writing launch configurations to %s", path)
+	return ioutil.WriteFile(path, content, 0644)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ide
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestAttachTargets(t *testing.T) {
+	state := &proto.State{
+		DebuggingContainers: []*proto.DebuggingContainerEvent{
+			{Namespace: "ns", PodName: "web-2", ContainerName: "web", Artifact: "go-app", Runtime: "go", WorkingDir: "/app", DebugPorts: map[string]uint32{"dlv": 56268}},
+			{Namespace: "ns", PodName: "web-1", ContainerName: "web", Artifact: "go-app", Runtime: "go", WorkingDir: "/app", DebugPorts: map[string]uint32{"dlv": 56268}},
+			{Namespace: "ns", PodName: "api-1", ContainerName: "api", Artifact: "jvm-app", Runtime: "jvm", DebugPorts: map[string]uint32{"jdwp": 5005}},
+			{Namespace: "ns", PodName: "php-1", ContainerName: "php", Artifact: "php", Runtime: "php", WorkingDir: "/var/www"},
+		},
+		ForwardedPorts: map[int32]*proto.PortEvent{
+			56268: {Namespace: "ns", PodName: "web-1", ContainerName: "web", RemotePort: 56268, LocalPort: 56268},
+			56269: {Namespace: "ns", PodName: "web-2", ContainerName: "web", RemotePort: 56268, LocalPort: 56269},
+			// a terminated pod
+			56270: {Namespace: "ns", PodName: "web-0", ContainerName: "web", RemotePort: 56268, LocalPort: 56270},
+		},
+	}
+
+	targets := attachTargets(state, map[string]string{"go-app": "/src/go", "php": "/src/php"})
+
+	testutil.CheckDeepEqual(t, []attachTarget{
+		// the api's port isn't forwarded yet
+		{Name: "Skaffold: php", Runtime: "php", Host: "localhost", Port: 9003, PortName: "dbgp", Namespace: "ns", PodName: "php-1", Container: "php", LocalRoot: "/src/php", RemoteRoot: "/var/www"},
+		{Name: "Skaffold: web (go-app)", Runtime: "go", Host: "localhost", Port: 56268, PortName: "dlv", Namespace: "ns", PodName: "web-1", Container: "web", LocalRoot: "/src/go", RemoteRoot: "/app"},
+	}, targets)
+}
+
+func TestWriteVSCodeConfigurations(t *testing.T) {
+	tests := []struct {
+		description string
+		existing    string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "new file",
+			expected: `{
+  "configurations": [
+    {
+      "host": "localhost",
+      "mode": "remote",
+      "name": "Skaffold: web",
+      "port": 56268,
+      "request": "attach",
+      "substitutePath": [
+        {
+          "from": "/src",
+          "to": "/app"
+        }
+      ],
+      "type": "go"
+    }
+  ],
+  "version": "0.2.0"
+}
+`,
+		},
+		{
+			description: "keep user configurations",
+			existing:    `{"version":"0.2.0","configurations":[{"name":"Skaffold: old","type":"go"},{"name":"Launch","type":"node"}],"compounds":[]}`,
+			expected: `{
+  "compounds": [],
+  "configurations": [
+    {
+      "name": "Launch",
+      "type": "node"
+    },
+    {
+      "host": "localhost",
+      "mode": "remote",
+      "name": "Skaffold: web",
+      "port": 56268,
+      "request": "attach",
+      "substitutePath": [
+        {
+          "from": "/src",
+          "to": "/app"
+        }
+      ],
+      "type": "go"
+    }
+  ],
+  "version": "0.2.0"
+}
+`,
+		},
+		{
+			description: "comments aren't overwritten",
+			existing:    "// my configurations\n{}",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			if test.existing != "" {
+				tmpDir.Write(".vscode/launch.json", test.existing)
+			}
+
+			err := writeVSCodeConfigurations(tmpDir.Path(".vscode/launch.json"), []attachTarget{
+				{Name: "Skaffold: web", Runtime: "go", Host: "localhost", Port: 56268, LocalRoot: "/src", RemoteRoot: "/app"},
+				{Name: "Skaffold: unknown", Runtime: "unknown", Host: "localhost", Port: 1234},
+			})
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				content, err := ioutil.ReadFile(tmpDir.Path(".vscode/launch.json"))
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.expected, string(content))
+			}
+		})
+	}
+}
+
+func TestConfigWriterStop(t *testing.T) {
+	testutil.Run(t, "remove generated configurations", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write(".vscode/launch.json", `{"version":"0.2.0","configurations":[{"name":"Launch","type":"node"}]}`)
+		w, err := NewConfigWriter(VSCode, tmpDir.Root(), nil)
+		t.CheckNoError(err)
+
+		w.update([]attachTarget{{Name: "Skaffold: web", Runtime: "go", Host: "localhost", Port: 56268}})
+		w.Stop()
+		w.update([]attachTarget{{Name: "Skaffold: web", Runtime: "go", Host: "localhost", Port: 56268}})
+
+		content, err := ioutil.ReadFile(tmpDir.Path(".vscode/launch.json"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`{
+  "configurations": [
+    {
+      "name": "Launch",
+      "type": "node"
+    }
+  ],
+  "version": "0.2.0"
+}
+`, string(content))
+	})
+}
+
+func TestWriteJetBrainsConfigurations(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch(".run/Skaffold_old_dlv.run.xml", ".run/Mine.run.xml")
+
+		err := writeJetBrainsConfigurations(tmpDir.Path(".run"), []attachTarget{
+			{Name: "Skaffold: api (jvm-app)", Runtime: "jvm", Host: "localhost", Port: 5005, PortName: "jdwp", Container: "api"},
+		})
+		t.CheckNoError(err)
+
+		files, err := filepath.Glob(tmpDir.Path(".run/*.run.xml"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{tmpDir.Path(".run/Mine.run.xml"), tmpDir.Path(".run/Skaffold_api_jdwp.run.xml")}, files)
+
+		content, err := ioutil.ReadFile(tmpDir.Path(".run/Skaffold_api_jdwp.run.xml"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`<component name="ProjectRunConfigurationManager">
+  <configuration default="false" name="Skaffold: api (jvm-app)" type="Remote" factoryName="Remote">
+    <option name="USE_SOCKET_TRANSPORT" value="true"></option>
+    <option name="SERVER_MODE" value="false"></option>
+    <option name="HOST" value="localhost"></option>
+    <option name="PORT" value="5005"></option>
+    <method v="2"></method>
+  </configuration>
+</component>
+`, string(content))
+	})
+}
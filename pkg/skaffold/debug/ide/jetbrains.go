@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ide
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+)
+
+// jetbrainsFilePrefix prefixes the names of the generated run configuration files.
+const jetbrainsFilePrefix = "Skaffold_"
+
+var invalidFileCharacters = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+type jetbrainsComponent struct {
+	XMLName       xml.Name               `xml:"component"`
+	Name          string                 `xml:"name,attr"`
+	Configuration jetbrainsConfiguration `xml:"configuration"`
+}
+
+type jetbrainsConfiguration struct {
+	Default     bool              `xml:"default,attr"`
+	Name        string            `xml:"name,attr"`
+	Type        string            `xml:"type,attr"`
+	FactoryName string            `xml:"factoryName,attr"`
+	Host        string            `xml:"host,attr,omitempty"`
+	Port        string            `xml:"port,attr,omitempty"`
+	Options     []jetbrainsOption `xml:"option"`
+	Method      jetbrainsMethod   `xml:"method"`
+}
+
+type jetbrainsOption struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type jetbrainsMethod struct {
+	V string `xml:"v,attr"`
+}
+
+// writeJetBrainsConfigurations writes a shared run configuration per target in the `.run` directory,
+// and removes the generated configurations of the targets that are gone.
+func writeJetBrainsConfigurations(dir string, targets []attachTarget) error {
+	written := make(map[string]bool)
+
+	for _, target := range targets {
+		configuration := jetbrainsRunConfiguration(target)
+		if configuration == nil {
+			logrus.Debugf("No JetBrains run configuration for runtime %q of %s", target.Runtime, target.Name)
+			continue
+		}
+
+		content, err := xml.MarshalIndent(jetbrainsComponent{Name: "ProjectRunConfigurationManager", Configuration: *configuration}, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, jetbrainsFileName(target))
+		if err := writeIfChanged(path, append(content, '\n')); err != nil {
+			return err
+		}
+		written[path] = true
+	}
+
+	generated, err := filepath.Glob(filepath.Join(dir, jetbrainsFilePrefix+"*.run.xml"))
+	if err != nil {
+		return err
+	}
+	for _, path := range generated {
+		if !written[path] {
+			logrus.Debugf("Removing stale run configuration %s", path)
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jetbrainsRunConfiguration returns the remote debug run configuration for a runtime,
+// or nil if there is none.
+func jetbrainsRunConfiguration(t attachTarget) *jetbrainsConfiguration {
+	port := strconv.Itoa(int(t.Port))
+
+	switch t.Runtime {
+	case "go":
+		return &jetbrainsConfiguration{
+			Name:        t.Name,
+			Type:        "GoRemoteDebugConfigurationType",
+			FactoryName: "Go Remote",
+			Options:     []jetbrainsOption{{"host", t.Host}, {"port", port}},
+			Method:      jetbrainsMethod{V: "2"},
+		}
+	case "jvm":
+		return &jetbrainsConfiguration{
+			Name:        t.Name,
+			Type:        "Remote",
+			FactoryName: "Remote",
+			Options: []jetbrainsOption{
+				{"USE_SOCKET_TRANSPORT", "true"},
+				{"SERVER_MODE", "false"},
+				{"HOST", t.Host},
+				{"PORT", port},
+			},
+			Method: jetbrainsMethod{V: "2"},
+		}
+	case "nodejs":
+		return &jetbrainsConfiguration{
+			Name:        t.Name,
+			Type:        "ChromiumRemoteDebugType",
+			FactoryName: "Chromium Remote",
+			Host:        t.Host,
+			Port:        port,
+			Method:      jetbrainsMethod{V: "2"},
+		}
+	default:
+		return nil
+	}
+}
+
+func jetbrainsFileName(t attachTarget) string {
+	name := fmt.Sprintf("%s_%s", t.Container, t.PortName)
+	return jetbrainsFilePrefix + invalidFileCharacters.ReplaceAllString(name, "_") + ".run.xml"
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ide
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// writeVSCodeConfigurations replaces the generated configurations of a `launch.json` file,
+// keeping the user's own configurations.
+func writeVSCodeConfigurations(path string, targets []attachTarget) error {
+	launch := map[string]json.RawMessage{}
+	var configurations []json.RawMessage
+
+	existing, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	default:
+		// VS Code accepts comments and trailing commas in launch.json, which can't be kept
+		// when rewriting it: such a file is left unchanged
+		if err := json.Unmarshal(existing, &launch); err != nil {
+			return fmt.Errorf("parsing %s: %w (remove its comments and trailing commas to let Skaffold update it)", path, err)
+		}
+		if raw, found := launch["configurations"]; found {
+			if err := json.Unmarshal(raw, &configurations); err != nil {
+				return fmt.Errorf("parsing configurations of %s: %w", path, err)
+			}
+		}
+	}
+
+	var kept []json.RawMessage
+	for _, raw := range configurations {
+		var named struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(raw, &named) == nil && strings.HasPrefix(named.Name, namePrefix) {
+			continue
+		}
+		kept = append(kept, raw)
+	}
+
+	for _, target := range targets {
+		configuration := vscodeConfiguration(target)
+		if configuration == nil {
+			logrus.Debugf("No VS Code launch configuration for runtime %q of %s", target.Runtime, target.Name)
+			continue
+		}
+		raw, err := json.Marshal(configuration)
+		if err != nil {
+			return err
+		}
+		kept = append(kept, raw)
+	}
+
+	if kept == nil {
+		kept = []json.RawMessage{}
+	}
+	if launch["version"] == nil {
+		launch["version"] = json.RawMessage(`"0.2.0"`)
+	}
+	if launch["configurations"], err = json.Marshal(kept); err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(launch, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeIfChanged(path, append(content, '\n'))
+}
+
+// vscodeConfiguration returns the attach configuration of the usual VS Code extension for a runtime,
+// or nil if there is none.
+func vscodeConfiguration(t attachTarget) map[string]interface{} {
+	var configuration map[string]interface{}
+
+	switch t.Runtime {
+	case "go":
+		configuration = map[string]interface{}{
+			"type":    "go",
+			"request": "attach",
+			"mode":    "remote",
+			"host":    t.Host,
+			"port":    t.Port,
+		}
+		if mapped(t) {
+			configuration["substitutePath"] = []map[string]string{{"from": t.LocalRoot, "to": t.RemoteRoot}}
+		}
+	case "nodejs":
+		configuration = map[string]interface{}{
+			"type":    "node",
+			"request": "attach",
+			"address": t.Host,
+			"port":    t.Port,
+		}
+		if mapped(t) {
+			configuration["localRoot"] = t.LocalRoot
+			configuration["remoteRoot"] = t.RemoteRoot
+		}
+	case "python":
+		configuration = map[string]interface{}{
+			"type":    "python",
+			"request": "attach",
+			"connect": map[string]interface{}{"host": t.Host, "port": t.Port},
+		}
+		if mapped(t) {
+			configuration["pathMappings"] = []map[string]string{{"localRoot": t.LocalRoot, "remoteRoot": t.RemoteRoot}}
+		}
+	case "jvm":
+		configuration = map[string]interface{}{
+			"type":     "java",
+			"request":  "attach",
+			"hostName": t.Host,
+			"port":     t.Port,
+		}
+	case "ruby":
+		configuration = map[string]interface{}{
+			"type":      "rdbg",
+			"request":   "attach",
+			"debugPort": fmt.Sprintf("%s:%d", t.Host, t.Port),
+		}
+		if mapped(t) {
+			configuration["localfsMap"] = t.RemoteRoot + ":" + t.LocalRoot
+		}
+	case "php":
+		// Xdebug connects to the IDE
+		configuration = map[string]interface{}{
+			"type":    "php",
+			"request": "launch",
+			"port":    t.Port,
+		}
+		if mapped(t) {
+			configuration["pathMappings"] = map[string]string{t.RemoteRoot: t.LocalRoot}
+		}
+	case "rust":
+		configuration = map[string]interface{}{
+			"type":                  "lldb",
+			"request":               "custom",
+			"processCreateCommands": []string{fmt.Sprintf("gdb-remote %s:%d", t.Host, t.Port)},
+		}
+		if mapped(t) {
+			configuration["sourceMap"] = map[string]string{t.RemoteRoot: t.LocalRoot}
+		}
+	default:
+		return nil
+	}
+
+	configuration["name"] = t.Name
+	return configuration
+}
+
+// mapped tells if the sources of a target can be mapped between the container and the workspace.
+func mapped(t attachTarget) bool {
+	return t.LocalRoot != "" && t.RemoteRoot != ""
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// RemoveProbes is the probe timeout that removes the liveness and readiness probes of debugged containers.
+const RemoveProbes time.Duration = -1
+
+// ParseProbeTimeout parses the timeout given to the probes of debugged containers:
+// a duration, `0` to leave the probes unchanged, or `remove` to remove them.
+func ParseProbeTimeout(value string) (time.Duration, error) {
+	switch value {
+	case "":
+		return 0, nil
+	case "remove":
+		return RemoveProbes, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("invalid probe timeout %q: must be a duration, '0' or 'remove'", value)
+	}
+	return timeout, nil
+}
+
+// waitingRuntimes don't start the program until a debugger attaches.
+var waitingRuntimes = map[string]bool{
+	// gdbserver holds the program at its first instruction
+	"rust": true,
+}
+
+// runtimeProbeTimeout returns the probe timeout for the containers of a runtime:
+// the probes of a program that waits for a debugger can't succeed, so they are removed.
+func runtimeProbeTimeout(runtime string, timeout time.Duration) time.Duration {
+	if waitingRuntimes[runtime] {
+		return RemoveProbes
+	}
+	return timeout
+}
+
+// relaxProbes widens the timeouts of the liveness and readiness probes of a container, so that the container
+// isn't restarted or taken out of service while stopped at a breakpoint, or removes them.
+// Returns the original probes, keyed by type, or nil if nothing was changed.
+func relaxProbes(container *v1.Container, timeout time.Duration) map[string]*v1.Probe {
+	if timeout == 0 {
+		return nil
+	}
+	seconds := int32(math.Ceil(timeout.Seconds()))
+
+	originals := make(map[string]*v1.Probe)
+	for probeType, probe := range map[string]**v1.Probe{
+		"liveness":  &container.LivenessProbe,
+		"readiness": &container.ReadinessProbe,
+	} {
+		switch {
+		case *probe == nil:
+			continue
+		case timeout == RemoveProbes:
+			originals[probeType] = *probe
+			*probe = nil
+		case (*probe).TimeoutSeconds < seconds:
+			// the container may share its probes with the original pod spec
+			originals[probeType] = *probe
+			*probe = (*probe).DeepCopy()
+			(*probe).TimeoutSeconds = seconds
+		}
+	}
+
+	if len(originals) == 0 {
+		return nil
+	}
+	return originals
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParseProbeTimeout(t *testing.T) {
+	tests := []struct {
+		value     string
+		expected  time.Duration
+		shouldErr bool
+	}{
+		{value: "", expected: 0},
+		{value: "0", expected: 0},
+		{value: "10m", expected: 10 * time.Minute},
+		{value: "remove", expected: RemoveProbes},
+		{value: "-1s", shouldErr: true},
+		{value: "forever", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.value, func(t *testutil.T) {
+			timeout, err := ParseProbeTimeout(test.value)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, timeout)
+		})
+	}
+}
+
+func TestRelaxProbes(t *testing.T) {
+	liveness := &v1.Probe{Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz"}}, TimeoutSeconds: 1}
+	readiness := &v1.Probe{Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/ready"}}, TimeoutSeconds: 900}
+
+	tests := []struct {
+		description       string
+		timeout           time.Duration
+		expectedLiveness  *v1.Probe
+		expectedReadiness *v1.Probe
+		expectedOriginals map[string]*v1.Probe
+	}{
+		{
+			description:       "unchanged",
+			timeout:           0,
+			expectedLiveness:  liveness,
+			expectedReadiness: readiness,
+		},
+		{
+			description:       "widened",
+			timeout:           90 * time.Second,
+			expectedLiveness:  &v1.Probe{Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz"}}, TimeoutSeconds: 90},
+			expectedReadiness: readiness,
+			expectedOriginals: map[string]*v1.Probe{"liveness": liveness},
+		},
+		{
+			description:       "rounded up",
+			timeout:           1500 * time.Millisecond,
+			expectedLiveness:  &v1.Probe{Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz"}}, TimeoutSeconds: 2},
+			expectedReadiness: readiness,
+			expectedOriginals: map[string]*v1.Probe{"liveness": liveness},
+		},
+		{
+			description:       "removed",
+			timeout:           RemoveProbes,
+			expectedOriginals: map[string]*v1.Probe{"liveness": liveness, "readiness": readiness},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			container := v1.Container{LivenessProbe: liveness, ReadinessProbe: readiness}
+
+			originals := relaxProbes(&container, test.timeout)
+
+			t.CheckDeepEqual(test.expectedLiveness, container.LivenessProbe)
+			t.CheckDeepEqual(test.expectedReadiness, container.ReadinessProbe)
+			t.CheckDeepEqual(test.expectedOriginals, originals)
+			// the original probes are left untouched
+			t.CheckDeepEqual(int32(1), liveness.TimeoutSeconds)
+		})
+	}
+}
+
+func TestTransformManifestRelaxesProbes(t *testing.T) {
+	podSpec := func() v1.PodSpec {
+		return v1.PodSpec{Containers: []v1.Container{{
+			Name:           "test",
+			Command:        []string{"/app/server"},
+			LivenessProbe:  &v1.Probe{Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"true"}}}, TimeoutSeconds: 5},
+			ReadinessProbe: &v1.Probe{Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"true"}}}},
+		}}}
+	}
+	tests := []struct {
+		description string
+		object      runtime.Object
+		podSpec     func(runtime.Object) *v1.PodSpec
+		annotations func(runtime.Object) map[string]string
+	}{
+		{
+			description: "pod",
+			object:      &v1.Pod{Spec: podSpec()},
+			podSpec:     func(o runtime.Object) *v1.PodSpec { return &o.(*v1.Pod).Spec },
+			annotations: func(o runtime.Object) map[string]string { return o.(*v1.Pod).Annotations },
+		},
+		{
+			description: "deployment",
+			object:      &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: v1.PodTemplateSpec{Spec: podSpec()}}},
+			podSpec:     func(o runtime.Object) *v1.PodSpec { return &o.(*appsv1.Deployment).Spec.Template.Spec },
+			annotations: func(o runtime.Object) map[string]string { return o.(*appsv1.Deployment).Spec.Template.Annotations },
+		},
+		{
+			description: "statefulset",
+			object:      &appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Template: v1.PodTemplateSpec{Spec: podSpec()}}},
+			podSpec:     func(o runtime.Object) *v1.PodSpec { return &o.(*appsv1.StatefulSet).Spec.Template.Spec },
+			annotations: func(o runtime.Object) map[string]string { return o.(*appsv1.StatefulSet).Spec.Template.Annotations },
+		},
+		{
+			description: "job",
+			object:      &batchv1.Job{Spec: batchv1.JobSpec{Template: v1.PodTemplateSpec{Spec: podSpec()}}},
+			podSpec:     func(o runtime.Object) *v1.PodSpec { return &o.(*batchv1.Job).Spec.Template.Spec },
+			annotations: func(o runtime.Object) map[string]string { return o.(*batchv1.Job).Spec.Template.Annotations },
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			retriever := func(image string) (imageConfiguration, error) {
+				return imageConfiguration{env: map[string]string{"PHP_VERSION": "8.0.0"}}, nil
+			}
+
+			result := transformManifest(test.object, retriever, "HELPERS", time.Minute)
+
+			t.CheckDeepEqual(true, result)
+			container := test.podSpec(test.object).Containers[0]
+			t.CheckDeepEqual(int32(60), container.LivenessProbe.TimeoutSeconds)
+			t.CheckDeepEqual(int32(60), container.ReadinessProbe.TimeoutSeconds)
+			t.CheckDeepEqual(`{"test":{"runtime":"php","originalProbes":{"liveness":{"exec":{"command":["true"]},"timeoutSeconds":5},"readiness":{"exec":{"command":["true"]}}}}}`,
+				test.annotations(test.object)[DebugConfigAnnotation])
+		})
+	}
+}
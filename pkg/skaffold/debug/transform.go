@@ -54,7 +54,9 @@ package debug
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	shell "github.com/kballard/go-shellquote"
 	"github.com/sirupsen/logrus"
@@ -64,6 +66,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 // ContainerDebugConfiguration captures debugging information for a specific container.
@@ -71,12 +75,14 @@ import (
 type ContainerDebugConfiguration struct {
 	// Artifact is the corresponding artifact's image name used in the skaffold.yaml
 	Artifact string `json:"artifact,omitempty"`
-	// Runtime represents the underlying language runtime (`go`, `jvm`, `nodejs`, `python`, `netcore`)
+	// Runtime represents the underlying language runtime (`go`, `jvm`, `nodejs`, `python`, `netcore`, `ruby`, `php`, `rust`), or the runtime named by a debug recipe
 	Runtime string `json:"runtime,omitempty"`
 	// WorkingDir is the working directory in the image configuration; may be empty
 	WorkingDir string `json:"workingDir,omitempty"`
 	// Ports is the list of debugging ports, keyed by protocol type
 	Ports map[string]uint32 `json:"ports,omitempty"`
+	// OriginalProbes are the liveness and readiness probes before they were relaxed, keyed by type
+	OriginalProbes map[string]*v1.Probe `json:"originalProbes,omitempty"`
 }
 
 // portAllocator is a function that takes a desired port and returns an available port
@@ -97,6 +103,9 @@ type imageConfiguration struct {
 	entrypoint []string
 	arguments  []string
 	workingDir string
+
+	// recipe is the artifact's debug recipe from the skaffold configuration, if any
+	recipe *latest.DebugRecipe
 }
 
 // containerTransformer transforms a container definition
@@ -142,15 +151,15 @@ func isEntrypointLauncher(entrypoint []string) bool {
 
 // transformManifest attempts to configure a manifest for debugging.
 // Returns true if changed, false otherwise.
-func transformManifest(obj runtime.Object, retrieveImageConfiguration configurationRetriever, debugHelpersRegistry string) bool {
+func transformManifest(obj runtime.Object, retrieveImageConfiguration configurationRetriever, debugHelpersRegistry string, probeTimeout time.Duration) bool {
 	one := int32(1)
 	switch o := obj.(type) {
 	case *v1.Pod:
-		return transformPodSpec(&o.ObjectMeta, &o.Spec, retrieveImageConfiguration, debugHelpersRegistry)
+		return transformPodSpec(&o.ObjectMeta, &o.Spec, retrieveImageConfiguration, debugHelpersRegistry, probeTimeout)
 	case *v1.PodList:
 		changed := false
 		for i := range o.Items {
-			if transformPodSpec(&o.Items[i].ObjectMeta, &o.Items[i].Spec, retrieveImageConfiguration, debugHelpersRegistry) {
+			if transformPodSpec(&o.Items[i].ObjectMeta, &o.Items[i].Spec, retrieveImageConfiguration, debugHelpersRegistry, probeTimeout) {
 				changed = true
 			}
 		}
@@ -159,26 +168,26 @@ func transformManifest(obj runtime.Object, retrieveImageConfiguration configurat
 		if o.Spec.Replicas != nil {
 			o.Spec.Replicas = &one
 		}
-		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry)
+		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry, probeTimeout)
 	case *appsv1.Deployment:
 		if o.Spec.Replicas != nil {
 			o.Spec.Replicas = &one
 		}
-		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry)
+		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry, probeTimeout)
 	case *appsv1.DaemonSet:
-		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry)
+		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry, probeTimeout)
 	case *appsv1.ReplicaSet:
 		if o.Spec.Replicas != nil {
 			o.Spec.Replicas = &one
 		}
-		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry)
+		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry, probeTimeout)
 	case *appsv1.StatefulSet:
 		if o.Spec.Replicas != nil {
 			o.Spec.Replicas = &one
 		}
-		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry)
+		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry, probeTimeout)
 	case *batchv1.Job:
-		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry)
+		return transformPodSpec(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec, retrieveImageConfiguration, debugHelpersRegistry, probeTimeout)
 
 	default:
 		group, version, _, description := describe(obj)
@@ -198,7 +207,7 @@ func transformManifest(obj runtime.Object, retrieveImageConfiguration configurat
 
 // transformPodSpec attempts to configure a podspec for debugging.
 // Returns true if changed, false otherwise.
-func transformPodSpec(metadata *metav1.ObjectMeta, podSpec *v1.PodSpec, retrieveImageConfiguration configurationRetriever, debugHelpersRegistry string) bool {
+func transformPodSpec(metadata *metav1.ObjectMeta, podSpec *v1.PodSpec, retrieveImageConfiguration configurationRetriever, debugHelpersRegistry string, probeTimeout time.Duration) bool {
 	// skip annotated podspecs — allows users to customize their own image
 	if _, found := metadata.Annotations[DebugConfigAnnotation]; found {
 		return false
@@ -228,6 +237,8 @@ func transformPodSpec(metadata *metav1.ObjectMeta, podSpec *v1.PodSpec, retrieve
 			if configuration.WorkingDir == "" {
 				configuration.WorkingDir = imageConfig.workingDir
 			}
+			// a container stopped at a breakpoint doesn't answer its probes
+			configuration.OriginalProbes = relaxProbes(&container, runtimeProbeTimeout(configuration.Runtime, probeTimeout))
 			configurations[container.Name] = configuration
 			podSpec.Containers[i] = container // apply any configuration changes
 			if len(requiredImage) > 0 {
@@ -252,8 +263,8 @@ func transformPodSpec(metadata *metav1.ObjectMeta, podSpec *v1.PodSpec, retrieve
 		// the initContainers are responsible for populating the contents of `/dbg`
 		for imageID := range requiredSupportImages {
 			supportFilesInitContainer := v1.Container{
-				Name:         fmt.Sprintf("install-%s-debug-support", imageID),
-				Image:        fmt.Sprintf("%s/%s", debugHelpersRegistry, imageID),
+				Name:         fmt.Sprintf("install-%s-debug-support", supportImageName(imageID)),
+				Image:        supportImage(imageID, debugHelpersRegistry),
 				VolumeMounts: []v1.VolumeMount{supportVolumeMount},
 			}
 			podSpec.InitContainers = append(podSpec.InitContainers, supportFilesInitContainer)
@@ -274,6 +285,34 @@ func transformPodSpec(metadata *metav1.ObjectMeta, podSpec *v1.PodSpec, retrieve
 	return false
 }
 
+// supportImage returns the image providing the debugging support files for an image ID.
+// Image IDs of the built-in runtimes are relative to the debug helpers registry,
+// whereas the images of debug recipes are full image references.
+func supportImage(imageID string, debugHelpersRegistry string) string {
+	if isImageReference(imageID) {
+		return imageID
+	}
+	return fmt.Sprintf("%s/%s", debugHelpersRegistry, imageID)
+}
+
+// supportImageName returns a name for the init container installing the support files of an image ID.
+func supportImageName(imageID string) string {
+	if !isImageReference(imageID) {
+		return imageID
+	}
+	name := imageID[strings.LastIndex(imageID, "/")+1:]
+	if i := strings.IndexAny(name, ":@"); i != -1 {
+		name = name[:i]
+	}
+	return strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+var invalidNameCharacters = regexp.MustCompile("[^a-z0-9-]+")
+
+func isImageReference(imageID string) bool {
+	return strings.ContainsAny(imageID, "/:@")
+}
+
 // allocatePort walks the podSpec's containers looking for an available port that is close to desiredPort.
 // We deal with wrapping and avoid allocating ports < 1024
 func allocatePort(podSpec *v1.PodSpec, desiredPort int32) int32 {
@@ -312,6 +351,20 @@ func isPortAvailable(podSpec *v1.PodSpec, port int32) bool {
 // Returns a debugging configuration description with associated language runtime support
 // container image, or an error if the rewrite was unsuccessful.
 func transformContainer(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	config = containerConfiguration(*container, config)
+
+	// Apply command-line unwrapping for buildpack images and images using `sh -c`-style command-lines
+	next := func(container *v1.Container, config imageConfiguration) (ContainerDebugConfiguration, string, error) {
+		return performContainerTransform(container, config, portAlloc)
+	}
+	if isCNBImage(config) {
+		return updateForCNBImage(container, config, next)
+	}
+	return updateForShDashC(container, config, next)
+}
+
+// containerConfiguration updates the image configuration with the settings of the k8s container.
+func containerConfiguration(container v1.Container, config imageConfiguration) imageConfiguration {
 	// Update the image configuration's environment with those set in the k8s manifest.
 	// (Environment variables in the k8s container's `env` add to the image configuration's `env` settings rather than replace.)
 	for _, envVar := range container.Env {
@@ -328,15 +381,7 @@ func transformContainer(container *v1.Container, config imageConfiguration, port
 	if len(container.Args) > 0 {
 		config.arguments = container.Args
 	}
-
-	// Apply command-line unwrapping for buildpack images and images using `sh -c`-style command-lines
-	next := func(container *v1.Container, config imageConfiguration) (ContainerDebugConfiguration, string, error) {
-		return performContainerTransform(container, config, portAlloc)
-	}
-	if isCNBImage(config) {
-		return updateForCNBImage(container, config, next)
-	}
-	return updateForShDashC(container, config, next)
+	return config
 }
 
 func updateForShDashC(container *v1.Container, ic imageConfiguration, transformer func(*v1.Container, imageConfiguration) (ContainerDebugConfiguration, string, error)) (ContainerDebugConfiguration, string, error) {
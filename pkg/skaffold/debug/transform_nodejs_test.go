@@ -674,7 +674,7 @@ func TestTransformManifestNodeJS(t *testing.T) {
 			retriever := func(image string) (imageConfiguration, error) {
 				return imageConfiguration{}, nil
 			}
-			result := transformManifest(value, retriever, "HELPERS")
+			result := transformManifest(value, retriever, "HELPERS", 0)
 
 			t.CheckDeepEqual(test.transformed, result)
 			t.CheckDeepEqual(test.out, value)
@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+type phpTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, phpTransformer{})
+}
+
+const (
+	// Xdebug 3 connects to port 9003 by default
+	defaultXdebugPort = 9003
+
+	// host.docker.internal is how Xdebug's documentation suggests to reach the host from a container
+	defaultXdebugClientHost = "host.docker.internal"
+)
+
+// xdebugSpec captures the useful Xdebug settings
+type xdebugSpec struct {
+	clientHost string
+	port       int32
+}
+
+// isLaunchingPhp determines if the arguments seems to be invoking php, php-fpm or the official images' apache launcher
+func isLaunchingPhp(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	command := filepath.Base(args[0])
+	return command == "php" || strings.HasPrefix(command, "php-fpm") || command == "apache2-foreground"
+}
+
+func (t phpTransformer) IsApplicable(config imageConfiguration) bool {
+	// PHP_VERSION and PHP_INI_DIR are defined in the official Docker `php` images
+	for _, v := range []string{"PHP_VERSION", "PHP_INI_DIR"} {
+		if _, found := config.env[v]; found {
+			return true
+		}
+	}
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingPhp(config.entrypoint)
+	}
+	return isLaunchingPhp(config.arguments)
+}
+
+// Apply configures a container definition for PHP with Xdebug. The extension must be installed in the image.
+// Unlike other debuggers, Xdebug connects to the IDE at `client_host`, so no port is exposed on the container
+// nor reported as a debug port: there's nothing to forward, and the IDE must be reachable from the container.
+// Xdebug is configured through environment variables rather than the command-line, as these are also
+// seen by the workers that php-fpm and apache start.
+// Returns a simple map describing the debug configuration details.
+func (t phpTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for PHP debugging", container.Name)
+
+	// try to find existing Xdebug settings
+	spec := retrieveXdebugSpec(config)
+
+	if spec == nil {
+		// the port is on the IDE's side, so it isn't allocated among the pod's ports
+		spec = &xdebugSpec{clientHost: defaultXdebugClientHost, port: defaultXdebugPort}
+		// keep the client_host and client_port that the image already sets
+		others, err := spec.parse(config.env["XDEBUG_CONFIG"])
+		if err != nil {
+			return ContainerDebugConfiguration{}, "", err
+		}
+
+		mode := "debug"
+		if v, found := config.env["XDEBUG_MODE"]; found && v != "" && v != "off" {
+			mode = v + ",debug"
+		}
+		container.Env = setEnvVar(container.Env, "XDEBUG_MODE", mode)
+
+		settings := spec.String()
+		if len(others) > 0 {
+			settings = strings.Join(others, " ") + " " + settings
+		}
+		container.Env = setEnvVar(container.Env, "XDEBUG_CONFIG", settings)
+	}
+
+	logrus.Warnf("Xdebug in %q connects to the IDE at %s:%d, which must be reachable from the container", container.Name, spec.clientHost, spec.port)
+
+	return ContainerDebugConfiguration{Runtime: "php"}, "", nil
+}
+
+// retrieveXdebugSpec finds the Xdebug settings if debugging is already enabled
+func retrieveXdebugSpec(config imageConfiguration) *xdebugSpec {
+	mode, found := config.env["XDEBUG_MODE"]
+	if !found {
+		return nil
+	}
+	debugging := false
+	for _, m := range strings.Split(mode, ",") {
+		if strings.TrimSpace(m) == "debug" {
+			debugging = true
+		}
+	}
+	if !debugging {
+		return nil
+	}
+
+	spec := xdebugSpec{clientHost: "localhost", port: defaultXdebugPort}
+	if _, err := spec.parse(config.env["XDEBUG_CONFIG"]); err != nil {
+		logrus.Errorf("%s\n", err)
+		return nil
+	}
+	return &spec
+}
+
+// parse reads the client_host and client_port from Xdebug settings and returns the other settings
+func (spec *xdebugSpec) parse(settings string) ([]string, error) {
+	var others []string
+	for _, setting := range strings.Fields(settings) {
+		split := strings.SplitN(setting, "=", 2)
+		if len(split) != 2 {
+			others = append(others, setting)
+			continue
+		}
+		switch split[0] {
+		case "client_host":
+			spec.clientHost = split[1]
+		case "client_port":
+			port, err := strconv.ParseInt(split[1], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Xdebug client_port %q: %w", split[1], err)
+			}
+			spec.port = int32(port)
+		default:
+			others = append(others, setting)
+		}
+	}
+	return others, nil
+}
+
+func (spec xdebugSpec) String() string {
+	return "client_host=" + spec.clientHost + " client_port=" + strconv.FormatInt(int64(spec.port), 10) + " start_with_request=yes"
+}
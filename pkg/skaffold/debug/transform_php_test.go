@@ -0,0 +1,194 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRetrieveXdebugSpec(t *testing.T) {
+	tests := []struct {
+		description string
+		env         map[string]string
+		result      *xdebugSpec
+	}{
+		{"no env", nil, nil},
+		{"not debugging", map[string]string{"XDEBUG_MODE": "coverage"}, nil},
+		{"debugging", map[string]string{"XDEBUG_MODE": "develop,debug"}, &xdebugSpec{clientHost: "localhost", port: 9003}},
+		{"client settings", map[string]string{"XDEBUG_MODE": "debug", "XDEBUG_CONFIG": "client_host=10.0.0.1 client_port=9000 idekey=VSCODE"}, &xdebugSpec{clientHost: "10.0.0.1", port: 9000}},
+		{"invalid port", map[string]string{"XDEBUG_MODE": "debug", "XDEBUG_CONFIG": "client_port=foo"}, nil},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			spec := retrieveXdebugSpec(imageConfiguration{env: test.env})
+
+			if test.result == nil {
+				t.CheckDeepEqual(test.result, spec)
+			} else {
+				t.CheckDeepEqual(*test.result, *spec, cmp.AllowUnexported(xdebugSpec{}))
+			}
+		})
+	}
+}
+
+func TestPhpTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "PHP_VERSION",
+			source:      imageConfiguration{env: map[string]string{"PHP_VERSION": "7.4.12"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint php",
+			source:      imageConfiguration{entrypoint: []string{"php", "artisan", "serve"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint php-fpm",
+			source:      imageConfiguration{entrypoint: []string{"/usr/sbin/php-fpm7.4", "-F"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint launcher, args apache2-foreground",
+			source:      imageConfiguration{entrypoint: []string{"docker-php-entrypoint"}, arguments: []string{"apache2-foreground"}},
+			launcher:    "docker-php-entrypoint",
+			result:      true,
+		},
+		{
+			description: "entrypoint /bin/sh",
+			source:      imageConfiguration{entrypoint: []string{"/bin/sh"}, arguments: []string{"php"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := phpTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestPhpTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		configuration imageConfiguration
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+	}{
+		{
+			description:   "basic",
+			configuration: imageConfiguration{arguments: []string{"apache2-foreground"}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "XDEBUG_MODE", Value: "debug"},
+					{Name: "XDEBUG_CONFIG", Value: "client_host=host.docker.internal client_port=9003 start_with_request=yes"},
+				},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "php"},
+		},
+		{
+			description:   "existing settings",
+			configuration: imageConfiguration{arguments: []string{"php-fpm"}, env: map[string]string{"XDEBUG_MODE": "coverage", "XDEBUG_CONFIG": "idekey=VSCODE"}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "XDEBUG_MODE", Value: "coverage,debug"},
+					{Name: "XDEBUG_CONFIG", Value: "idekey=VSCODE client_host=host.docker.internal client_port=9003 start_with_request=yes"},
+				},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "php"},
+		},
+		{
+			description:   "keeps client host",
+			configuration: imageConfiguration{arguments: []string{"php-fpm"}, env: map[string]string{"XDEBUG_CONFIG": "client_host=10.0.0.2 idekey=VSCODE"}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "XDEBUG_MODE", Value: "debug"},
+					{Name: "XDEBUG_CONFIG", Value: "idekey=VSCODE client_host=10.0.0.2 client_port=9003 start_with_request=yes"},
+				},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "php"},
+		},
+		{
+			description:   "already debugging",
+			configuration: imageConfiguration{arguments: []string{"php-fpm"}, env: map[string]string{"XDEBUG_MODE": "debug", "XDEBUG_CONFIG": "client_port=9000"}},
+			debugConfig:   ContainerDebugConfiguration{Runtime: "php"},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var container v1.Container
+			config, image, err := phpTransformer{}.Apply(&container, test.configuration, identity)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.result, container)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual("", image)
+		})
+	}
+}
+
+func TestTransformManifestPhp(t *testing.T) {
+	testutil.Run(t, "Pod with PHP container", func(t *testutil.T) {
+		pod := &v1.Pod{
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name: "test",
+				Args: []string{"php", "-S", "0.0.0.0:8080"},
+			}}},
+		}
+		retriever := func(image string) (imageConfiguration, error) {
+			return imageConfiguration{}, nil
+		}
+
+		result := transformManifest(pod, retriever, "HELPERS", 0)
+
+		t.CheckDeepEqual(true, result)
+		t.CheckDeepEqual(&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"debug.cloud.google.com/config": `{"test":{"runtime":"php"}}`},
+			},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name: "test",
+				Args: []string{"php", "-S", "0.0.0.0:8080"},
+				Env: []v1.EnvVar{
+					{Name: "XDEBUG_MODE", Value: "debug"},
+					{Name: "XDEBUG_CONFIG", Value: "client_host=host.docker.internal client_port=9003 start_with_request=yes"},
+				},
+			}}},
+		}, pod)
+	})
+}
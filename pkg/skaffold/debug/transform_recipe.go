@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// recipeTransformer applies the debug recipe declared for an artifact in the skaffold.yaml.
+type recipeTransformer struct{}
+
+func init() {
+	// recipes are explicitly declared by the user, so they run ahead of the detected runtimes
+	containerTransforms = append([]containerTransformer{recipeTransformer{}}, containerTransforms...)
+}
+
+// recipeValues are the values available to the templates of a debug recipe
+type recipeValues struct {
+	// Ports are the allocated ports, by name
+	Ports map[string]int32
+	// Command is the original command-line, quoted for a shell
+	Command string
+}
+
+// commandPlaceholder is replaced by the original command-line's arguments when it makes up a whole element
+const commandPlaceholder = "{{.Command}}"
+
+func (t recipeTransformer) IsApplicable(config imageConfiguration) bool {
+	if config.recipe != nil {
+		logrus.Infof("Artifact %q has a debug recipe for runtime %q", config.artifact, config.recipe.Runtime)
+		return true
+	}
+	return false
+}
+
+// Apply configures a container definition as described by the artifact's debug recipe.
+// Returns a simple map describing the debug configuration details.
+func (t recipeTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	recipe := config.recipe
+	logrus.Infof("Configuring %q for %s debugging with a debug recipe", container.Name, recipe.Runtime)
+
+	values := recipeValues{Ports: make(map[string]int32)}
+	ports := make(map[string]uint32)
+	// allocate the ports in a stable order
+	var names []string
+	for name := range recipe.Ports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		port := portAlloc(recipe.Ports[name])
+		values.Ports[name] = port
+		ports[name] = uint32(port)
+		container.Ports = exposePort(container.Ports, name, port)
+	}
+
+	if len(recipe.Command) > 0 {
+		switch {
+		case len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint):
+			values.Command = shJoin(config.entrypoint)
+			commandLine, err := rewriteRecipeCommandLine(recipe.Command, config.entrypoint, values)
+			if err != nil {
+				return ContainerDebugConfiguration{}, "", err
+			}
+			container.Command = commandLine
+
+		default:
+			values.Command = shJoin(config.arguments)
+			commandLine, err := rewriteRecipeCommandLine(recipe.Command, config.arguments, values)
+			if err != nil {
+				return ContainerDebugConfiguration{}, "", err
+			}
+			container.Args = commandLine
+		}
+	}
+
+	// set the variables in a stable order
+	names = nil
+	for name := range recipe.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, err := expandRecipeTemplate(recipe.Env[name], values)
+		if err != nil {
+			return ContainerDebugConfiguration{}, "", err
+		}
+		container.Env = setEnvVar(container.Env, name, value)
+	}
+
+	return ContainerDebugConfiguration{
+		Runtime: recipe.Runtime,
+		Ports:   ports,
+	}, recipeSupportImage(recipe), nil
+}
+
+// rewriteRecipeCommandLine expands the command-line template of a debug recipe.
+func rewriteRecipeCommandLine(templates []string, commandLine []string, values recipeValues) ([]string, error) {
+	var rewritten []string
+	for _, element := range templates {
+		if strings.TrimSpace(element) == commandPlaceholder {
+			rewritten = append(rewritten, commandLine...)
+			continue
+		}
+		expanded, err := expandRecipeTemplate(element, values)
+		if err != nil {
+			return nil, err
+		}
+		rewritten = append(rewritten, expanded)
+	}
+	return rewritten, nil
+}
+
+func expandRecipeTemplate(text string, values recipeValues) (string, error) {
+	tmpl, err := template.New("recipe").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parsing debug recipe template %q: %w", text, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("expanding debug recipe template %q: %w", text, err)
+	}
+	return buf.String(), nil
+}
+
+// recipeSupportImage returns the helper image of a debug recipe as a full image reference,
+// so that it isn't mistaken for one of the images from the debug helpers registry.
+func recipeSupportImage(recipe *latest.DebugRecipe) string {
+	if recipe.Image == "" || isImageReference(recipe.Image) {
+		return recipe.Image
+	}
+	return recipe.Image + ":latest"
+}
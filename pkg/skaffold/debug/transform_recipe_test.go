@@ -0,0 +1,215 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRecipeTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		result      bool
+	}{
+		{
+			description: "recipe",
+			source:      imageConfiguration{recipe: &latest.DebugRecipe{Runtime: "elixir"}},
+			result:      true,
+		},
+		{
+			description: "no recipe",
+			source:      imageConfiguration{entrypoint: []string{"/launch.sh"}},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			result := recipeTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestRecipeTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		configuration imageConfiguration
+		shouldErr     bool
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+		image         string
+	}{
+		{
+			description: "runtime only",
+			configuration: imageConfiguration{
+				entrypoint: []string{"/launch.sh"},
+				recipe:     &latest.DebugRecipe{Runtime: "elixir"},
+			},
+			result:      v1.Container{},
+			debugConfig: ContainerDebugConfiguration{Runtime: "elixir", Ports: map[string]uint32{}},
+		},
+		{
+			description: "entrypoint",
+			configuration: imageConfiguration{
+				entrypoint: []string{"/launch.sh", "start"},
+				recipe: &latest.DebugRecipe{
+					Runtime: "elixir",
+					Command: []string{"/debug.sh", "--port={{.Ports.debug}}", "--", "{{.Command}}"},
+					Ports:   map[string]int32{"debug": 4000},
+					Env:     map[string]string{"DEBUG_PORT": "{{.Ports.debug}}", "MIX_ENV": "dev"},
+				},
+			},
+			result: v1.Container{
+				Command: []string{"/debug.sh", "--port=4000", "--", "/launch.sh", "start"},
+				Ports:   []v1.ContainerPort{{Name: "debug", ContainerPort: 4000}},
+				Env:     []v1.EnvVar{{Name: "DEBUG_PORT", Value: "4000"}, {Name: "MIX_ENV", Value: "dev"}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "elixir", Ports: map[string]uint32{"debug": 4000}},
+		},
+		{
+			description: "command not entrypoint",
+			configuration: imageConfiguration{
+				arguments: []string{"/launch.sh", "start now"},
+				recipe: &latest.DebugRecipe{
+					Runtime: "elixir",
+					Command: []string{"sh", "-c", "DEBUG=1 {{.Command}}"},
+				},
+			},
+			result: v1.Container{
+				Args: []string{"sh", "-c", `DEBUG=1 /launch.sh "start now"`},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "elixir", Ports: map[string]uint32{}},
+		},
+		{
+			description: "helper image",
+			configuration: imageConfiguration{
+				entrypoint: []string{"/launch.sh"},
+				recipe:     &latest.DebugRecipe{Runtime: "elixir", Image: "elixir-debug-support"},
+			},
+			result:      v1.Container{},
+			debugConfig: ContainerDebugConfiguration{Runtime: "elixir", Ports: map[string]uint32{}},
+			image:       "elixir-debug-support:latest",
+		},
+		{
+			description: "unknown port",
+			configuration: imageConfiguration{
+				entrypoint: []string{"/launch.sh"},
+				recipe:     &latest.DebugRecipe{Runtime: "elixir", Command: []string{"--port={{.Ports.debug}}"}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "invalid template",
+			configuration: imageConfiguration{
+				entrypoint: []string{"/launch.sh"},
+				recipe:     &latest.DebugRecipe{Runtime: "elixir", Env: map[string]string{"PORT": "{{.Ports"}},
+			},
+			shouldErr: true,
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var container v1.Container
+			config, image, err := recipeTransformer{}.Apply(&container, test.configuration, identity)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.result, container)
+				t.CheckDeepEqual(test.debugConfig, config)
+				t.CheckDeepEqual(test.image, image)
+			}
+		})
+	}
+}
+
+func TestSupportImage(t *testing.T) {
+	tests := []struct {
+		imageID string
+		image   string
+		name    string
+	}{
+		{imageID: "go", image: "HELPERS/go", name: "go"},
+		{imageID: "elixir-debug-support:latest", image: "elixir-debug-support:latest", name: "elixir-debug-support"},
+		{imageID: "gcr.io/project/Elixir_Helper@sha256:abcd", image: "gcr.io/project/Elixir_Helper@sha256:abcd", name: "elixir-helper"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.imageID, func(t *testutil.T) {
+			t.CheckDeepEqual(test.image, supportImage(test.imageID, "HELPERS"))
+			t.CheckDeepEqual(test.name, supportImageName(test.imageID))
+		})
+	}
+}
+
+func TestTransformManifestRecipe(t *testing.T) {
+	testutil.Run(t, "recipe runs ahead of the detected runtime", func(t *testutil.T) {
+		pod := &v1.Pod{
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:    "test",
+				Command: []string{"/launch.sh"},
+			}}},
+		}
+		retriever := func(image string) (imageConfiguration, error) {
+			return imageConfiguration{
+				env: map[string]string{"RUST_VERSION": "1.48.0"},
+				recipe: &latest.DebugRecipe{
+					Runtime: "custom",
+					Command: []string{"/debug.sh", "{{.Command}}"},
+					Ports:   map[string]int32{"debug": 4000},
+					Image:   "gcr.io/project/custom-helper",
+				},
+			}, nil
+		}
+
+		result := transformManifest(pod, retriever, "HELPERS", 0)
+
+		t.CheckDeepEqual(true, result)
+		supportVolumeMount := v1.VolumeMount{Name: "debugging-support-files", MountPath: "/dbg"}
+		t.CheckDeepEqual(&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"debug.cloud.google.com/config": `{"test":{"runtime":"custom","ports":{"debug":4000}}}`},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:         "test",
+					Command:      []string{"/debug.sh", "/launch.sh"},
+					Ports:        []v1.ContainerPort{{Name: "debug", ContainerPort: 4000}},
+					VolumeMounts: []v1.VolumeMount{supportVolumeMount},
+				}},
+				InitContainers: []v1.Container{{
+					Name:         "install-custom-helper-debug-support",
+					Image:        "gcr.io/project/custom-helper",
+					VolumeMounts: []v1.VolumeMount{supportVolumeMount},
+				}},
+				Volumes: []v1.Volume{{
+					Name:         "debugging-support-files",
+					VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+				}},
+			},
+		}, pod)
+	})
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+type rubyTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, rubyTransformer{})
+}
+
+const (
+	// most examples of rdbg use 12345
+	defaultRdbgPort = 12345
+)
+
+// rdbgSpec captures the useful rdbg options
+type rdbgSpec struct {
+	host string
+	port int32
+}
+
+// rubyCommands are the commands that are known to run a Ruby program
+var rubyCommands = []string{"ruby", "bundle", "rails", "rake", "rackup", "puma", "unicorn", "sidekiq"}
+
+// isLaunchingRuby determines if the arguments seems to be invoking ruby or a well-known ruby tool
+func isLaunchingRuby(args []string) bool {
+	return len(args) > 0 && util.StrSliceContains(rubyCommands, filepath.Base(args[0]))
+}
+
+// isLaunchingRdbg determines if the arguments seems to be invoking the rdbg debugger
+func isLaunchingRdbg(args []string) bool {
+	return len(args) > 0 && (args[0] == "rdbg" || strings.HasSuffix(args[0], "/rdbg"))
+}
+
+func (t rubyTransformer) IsApplicable(config imageConfiguration) bool {
+	// RUBY_VERSION and RUBY_MAJOR are defined in the official Docker `ruby` images
+	for _, v := range []string{"RUBY_VERSION", "RUBY_MAJOR"} {
+		if _, found := config.env[v]; found {
+			return true
+		}
+	}
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingRuby(config.entrypoint) || isLaunchingRdbg(config.entrypoint)
+	}
+	return isLaunchingRuby(config.arguments) || isLaunchingRdbg(config.arguments)
+}
+
+// Apply configures a container definition for Ruby with rdbg, from the `debug` gem.
+// The gem must be installed in the image.
+// Returns a simple map describing the debug configuration details.
+func (t rubyTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for Ruby debugging", container.Name)
+
+	// try to find an existing `rdbg` command or `RUBY_DEBUG_PORT` setting
+	spec := retrieveRdbgSpec(config)
+
+	if spec == nil {
+		spec = &rdbgSpec{host: "0.0.0.0", port: portAlloc(defaultRdbgPort)}
+		switch {
+		case len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) && isLaunchingRuby(config.entrypoint):
+			container.Command = rewriteRdbgCommandLine(config.entrypoint, *spec)
+
+		case (len(config.entrypoint) == 0 || isEntrypointLauncher(config.entrypoint)) && isLaunchingRuby(config.arguments):
+			container.Args = rewriteRdbgCommandLine(config.arguments, *spec)
+
+		default:
+			// the debugger can also be started by requiring `debug/open_nonstop`
+			rubyOpt := "-rdebug/open_nonstop"
+			if v, found := config.env["RUBYOPT"]; found {
+				rubyOpt = v + " " + rubyOpt
+			}
+			container.Env = setEnvVar(container.Env, "RUBYOPT", rubyOpt)
+			container.Env = setEnvVar(container.Env, "RUBY_DEBUG_HOST", spec.host)
+			container.Env = setEnvVar(container.Env, "RUBY_DEBUG_PORT", strconv.FormatInt(int64(spec.port), 10))
+		}
+	}
+
+	container.Ports = exposePort(container.Ports, "dap", spec.port)
+
+	return ContainerDebugConfiguration{
+		Runtime: "ruby",
+		Ports:   map[string]uint32{"dap": uint32(spec.port)},
+	}, "", nil
+}
+
+func retrieveRdbgSpec(config imageConfiguration) *rdbgSpec {
+	if spec := extractRdbgSpec(config.entrypoint); spec != nil {
+		return spec
+	}
+	if spec := extractRdbgSpec(config.arguments); spec != nil {
+		return spec
+	}
+	if value, found := config.env["RUBY_DEBUG_PORT"]; found && strings.Contains(config.env["RUBYOPT"], "-rdebug/open") {
+		port, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			logrus.Errorf("Invalid RUBY_DEBUG_PORT %q: %s\n", value, err)
+			return nil
+		}
+		return &rdbgSpec{host: config.env["RUBY_DEBUG_HOST"], port: int32(port)}
+	}
+	return nil
+}
+
+func extractRdbgSpec(args []string) *rdbgSpec {
+	if !isLaunchingRdbg(args) {
+		return nil
+	}
+	spec := rdbgSpec{port: defaultRdbgPort}
+arguments:
+	for i, arg := range args {
+		var value string
+		switch {
+		case arg == "--":
+			break arguments
+		case arg == "--port" || arg == "--host":
+			if i == len(args)-1 {
+				return nil
+			}
+			value = args[i+1]
+		case strings.HasPrefix(arg, "--port=") || strings.HasPrefix(arg, "--host="):
+			value = strings.SplitN(arg, "=", 2)[1]
+		default:
+			continue
+		}
+
+		if strings.HasPrefix(arg, "--host") {
+			spec.host = value
+			continue
+		}
+		port, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			logrus.Errorf("Invalid rdbg port %q: %s\n", value, err)
+			return nil
+		}
+		spec.port = int32(port)
+	}
+	return &spec
+}
+
+// rewriteRdbgCommandLine rewrites a ruby command-line to run under `rdbg`
+func rewriteRdbgCommandLine(commandLine []string, spec rdbgSpec) []string {
+	return append(spec.asArguments(), commandLine...)
+}
+
+func (spec rdbgSpec) asArguments() []string {
+	// `--nonstop` doesn't wait for a debugger to attach, and `-c` runs a command rather than a script
+	return []string{"rdbg", "--open", "--nonstop", "--host", spec.host, "--port", strconv.FormatInt(int64(spec.port), 10), "-c", "--"}
+}
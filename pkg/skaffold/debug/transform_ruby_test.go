@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExtractRdbgSpec(t *testing.T) {
+	tests := []struct {
+		in     []string
+		result *rdbgSpec
+	}{
+		{nil, nil},
+		{[]string{"ruby", "app.rb"}, nil},
+		{[]string{"rdbg", "app.rb"}, &rdbgSpec{port: 12345}},
+		{[]string{"rdbg", "--open", "--port", "9000", "app.rb"}, &rdbgSpec{port: 9000}},
+		{[]string{"/usr/local/bin/rdbg", "--open", "--host=0.0.0.0", "--port=9000", "-c", "--", "rails", "--port", "3000"}, &rdbgSpec{host: "0.0.0.0", port: 9000}},
+		{[]string{"rdbg", "--port"}, nil},
+		{[]string{"rdbg", "--port=foo"}, nil},
+	}
+	for _, test := range tests {
+		testutil.Run(t, strings.Join(test.in, " "), func(t *testutil.T) {
+			if test.result == nil {
+				t.CheckDeepEqual(test.result, extractRdbgSpec(test.in))
+			} else {
+				t.CheckDeepEqual(*test.result, *extractRdbgSpec(test.in), cmp.AllowUnexported(rdbgSpec{}))
+			}
+		})
+	}
+}
+
+func TestRubyTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		launcher    string
+		result      bool
+	}{
+		{
+			description: "RUBY_VERSION",
+			source:      imageConfiguration{env: map[string]string{"RUBY_VERSION": "2.7.2"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint ruby",
+			source:      imageConfiguration{entrypoint: []string{"ruby", "app.rb"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint /usr/local/bin/bundle",
+			source:      imageConfiguration{entrypoint: []string{"/usr/local/bin/bundle", "exec", "rails", "server"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint rdbg",
+			source:      imageConfiguration{entrypoint: []string{"rdbg", "app.rb"}},
+			result:      true,
+		},
+		{
+			description: "no entrypoint, args puma",
+			source:      imageConfiguration{arguments: []string{"puma", "-C", "config/puma.rb"}},
+			result:      true,
+		},
+		{
+			description: "entrypoint launcher, args rails",
+			source:      imageConfiguration{entrypoint: []string{"launcher"}, arguments: []string{"rails", "server"}},
+			launcher:    "launcher",
+			result:      true,
+		},
+		{
+			description: "entrypoint /bin/sh",
+			source:      imageConfiguration{entrypoint: []string{"/bin/sh"}, arguments: []string{"ruby", "app.rb"}},
+			result:      false,
+		},
+		{
+			description: "nothing",
+			source:      imageConfiguration{},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&entrypointLaunchers, []string{test.launcher})
+			result := rubyTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestRubyTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		containerSpec v1.Container
+		configuration imageConfiguration
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+	}{
+		{
+			description:   "entrypoint",
+			configuration: imageConfiguration{entrypoint: []string{"ruby", "app.rb"}},
+			result: v1.Container{
+				Command: []string{"rdbg", "--open", "--nonstop", "--host", "0.0.0.0", "--port", "12345", "-c", "--", "ruby", "app.rb"},
+				Ports:   []v1.ContainerPort{{Name: "dap", ContainerPort: 12345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 12345}},
+		},
+		{
+			description:   "command not entrypoint",
+			configuration: imageConfiguration{arguments: []string{"bundle", "exec", "rails", "server"}},
+			result: v1.Container{
+				Args:  []string{"rdbg", "--open", "--nonstop", "--host", "0.0.0.0", "--port", "12345", "-c", "--", "bundle", "exec", "rails", "server"},
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 12345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 12345}},
+		},
+		{
+			description:   "unknown command-line",
+			configuration: imageConfiguration{entrypoint: []string{"/app/start.sh"}, env: map[string]string{"RUBY_VERSION": "3.0", "RUBYOPT": "-W0"}},
+			result: v1.Container{
+				Env: []v1.EnvVar{
+					{Name: "RUBYOPT", Value: "-W0 -rdebug/open_nonstop"},
+					{Name: "RUBY_DEBUG_HOST", Value: "0.0.0.0"},
+					{Name: "RUBY_DEBUG_PORT", Value: "12345"},
+				},
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 12345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 12345}},
+		},
+		{
+			description:   "existing rdbg",
+			configuration: imageConfiguration{entrypoint: []string{"rdbg", "--open", "--port", "9000", "app.rb"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 9000}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 9000}},
+		},
+		{
+			description:   "existing RUBY_DEBUG_PORT",
+			configuration: imageConfiguration{entrypoint: []string{"ruby", "app.rb"}, env: map[string]string{"RUBYOPT": "-rdebug/open", "RUBY_DEBUG_PORT": "9000"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "dap", ContainerPort: 9000}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "ruby", Ports: map[string]uint32{"dap": 9000}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			config, image, err := rubyTransformer{}.Apply(&test.containerSpec, test.configuration, identity)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.result, test.containerSpec)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual("", image)
+		})
+	}
+}
+
+func TestTransformManifestRuby(t *testing.T) {
+	testutil.Run(t, "Pod with Ruby container", func(t *testutil.T) {
+		pod := &v1.Pod{
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:    "test",
+				Command: []string{"ruby", "app.rb"},
+				Ports:   []v1.ContainerPort{{Name: "http", ContainerPort: 12345}},
+			}}},
+		}
+		retriever := func(image string) (imageConfiguration, error) {
+			return imageConfiguration{}, nil
+		}
+
+		result := transformManifest(pod, retriever, "HELPERS", 0)
+
+		t.CheckDeepEqual(true, result)
+		t.CheckDeepEqual(&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"debug.cloud.google.com/config": `{"test":{"runtime":"ruby","ports":{"dap":12346}}}`},
+			},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:    "test",
+				Command: []string{"rdbg", "--open", "--nonstop", "--host", "0.0.0.0", "--port", "12346", "-c", "--", "ruby", "app.rb"},
+				Ports:   []v1.ContainerPort{{Name: "http", ContainerPort: 12345}, {Name: "dap", ContainerPort: 12346}},
+			}}},
+		}, pod)
+	})
+}
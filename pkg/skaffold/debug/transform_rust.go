@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+type rustTransformer struct{}
+
+func init() {
+	containerTransforms = append(containerTransforms, rustTransformer{})
+}
+
+const (
+	// most examples of gdbserver use 1234
+	defaultGdbserverPort = 1234
+)
+
+// gdbserverSpec captures the useful gdbserver and lldb-server options
+type gdbserverSpec struct {
+	host string
+	port int32
+}
+
+// isLaunchingGdbserver determines if the arguments seems to be invoking gdbserver or lldb-server
+func isLaunchingGdbserver(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	command := filepath.Base(args[0])
+	return command == "gdbserver" || command == "lldb-server"
+}
+
+func (t rustTransformer) IsApplicable(config imageConfiguration) bool {
+	// RUST_VERSION and RUSTUP_HOME are both defined in the official Docker `rust` images.
+	// Variables like CARGO_HOME or RUST_BACKTRACE are often left over from a build stage
+	// and don't tell that the container runs a Rust program.
+	_, version := config.env["RUST_VERSION"]
+	_, rustup := config.env["RUSTUP_HOME"]
+	if version && rustup {
+		logrus.Infof("Artifact %q has Rust runtime: has env RUST_VERSION and RUSTUP_HOME", config.artifact)
+		return true
+	}
+	if len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint) {
+		return isLaunchingGdbserver(config.entrypoint)
+	}
+	return isLaunchingGdbserver(config.arguments)
+}
+
+// Apply configures a container definition for Rust with gdbserver, which must be installed in the image.
+// The program is stopped until a debugger connects, so the container's probes are removed (see `runtimeProbeTimeout`).
+// Returns a simple map describing the debug configuration details.
+func (t rustTransformer) Apply(container *v1.Container, config imageConfiguration, portAlloc portAllocator) (ContainerDebugConfiguration, string, error) {
+	logrus.Infof("Configuring %q for Rust debugging", container.Name)
+
+	// try to find an existing `gdbserver` or `lldb-server` command
+	spec := retrieveGdbserverSpec(config)
+
+	if spec == nil {
+		spec = &gdbserverSpec{port: portAlloc(defaultGdbserverPort)}
+		switch {
+		case len(config.entrypoint) > 0 && !isEntrypointLauncher(config.entrypoint):
+			container.Command = rewriteGdbserverCommandLine(config.entrypoint, *spec)
+
+		case (len(config.entrypoint) == 0 || isEntrypointLauncher(config.entrypoint)) && len(config.arguments) > 0:
+			container.Args = rewriteGdbserverCommandLine(config.arguments, *spec)
+
+		default:
+			return ContainerDebugConfiguration{}, "", fmt.Errorf("container %q has no command-line", container.Name)
+		}
+	}
+
+	container.Ports = exposePort(container.Ports, "gdbserver", spec.port)
+
+	return ContainerDebugConfiguration{
+		Runtime: "rust",
+		Ports:   map[string]uint32{"gdbserver": uint32(spec.port)},
+	}, "", nil
+}
+
+func retrieveGdbserverSpec(config imageConfiguration) *gdbserverSpec {
+	if spec := extractGdbserverSpec(config.entrypoint); spec != nil {
+		return spec
+	}
+	if spec := extractGdbserverSpec(config.arguments); spec != nil {
+		return spec
+	}
+	return nil
+}
+
+// extractGdbserverSpec finds the address that gdbserver (`gdbserver [host]:port prog`)
+// or lldb-server (`lldb-server gdbserver [host]:port -- prog`) listens on.
+func extractGdbserverSpec(args []string) *gdbserverSpec {
+	if !isLaunchingGdbserver(args) {
+		return nil
+	}
+	for _, arg := range args[1:] {
+		if arg == "--" {
+			break
+		}
+		if strings.HasPrefix(arg, "-") || !strings.Contains(arg, ":") {
+			continue
+		}
+		split := strings.SplitN(arg, ":", 2)
+		port, err := strconv.ParseInt(split[1], 10, 32)
+		if err != nil {
+			logrus.Errorf("Invalid gdbserver port %q: %s\n", arg, err)
+			return nil
+		}
+		return &gdbserverSpec{host: split[0], port: int32(port)}
+	}
+	return nil
+}
+
+// rewriteGdbserverCommandLine rewrites a command-line to run the program under `gdbserver`
+func rewriteGdbserverCommandLine(commandLine []string, spec gdbserverSpec) []string {
+	return append(spec.asArguments(), commandLine...)
+}
+
+func (spec gdbserverSpec) asArguments() []string {
+	// an empty host listens on all interfaces
+	return []string{"gdbserver", fmt.Sprintf("%s:%d", spec.host, spec.port)}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExtractGdbserverSpec(t *testing.T) {
+	tests := []struct {
+		in     []string
+		result *gdbserverSpec
+	}{
+		{nil, nil},
+		{[]string{"/app/server"}, nil},
+		{[]string{"gdbserver", ":2345", "/app/server"}, &gdbserverSpec{port: 2345}},
+		{[]string{"/usr/bin/gdbserver", "--once", "0.0.0.0:2345", "/app/server", "--listen", "a:1"}, &gdbserverSpec{host: "0.0.0.0", port: 2345}},
+		{[]string{"lldb-server", "gdbserver", "*:2345", "--", "/app/server"}, &gdbserverSpec{host: "*", port: 2345}},
+		{[]string{"lldb-server", "gdbserver", "--", "/app/server", "a:1"}, nil},
+		{[]string{"gdbserver", "host:port", "/app/server"}, nil},
+	}
+	for _, test := range tests {
+		testutil.Run(t, strings.Join(test.in, " "), func(t *testutil.T) {
+			if test.result == nil {
+				t.CheckDeepEqual(test.result, extractGdbserverSpec(test.in))
+			} else {
+				t.CheckDeepEqual(*test.result, *extractGdbserverSpec(test.in), cmp.AllowUnexported(gdbserverSpec{}))
+			}
+		})
+	}
+}
+
+func TestRustTransformer_IsApplicable(t *testing.T) {
+	tests := []struct {
+		description string
+		source      imageConfiguration
+		result      bool
+	}{
+		{
+			description: "official image",
+			source:      imageConfiguration{env: map[string]string{"RUST_VERSION": "1.48.0", "RUSTUP_HOME": "/usr/local/rustup", "CARGO_HOME": "/usr/local/cargo"}},
+			result:      true,
+		},
+		{
+			description: "RUST_VERSION only",
+			source:      imageConfiguration{env: map[string]string{"RUST_VERSION": "1.48.0"}},
+			result:      false,
+		},
+		{
+			description: "build stage leftovers",
+			source:      imageConfiguration{env: map[string]string{"CARGO_HOME": "/usr/local/cargo", "RUST_BACKTRACE": "1"}},
+			result:      false,
+		},
+		{
+			description: "entrypoint gdbserver",
+			source:      imageConfiguration{entrypoint: []string{"gdbserver", ":1234", "/app/server"}},
+			result:      true,
+		},
+		{
+			description: "args lldb-server",
+			source:      imageConfiguration{arguments: []string{"lldb-server", "gdbserver", "*:1234", "--", "/app/server"}},
+			result:      true,
+		},
+		{
+			description: "binary without env",
+			source:      imageConfiguration{entrypoint: []string{"/app/server"}},
+			result:      false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			result := rustTransformer{}.IsApplicable(test.source)
+
+			t.CheckDeepEqual(test.result, result)
+		})
+	}
+}
+
+func TestRustTransformer_Apply(t *testing.T) {
+	tests := []struct {
+		description   string
+		configuration imageConfiguration
+		shouldErr     bool
+		result        v1.Container
+		debugConfig   ContainerDebugConfiguration
+	}{
+		{
+			description:   "empty",
+			configuration: imageConfiguration{env: map[string]string{"RUST_VERSION": "1.48.0"}},
+			shouldErr:     true,
+		},
+		{
+			description:   "entrypoint",
+			configuration: imageConfiguration{entrypoint: []string{"/app/server", "--port", "8080"}},
+			result: v1.Container{
+				Command: []string{"gdbserver", ":1234", "/app/server", "--port", "8080"},
+				Ports:   []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 1234}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "rust", Ports: map[string]uint32{"gdbserver": 1234}},
+		},
+		{
+			description:   "command not entrypoint",
+			configuration: imageConfiguration{arguments: []string{"/app/server"}},
+			result: v1.Container{
+				Args:  []string{"gdbserver", ":1234", "/app/server"},
+				Ports: []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 1234}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "rust", Ports: map[string]uint32{"gdbserver": 1234}},
+		},
+		{
+			description:   "existing lldb-server",
+			configuration: imageConfiguration{entrypoint: []string{"lldb-server", "gdbserver", "*:2345", "--", "/app/server"}},
+			result: v1.Container{
+				Ports: []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 2345}},
+			},
+			debugConfig: ContainerDebugConfiguration{Runtime: "rust", Ports: map[string]uint32{"gdbserver": 2345}},
+		},
+	}
+	var identity portAllocator = func(port int32) int32 {
+		return port
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var container v1.Container
+			config, image, err := rustTransformer{}.Apply(&container, test.configuration, identity)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.result, container)
+			t.CheckDeepEqual(test.debugConfig, config)
+			t.CheckDeepEqual("", image)
+		})
+	}
+}
+
+func TestTransformManifestRust(t *testing.T) {
+	testutil.Run(t, "Pod with Rust container", func(t *testutil.T) {
+		pod := &v1.Pod{
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:          "test",
+				Command:       []string{"/app/server"},
+				LivenessProbe: &v1.Probe{Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"true"}}}},
+			}}},
+		}
+		retriever := func(image string) (imageConfiguration, error) {
+			return imageConfiguration{env: map[string]string{"RUST_VERSION": "1.48.0", "RUSTUP_HOME": "/usr/local/rustup"}}, nil
+		}
+
+		// the probes are removed even if they'd be left unchanged for other runtimes
+		result := transformManifest(pod, retriever, "HELPERS", 0)
+
+		t.CheckDeepEqual(true, result)
+		t.CheckDeepEqual(&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"debug.cloud.google.com/config": `{"test":{"runtime":"rust","ports":{"gdbserver":1234},"originalProbes":{"liveness":{"exec":{"command":["true"]}}}}}`},
+			},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:    "test",
+				Command: []string{"gdbserver", ":1234", "/app/server"},
+				Ports:   []v1.ContainerPort{{Name: "gdbserver", ContainerPort: 1234}},
+			}}},
+		}, pod)
+	})
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compose
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/segmentio/textio"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/compose"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Deployer translates Docker Compose files to Kubernetes objects and deploys them with kubectl.
+type Deployer struct {
+	*latest.ComposeDeploy
+
+	workingDir         string
+	globalConfig       string
+	defaultRepo        *string
+	kubectl            kubectl.CLI
+	insecureRegistries map[string]bool
+	labels             map[string]string
+}
+
+// NewDeployer returns a new Deployer for a ComposeDeploy config.
+func NewDeployer(cfg kubectl.Config, labels map[string]string) (*Deployer, error) {
+	defaultNamespace := ""
+	if cfg.Pipeline().Deploy.ComposeDeploy.DefaultNamespace != nil {
+		var err error
+		defaultNamespace, err = util.ExpandEnvTemplate(*cfg.Pipeline().Deploy.ComposeDeploy.DefaultNamespace, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Deployer{
+		ComposeDeploy:      cfg.Pipeline().Deploy.ComposeDeploy,
+		workingDir:         cfg.GetWorkingDir(),
+		globalConfig:       cfg.GlobalConfig(),
+		defaultRepo:        cfg.DefaultRepo(),
+		kubectl:            kubectl.NewCLI(cfg, cfg.Pipeline().Deploy.ComposeDeploy.Flags, defaultNamespace),
+		insecureRegistries: cfg.GetInsecureRegistries(),
+		labels:             labels,
+	}, nil
+}
+
+// Deploy translates the Compose services and runs `kubectl apply` on the resulting manifests.
+func (c *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact) ([]string, error) {
+	manifests, err := c.renderManifests(builds)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(manifests) == 0 {
+		return nil, nil
+	}
+
+	namespaces, err := manifests.CollectNamespaces()
+	if err != nil {
+		event.DeployInfoEvent(fmt.Errorf("could not fetch deployed resource namespace. "+
+			"This might cause port-forward and deploy health-check to fail: %w", err))
+	}
+
+	if err := c.kubectl.WaitForDeletions(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+		return nil, err
+	}
+
+	if err := c.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
+		return nil, err
+	}
+
+	return namespaces, nil
+}
+
+// Cleanup deletes what was deployed by calling Deploy.
+func (c *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
+	manifests, err := c.readManifests()
+	if err != nil {
+		return err
+	}
+
+	return c.kubectl.Delete(ctx, textio.NewPrefixWriter(out, " - "), manifests)
+}
+
+// Dependencies lists the Compose files.
+func (c *Deployer) Dependencies() ([]string, error) {
+	var deps []string
+	for _, path := range c.Paths {
+		deps = append(deps, c.path(path))
+	}
+	return deps, nil
+}
+
+// Render writes the Kubernetes manifests translated from the Compose files.
+func (c *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Artifact, _ bool, filepath string) error {
+	manifests, err := c.renderManifests(builds)
+	if err != nil {
+		return err
+	}
+
+	return manifest.Write(manifests.String(), filepath, out)
+}
+
+// readManifests translates the Compose files to Kubernetes manifests.
+func (c *Deployer) readManifests() (manifest.ManifestList, error) {
+	var manifests manifest.ManifestList
+	for _, path := range c.Paths {
+		project, err := compose.Load(c.path(path))
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := project.Manifests()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range list {
+			manifests.Append(m)
+		}
+	}
+	return manifests, nil
+}
+
+func (c *Deployer) renderManifests(builds []build.Artifact) (manifest.ManifestList, error) {
+	debugHelpersRegistry, err := config.GetDebugHelpersRegistry(c.globalConfig)
+	if err != nil {
+		return nil, deployerr.DebugHelperRetrieveErr(fmt.Errorf("retrieving debug helpers registry: %w", err))
+	}
+
+	manifests, err := c.readManifests()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(manifests) == 0 {
+		return nil, nil
+	}
+
+	if len(builds) == 0 {
+		images, err := manifests.GetImages()
+		if err != nil {
+			return nil, err
+		}
+		for _, image := range images {
+			tag, err := deployutil.ApplyDefaultRepo(c.globalConfig, c.defaultRepo, image.Tag)
+			if err != nil {
+				return nil, err
+			}
+			builds = append(builds, build.Artifact{ImageName: image.ImageName, Tag: tag})
+		}
+	}
+
+	manifests, err = manifests.ReplaceImages(builds)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifests, err = manifest.ApplyTransforms(manifests, builds, c.insecureRegistries, debugHelpersRegistry); err != nil {
+		return nil, err
+	}
+
+	return manifests.SetLabels(c.labels)
+}
+
+func (c *Deployer) path(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(c.workingDir, path)
+}
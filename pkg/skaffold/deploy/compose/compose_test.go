@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compose
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const composeFile = `services:
+  web:
+    build: .
+    ports: ["8080:80"]
+`
+
+const webManifests = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  creationTimestamp: null
+  labels:
+    skaffold.dev/compose-service: web
+  name: web
+spec:
+  selector:
+    matchLabels:
+      skaffold.dev/compose-service: web
+  strategy: {}
+  template:
+    metadata:
+      creationTimestamp: null
+      labels:
+        skaffold.dev/compose-service: web
+    spec:
+      containers:
+      - image: web:v1
+        name: web
+        ports:
+        - containerPort: 80
+          protocol: TCP
+        resources: {}
+status: {}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  creationTimestamp: null
+  labels:
+    skaffold.dev/compose-service: web
+  name: web
+spec:
+  ports:
+  - name: 8080-tcp
+    port: 8080
+    protocol: TCP
+    targetPort: 80
+  selector:
+    skaffold.dev/compose-service: web
+status:
+  loadBalancer: {}
+`
+
+func TestComposeDeploy(t *testing.T) {
+	tests := []struct {
+		description string
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "apply translated manifests",
+			commands:    testutil.CmdRunInput("kubectl --context kubecontext --namespace testNamespace apply -f -", strings.TrimSuffix(webManifests, "\n")),
+		},
+		{
+			description: "apply error",
+			commands:    testutil.CmdRunErr("kubectl --context kubecontext --namespace testNamespace apply -f -", errors.New("apply failed")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			tmpDir := t.NewTempDir().Write("docker-compose.yaml", composeFile)
+
+			deployer, err := NewDeployer(&composeConfig{workingDir: tmpDir.Root(), compose: latest.ComposeDeploy{Paths: []string{"docker-compose.yaml"}}}, nil)
+			t.RequireNoError(err)
+
+			_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "web", Tag: "web:v1"}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestComposeRender(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("docker-compose.yaml", composeFile)
+
+		deployer, err := NewDeployer(&composeConfig{workingDir: tmpDir.Root(), compose: latest.ComposeDeploy{Paths: []string{"docker-compose.yaml"}}}, nil)
+		t.RequireNoError(err)
+
+		var out bytes.Buffer
+		err = deployer.Render(context.Background(), &out, []build.Artifact{{ImageName: "web", Tag: "web:v1"}}, true, "")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(webManifests, out.String())
+	})
+}
+
+func TestComposeCleanup(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("kubectl --context kubecontext --namespace testNamespace delete --ignore-not-found=true -f -"))
+		tmpDir := t.NewTempDir().Write("docker-compose.yaml", composeFile)
+
+		deployer, err := NewDeployer(&composeConfig{workingDir: tmpDir.Root(), compose: latest.ComposeDeploy{Paths: []string{"docker-compose.yaml"}}}, nil)
+		t.RequireNoError(err)
+
+		err = deployer.Cleanup(context.Background(), ioutil.Discard)
+
+		t.CheckNoError(err)
+	})
+}
+
+func TestComposeDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		deployer, err := NewDeployer(&composeConfig{workingDir: "root", compose: latest.ComposeDeploy{Paths: []string{"docker-compose.yaml", "/abs/compose.yaml"}}}, nil)
+		t.RequireNoError(err)
+
+		deps, err := deployer.Dependencies()
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{filepath.Join("root", "docker-compose.yaml"), "/abs/compose.yaml"}, deps)
+	})
+}
+
+type composeConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	workingDir            string
+	compose               latest.ComposeDeploy
+}
+
+func (c *composeConfig) GetKubeContext() string   { return kubectl.TestKubeContext }
+func (c *composeConfig) GetKubeNamespace() string { return kubectl.TestNamespace }
+func (c *composeConfig) GetWorkingDir() string    { return c.workingDir }
+func (c *composeConfig) WaitForDeletions() config.WaitForDeletions {
+	return config.WaitForDeletions{}
+}
+func (c *composeConfig) Pipeline() latest.Pipeline {
+	var pipeline latest.Pipeline
+	pipeline.Deploy.DeployType.ComposeDeploy = &c.compose
+	return pipeline
+}
@@ -41,3 +41,26 @@ type Deployer interface {
 	// writes them to the given file path
 	Render(context.Context, io.Writer, []build.Artifact, bool, string) error
 }
+
+// LogAggregator prints the logs of deployed containers.
+type LogAggregator interface {
+	// AddStream prints the lines read from r, prefixed with name and colored after the image.
+	AddStream(ctx context.Context, image, name string, r io.Reader)
+}
+
+// logStreamer is implemented by deployers that stream the logs of what they deploy.
+type logStreamer interface {
+	SetLogAggregator(LogAggregator)
+}
+
+// WithLogAggregator gives the log aggregator to the deployers that stream logs themselves.
+func WithLogAggregator(d Deployer, l LogAggregator) {
+	switch d := d.(type) {
+	case DeployerMux:
+		for _, deployer := range d {
+			WithLogAggregator(deployer, l)
+		}
+	case logStreamer:
+		d.SetLogAggregator(l)
+	}
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+)
+
+const defaultNetwork = "skaffold-network"
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// Deployer runs the built images as containers on the local Docker daemon.
+type Deployer struct {
+	*latest.DockerDeploy
+
+	client     docker.LocalDaemon
+	labels     map[string]string
+	workingDir string
+	logs       deploy.LogAggregator
+}
+
+// NewDeployer returns a new Deployer for a DockerDeploy config.
+func NewDeployer(cfg types.Config, labels map[string]string) (*Deployer, error) {
+	client, err := docker.NewAPIClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Deployer{
+		DockerDeploy: cfg.Pipeline().Deploy.DockerDeploy,
+		client:       client,
+		labels:       labels,
+		workingDir:   cfg.GetWorkingDir(),
+	}, nil
+}
+
+// SetLogAggregator sets where the logs of the containers are printed.
+func (d *Deployer) SetLogAggregator(l deploy.LogAggregator) {
+	d.logs = l
+}
+
+// Deploy removes the previous containers and runs a new container for each configured image.
+// The containers of this project and run that are no longer configured are removed. Containers
+// of other projects are never removed, even if they have the same name. It doesn't deploy to any namespace.
+func (d *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact) ([]string, error) {
+	if err := d.client.EnsureNetwork(ctx, d.network(), d.deployerLabels()); err != nil {
+		return nil, err
+	}
+
+	existing, err := d.client.ContainerIDs(ctx, d.deployerLabels())
+	if err != nil {
+		return nil, err
+	}
+
+	configured := map[string]bool{}
+	for _, c := range d.containers(builds) {
+		if err := d.run(ctx, out, c, builds, existing); err != nil {
+			return nil, err
+		}
+		configured[c.Name] = true
+	}
+
+	return nil, d.removeUnconfigured(ctx, out, configured)
+}
+
+// removeUnconfigured removes the containers of this project started by this run that are no longer configured.
+func (d *Deployer) removeUnconfigured(ctx context.Context, out io.Writer, configured map[string]bool) error {
+	runID, found := d.labels[label.RunIDLabel]
+	if !found {
+		return nil
+	}
+
+	labels := d.deployerLabels()
+	labels[label.RunIDLabel] = runID
+	started, err := d.client.ContainerIDs(ctx, labels)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(started))
+	for name := range started {
+		if !configured[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := d.client.RemoveContainer(ctx, started[name]); err != nil {
+			return err
+		}
+		color.Default.Fprintf(out, " - %s: container removed\n", name)
+	}
+	return nil
+}
+
+func (d *Deployer) run(ctx context.Context, out io.Writer, c latest.DockerContainer, builds []build.Artifact, existing map[string]string) error {
+	if id, found := existing[c.Name]; found {
+		if err := d.client.RemoveContainer(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	image := resolveImage(c.Image, builds)
+
+	labels := d.deployerLabels()
+	for k, v := range d.labels {
+		labels[k] = v
+	}
+	labels[docker.ImageLabel] = image
+
+	binds, err := d.binds(c.Volumes)
+	if err != nil {
+		return err
+	}
+
+	id, err := d.client.Run(ctx, docker.ContainerCreateOpts{
+		Name:    c.Name,
+		Image:   image,
+		Network: d.network(),
+		Args:    c.Args,
+		Env:     envList(c.Env),
+		Labels:  labels,
+		Ports:   c.Ports,
+		Binds:   binds,
+	})
+	if err != nil {
+		return err
+	}
+
+	color.Default.Fprintf(out, " - %s: container started\n", c.Name)
+	if err := publishedPorts(out, c); err != nil {
+		return err
+	}
+
+	if d.logs != nil {
+		r, w := io.Pipe()
+		go func() {
+			w.CloseWithError(d.client.ContainerLogs(ctx, w, id))
+		}()
+		d.logs.AddStream(ctx, image, c.Name, r)
+	}
+
+	return nil
+}
+
+// Cleanup removes the containers created by Deploy for this project, and the network
+// once no other container uses it.
+func (d *Deployer) Cleanup(ctx context.Context, out io.Writer) error {
+	existing, err := d.client.ContainerIDs(ctx, d.deployerLabels())
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(existing))
+	for name := range existing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := d.client.RemoveContainer(ctx, existing[name]); err != nil {
+			return err
+		}
+		color.Default.Fprintf(out, " - %s: container removed\n", name)
+	}
+
+	return d.client.RemoveNetwork(ctx, d.network())
+}
+
+// Dependencies returns nothing since the containers are configured in skaffold.yaml.
+func (d *Deployer) Dependencies() ([]string, error) {
+	return nil, nil
+}
+
+// Render doesn't do anything since there are no manifests to render.
+func (d *Deployer) Render(context.Context, io.Writer, []build.Artifact, bool, string) error {
+	logrus.Debugln("The docker deployer has no manifests to render")
+	return nil
+}
+
+func (d *Deployer) network() string {
+	if d.Network != "" {
+		return d.Network
+	}
+	return defaultNetwork
+}
+
+// deployerLabels returns the labels that identify the containers of this project.
+func (d *Deployer) deployerLabels() map[string]string {
+	return docker.DeployerLabels(d.workingDir)
+}
+
+// containers returns the configured containers, or one container per built artifact.
+func (d *Deployer) containers(builds []build.Artifact) []latest.DockerContainer {
+	var containers []latest.DockerContainer
+	if len(d.Containers) > 0 {
+		containers = append(containers, d.Containers...)
+	} else {
+		for _, b := range builds {
+			containers = append(containers, latest.DockerContainer{Image: b.ImageName})
+		}
+	}
+
+	for i := range containers {
+		if containers[i].Name == "" {
+			containers[i].Name = containerName(containers[i].Image)
+		}
+	}
+	return containers
+}
+
+// binds resolves the relative sources of the volumes from the working directory.
+func (d *Deployer) binds(volumes []string) ([]string, error) {
+	var binds []string
+	for _, volume := range volumes {
+		parts := strings.SplitN(volume, ":", 2)
+		if strings.HasPrefix(parts[0], ".") {
+			source, err := filepath.Abs(filepath.Join(d.workingDir, parts[0]))
+			if err != nil {
+				return nil, fmt.Errorf("resolving volume %q: %w", volume, err)
+			}
+			parts[0] = source
+		}
+		binds = append(binds, strings.Join(parts, ":"))
+	}
+	return binds, nil
+}
+
+// containerName derives a container name from the last part of an image name.
+func containerName(image string) string {
+	name := image
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.IndexAny(name, ":@"); i >= 0 {
+		name = name[:i]
+	}
+	return invalidNameChars.ReplaceAllString(name, "-")
+}
+
+// resolveImage replaces the name of an artifact with the tag that was built.
+func resolveImage(image string, builds []build.Artifact) string {
+	for _, b := range builds {
+		if b.ImageName == image {
+			return b.Tag
+		}
+	}
+	return image
+}
+
+func envList(env map[string]string) []string {
+	var list []string
+	for k, v := range env {
+		list = append(list, k+"="+v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// publishedPorts prints the ports published on the host and emits a port forward event for each.
+func publishedPorts(out io.Writer, c latest.DockerContainer) error {
+	for _, spec := range c.Ports {
+		mappings, err := nat.ParsePortSpec(spec)
+		if err != nil {
+			return fmt.Errorf("parsing port %q: %w", spec, err)
+		}
+
+		for _, m := range mappings {
+			if m.Binding.HostPort == "" {
+				continue
+			}
+			localPort, err := strconv.Atoi(m.Binding.HostPort)
+			if err != nil {
+				continue
+			}
+
+			address := m.Binding.HostIP
+			if address == "" {
+				address = "127.0.0.1"
+			}
+
+			color.Default.Fprintf(out, "Port forwarding container/%s, remote port %s -> address %s port %d\n", c.Name, m.Port.Port(), address, localPort)
+			event.PortForwarded(int32(localPort), schemautil.IntOrString{IntVal: m.Port.Int()}, "", c.Name, "", "", "container", c.Name, address)
+		}
+	}
+	return nil
+}
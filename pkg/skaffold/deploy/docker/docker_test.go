@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDeploy(t *testing.T) {
+	tests := []struct {
+		description     string
+		cfg             latest.DockerDeploy
+		builds          []build.Artifact
+		existing        []*testutil.FakeContainer
+		missingImages   map[string]bool
+		expectedNames   []string
+		expectedImages  []string
+		expectedEnv     []string
+		expectedBinds   []string
+		expectedNetwork string
+		expectedPulled  []string
+		expectedOutput  string
+	}{
+		{
+			description:     "one container per artifact",
+			builds:          []build.Artifact{{ImageName: "gcr.io/project/app", Tag: "gcr.io/project/app:v1"}, {ImageName: "db", Tag: "db:v2"}},
+			expectedNames:   []string{"app", "db"},
+			expectedImages:  []string{"gcr.io/project/app:v1", "db:v2"},
+			expectedNetwork: "skaffold-network",
+			expectedOutput:  " - app: container started\n - db: container started\n",
+		},
+		{
+			description: "configured containers",
+			cfg: latest.DockerDeploy{
+				Network: "net",
+				Containers: []latest.DockerContainer{{
+					Name:    "web",
+					Image:   "app",
+					Env:     map[string]string{"PORT": "8080", "DEBUG": "1"},
+					Ports:   []string{"9000:8080"},
+					Volumes: []string{"./data:/data", "/tmp:/tmp:ro"},
+				}, {
+					Image: "redis:6",
+				}},
+			},
+			builds:          []build.Artifact{{ImageName: "app", Tag: "app:v1"}},
+			missingImages:   map[string]bool{"redis:6": true},
+			expectedNames:   []string{"web", "redis"},
+			expectedImages:  []string{"app:v1", "redis:6"},
+			expectedEnv:     []string{"DEBUG=1", "PORT=8080"},
+			expectedBinds:   []string{filepath.Join("/project", "data") + ":/data", "/tmp:/tmp:ro"},
+			expectedNetwork: "net",
+			expectedPulled:  []string{"redis:6"},
+			expectedOutput:  " - web: container started\nPort forwarding container/web, remote port 8080 -> address 127.0.0.1 port 9000\n - redis: container started\n",
+		},
+		{
+			description:     "replace previous container",
+			builds:          []build.Artifact{{ImageName: "app", Tag: "app:v2"}},
+			existing:        []*testutil.FakeContainer{{ID: "old", Name: "app"}},
+			expectedNames:   []string{"app"},
+			expectedImages:  []string{"app:v2"},
+			expectedNetwork: "skaffold-network",
+			expectedOutput:  " - app: container started\n",
+		},
+		{
+			description:     "remove container that's no longer configured",
+			builds:          []build.Artifact{{ImageName: "app", Tag: "app:v2"}},
+			existing:        []*testutil.FakeContainer{{ID: "old", Name: "app"}, {ID: "removed", Name: "db"}},
+			expectedNames:   []string{"app"},
+			expectedImages:  []string{"app:v2"},
+			expectedNetwork: "skaffold-network",
+			expectedOutput:  " - app: container started\n - db: container removed\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			for _, c := range test.existing {
+				c.Config.Labels = docker.DeployerLabels("/project")
+				c.Config.Labels[label.RunIDLabel] = "123"
+			}
+			fakeClient := &testutil.FakeAPIClient{Containers: test.existing, MissingImages: test.missingImages}
+			cfg := test.cfg
+			deployer := &Deployer{
+				DockerDeploy: &cfg,
+				client:       docker.NewLocalDaemon(fakeClient, nil, false, nil),
+				labels:       map[string]string{label.RunIDLabel: "123"},
+				workingDir:   "/project",
+			}
+
+			var out bytes.Buffer
+			namespaces, err := deployer.Deploy(context.Background(), &out, test.builds)
+
+			t.CheckNoError(err)
+			t.CheckEmpty(namespaces)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+			t.CheckDeepEqual([]string{test.expectedNetwork}, fakeClient.Networks)
+			t.CheckDeepEqual(test.expectedPulled, fakeClient.Pulled())
+			t.CheckDeepEqual(len(test.expectedNames), len(fakeClient.Containers))
+			for i, c := range fakeClient.Containers {
+				t.CheckDeepEqual(test.expectedNames[i], c.Name)
+				t.CheckDeepEqual(test.expectedImages[i], c.Config.Image)
+				t.CheckDeepEqual(test.expectedImages[i], c.Config.Labels[docker.ImageLabel])
+				t.CheckDeepEqual("123", c.Config.Labels[label.RunIDLabel])
+				t.CheckDeepEqual(docker.DeployerLabels("/project")[docker.ProjectLabel], c.Config.Labels[docker.ProjectLabel])
+				t.CheckDeepEqual(testutil.Started, c.State)
+				t.CheckDeepEqual(test.expectedNetwork, string(c.HostConfig.NetworkMode))
+				if i == 0 {
+					t.CheckDeepEqual(test.expectedEnv, c.Config.Env)
+					t.CheckDeepEqual(test.expectedBinds, c.HostConfig.Binds)
+				}
+			}
+		})
+	}
+}
+
+func TestDeployLeavesOtherProjects(t *testing.T) {
+	otherProject := func(id, name string) *testutil.FakeContainer {
+		labels := docker.DeployerLabels("/other-project")
+		labels[label.RunIDLabel] = "123"
+		return &testutil.FakeContainer{ID: id, Name: name, Config: container.Config{Labels: labels}}
+	}
+
+	testutil.Run(t, "containers with other names", func(t *testutil.T) {
+		fakeClient := &testutil.FakeAPIClient{Containers: []*testutil.FakeContainer{otherProject("other", "db")}}
+		deployer := &Deployer{
+			DockerDeploy: &latest.DockerDeploy{},
+			client:       docker.NewLocalDaemon(fakeClient, nil, false, nil),
+			labels:       map[string]string{label.RunIDLabel: "123"},
+			workingDir:   "/project",
+		}
+
+		var out bytes.Buffer
+		_, err := deployer.Deploy(context.Background(), &out, []build.Artifact{{ImageName: "app", Tag: "app:v1"}})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(" - app: container started\n", out.String())
+		t.CheckDeepEqual(2, len(fakeClient.Containers))
+		t.CheckDeepEqual("db", fakeClient.Containers[0].Name)
+	})
+
+	testutil.Run(t, "container with the same name", func(t *testutil.T) {
+		fakeClient := &testutil.FakeAPIClient{Containers: []*testutil.FakeContainer{otherProject("other", "app")}}
+		deployer := &Deployer{
+			DockerDeploy: &latest.DockerDeploy{},
+			client:       docker.NewLocalDaemon(fakeClient, nil, false, nil),
+			labels:       map[string]string{label.RunIDLabel: "123"},
+			workingDir:   "/project",
+		}
+
+		_, err := deployer.Deploy(context.Background(), &bytes.Buffer{}, []build.Artifact{{ImageName: "app", Tag: "app:v1"}})
+
+		t.CheckErrorContains("already in use", err)
+		t.CheckDeepEqual(1, len(fakeClient.Containers))
+		t.CheckDeepEqual("other", fakeClient.Containers[0].ID)
+	})
+}
+
+func TestCleanup(t *testing.T) {
+	testutil.Run(t, "remove containers and network", func(t *testutil.T) {
+		fakeClient := &testutil.FakeAPIClient{Networks: []string{"skaffold-network"}}
+		deployer := &Deployer{
+			DockerDeploy: &latest.DockerDeploy{},
+			client:       docker.NewLocalDaemon(fakeClient, nil, false, nil),
+			workingDir:   "/project",
+		}
+
+		_, err := deployer.Deploy(context.Background(), &bytes.Buffer{}, []build.Artifact{{ImageName: "app", Tag: "app:v1"}})
+		t.CheckNoError(err)
+		fakeClient.Containers = append(fakeClient.Containers, &testutil.FakeContainer{ID: "other", Name: "other"})
+
+		var out bytes.Buffer
+		err = deployer.Cleanup(context.Background(), &out)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(" - app: container removed\n", out.String())
+		t.CheckDeepEqual(1, len(fakeClient.Containers))
+		t.CheckDeepEqual("other", fakeClient.Containers[0].Name)
+		t.CheckEmpty(fakeClient.Networks)
+	})
+
+	testutil.Run(t, "keep other projects' containers and their network", func(t *testutil.T) {
+		otherLabels := docker.DeployerLabels("/other-project")
+		fakeClient := &testutil.FakeAPIClient{
+			Networks: []string{"skaffold-network"},
+			Containers: []*testutil.FakeContainer{{
+				ID:         "other",
+				Name:       "db",
+				Config:     container.Config{Labels: otherLabels},
+				HostConfig: container.HostConfig{NetworkMode: "skaffold-network"},
+			}},
+		}
+		deployer := &Deployer{
+			DockerDeploy: &latest.DockerDeploy{},
+			client:       docker.NewLocalDaemon(fakeClient, nil, false, nil),
+			workingDir:   "/project",
+		}
+
+		_, err := deployer.Deploy(context.Background(), &bytes.Buffer{}, []build.Artifact{{ImageName: "app", Tag: "app:v1"}})
+		t.CheckNoError(err)
+
+		var out bytes.Buffer
+		err = deployer.Cleanup(context.Background(), &out)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(" - app: container removed\n", out.String())
+		t.CheckDeepEqual(1, len(fakeClient.Containers))
+		t.CheckDeepEqual("db", fakeClient.Containers[0].Name)
+		t.CheckDeepEqual([]string{"skaffold-network"}, fakeClient.Networks)
+	})
+}
+
+func TestContainerName(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{image: "app", expected: "app"},
+		{image: "gcr.io/project/app:v1", expected: "app"},
+		{image: "localhost:5000/app@sha256:abc", expected: "app"},
+		{image: "my_app+test", expected: "my_app-test"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.image, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, containerName(test.image))
+		})
+	}
+}
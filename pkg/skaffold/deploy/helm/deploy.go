@@ -39,9 +39,11 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -55,6 +57,9 @@ var (
 	// versionRegex extracts version from "helm version --client", for instance: "2.14.0-rc.2"
 	versionRegex = regexp.MustCompile(`v(\d[\w.\-]+)`)
 
+	// revisionRegex extracts the revision of a release from "helm get", for instance: "REVISION: 3"
+	revisionRegex = regexp.MustCompile(`(?m)^REVISION:\s*(\d+)`)
+
 	// helm3Version represents the version cut-off for helm3 behavior
 	helm3Version  = semver.MustParse("3.0.0-beta.0")
 	helm32Version = semver.MustParse("3.2.0")
@@ -85,6 +90,11 @@ type Deployer struct {
 
 	forceDeploy bool
 	enableDebug bool
+	// probeTimeout is passed to the debug post-renderer
+	probeTimeout string
+
+	// state remembers the fingerprint of each release that was deployed
+	state *state.Store
 
 	// bV is the helm binary version
 	bV semver.Version
@@ -102,15 +112,17 @@ func NewDeployer(cfg kubectl.Config, labels map[string]string) (*Deployer, error
 	}
 
 	return &Deployer{
-		HelmDeploy:  cfg.Pipeline().Deploy.HelmDeploy,
-		kubeContext: cfg.GetKubeContext(),
-		kubeConfig:  cfg.GetKubeConfig(),
-		namespace:   cfg.GetKubeNamespace(),
-		forceDeploy: cfg.ForceDeploy(),
-		configFile:  cfg.ConfigurationFile(),
-		labels:      labels,
-		bV:          hv,
-		enableDebug: cfg.Mode() == config.RunModes.Debug,
+		HelmDeploy:   cfg.Pipeline().Deploy.HelmDeploy,
+		kubeContext:  cfg.GetKubeContext(),
+		kubeConfig:   cfg.GetKubeConfig(),
+		namespace:    cfg.GetKubeNamespace(),
+		forceDeploy:  cfg.ForceDeploy(),
+		configFile:   cfg.ConfigurationFile(),
+		labels:       labels,
+		bV:           hv,
+		enableDebug:  cfg.Mode() == config.RunModes.Debug && cfg.DebugMode() != debug.ModeAttach,
+		probeTimeout: cfg.AutoProbeTimeout(),
+		state:        cfg.DeployState(),
 	}, nil
 }
 
@@ -173,58 +185,67 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 func (h *Deployer) Dependencies() ([]string, error) {
 	var deps []string
 
-	for _, release := range h.Releases {
-		r := release
-		deps = append(deps, r.ValuesFiles...)
-
-		if r.Remote {
-			// chart path is only a dependency if it exists on the local filesystem
-			continue
+	for _, r := range h.Releases {
+		releaseDeps, err := releaseDependencies(r)
+		deps = append(deps, releaseDeps...)
+		if err != nil {
+			return deps, err
 		}
+	}
+	sort.Strings(deps)
+	return deps, nil
+}
 
-		chartDepsDirs := []string{
-			"charts",
-			"tmpcharts",
-		}
+// releaseDependencies returns the list of files that a release depends on.
+func releaseDependencies(r latest.HelmRelease) ([]string, error) {
+	deps := append([]string{}, r.ValuesFiles...)
+
+	if r.Remote {
+		// chart path is only a dependency if it exists on the local filesystem
+		return deps, nil
+	}
+
+	chartDepsDirs := []string{
+		"charts",
+		"tmpcharts",
+	}
 
-		lockFiles := []string{
-			"Chart.lock",
+	lockFiles := []string{
+		"Chart.lock",
+	}
+
+	// We can always add a dependency if it is not contained in our chartDepsDirs.
+	// However, if the file is in our chartDepsDir, we can only include the file
+	// if we are not running the helm dep build phase, as that modifies files inside
+	// the chartDepsDir and results in an infinite build loop.
+	// We additionally exclude ChartFile.lock,
+	// since it also gets modified during a `helm dep build`.
+	isDep := func(path string, info walk.Dirent) (bool, error) {
+		if info.IsDir() {
+			return false, nil
+		}
+		if r.SkipBuildDependencies {
+			return true, nil
 		}
 
-		// We can always add a dependency if it is not contained in our chartDepsDirs.
-		// However, if the file is in our chartDepsDir, we can only include the file
-		// if we are not running the helm dep build phase, as that modifies files inside
-		// the chartDepsDir and results in an infinite build loop.
-		// We additionally exclude ChartFile.lock,
-		// since it also gets modified during a `helm dep build`.
-		isDep := func(path string, info walk.Dirent) (bool, error) {
-			if info.IsDir() {
+		for _, v := range chartDepsDirs {
+			if strings.HasPrefix(path, filepath.Join(r.ChartPath, v)) {
 				return false, nil
 			}
-			if r.SkipBuildDependencies {
-				return true, nil
-			}
-
-			for _, v := range chartDepsDirs {
-				if strings.HasPrefix(path, filepath.Join(release.ChartPath, v)) {
-					return false, nil
-				}
-			}
+		}
 
-			for _, v := range lockFiles {
-				if strings.EqualFold(info.Name(), v) {
-					return false, nil
-				}
+		for _, v := range lockFiles {
+			if strings.EqualFold(info.Name(), v) {
+				return false, nil
 			}
-
-			return true, nil
 		}
 
-		if err := walk.From(release.ChartPath).When(isDep).AppendPaths(&deps); err != nil {
-			return deps, userErr("issue walking releases", err)
-		}
+		return true, nil
+	}
+
+	if err := walk.From(r.ChartPath).When(isDep).AppendPaths(&deps); err != nil {
+		return deps, userErr("issue walking releases", err)
 	}
-	sort.Strings(deps)
 	return deps, nil
 }
 
@@ -357,7 +378,8 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 		return nil, err
 	}
 
-	if err := h.exec(ctx, ioutil.Discard, false, nil, getArgs(releaseName, opts.namespace)...); err != nil {
+	var installed bytes.Buffer
+	if err := h.exec(ctx, &installed, false, nil, getArgs(releaseName, opts.namespace)...); err != nil {
 		color.Yellow.Fprintf(out, "Helm release %s not installed. Installing...\n", releaseName)
 
 		opts.upgrade = false
@@ -372,6 +394,20 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 		}
 	}
 
+	// Skip releases that were already deployed with the same chart, values and images.
+	key := releaseKey(releaseName, opts.namespace)
+	fingerprint, err := h.releaseFingerprint(r, builds, valuesSet, opts)
+	if err != nil {
+		logrus.Debugf("Unable to compute the fingerprint of release %s: %v", releaseName, err)
+	}
+	// The live revision is part of the fingerprint, so a release that was upgraded,
+	// rolled back or reinstalled since is never skipped.
+	revision := releaseRevision(installed.String())
+	if opts.upgrade && !h.forceDeploy && revision != "" && h.state.Unchanged(key, state.Fingerprint(fingerprint, revision)) {
+		logrus.Infof("Release %s is unchanged, skipping upgrade...", releaseName)
+		return parseReleaseInfo(opts.namespace, bufio.NewReader(&installed)), nil
+	}
+
 	// Only build local dependencies, but allow a user to skip them.
 	if !r.SkipBuildDependencies && !r.Remote {
 		logrus.Infof("Building helm dependencies...")
@@ -421,10 +457,59 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, r latest.He
 		return nil, userErr("get release", err)
 	}
 
+	if revision = releaseRevision(b.String()); fingerprint != "" && revision != "" {
+		h.state.Record(key, state.Fingerprint(fingerprint, revision))
+	}
+
 	artifacts := parseReleaseInfo(opts.namespace, bufio.NewReader(&b))
 	return artifacts, nil
 }
 
+// releaseKey identifies a release in the deploy state.
+func releaseKey(releaseName, namespace string) string {
+	return fmt.Sprintf("helm/%s/%s", namespace, releaseName)
+}
+
+// releaseRevision reads the revision of a release from the output of `helm get`.
+func releaseRevision(release string) string {
+	if match := revisionRegex.FindStringSubmatch(release); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// releaseFingerprint hashes everything a release upgrade depends on: the release definition,
+// the arguments it's installed with, including the image tags, and the content of its chart and values files.
+func (h *Deployer) releaseFingerprint(r latest.HelmRelease, builds []build.Artifact, valuesSet map[string]bool, opts installOpts) (string, error) {
+	if r.Remote && r.Version == "" {
+		// The latest version of a remote chart can change at any time.
+		return "", nil
+	}
+
+	args, err := h.installArgs(r, builds, valuesSet, opts)
+	if err != nil {
+		return "", err
+	}
+	// Image values are set in no particular order.
+	sort.Strings(args)
+
+	definition, err := yaml.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+
+	deps, err := releaseDependencies(r)
+	if err != nil {
+		return "", err
+	}
+	files, err := state.FilesFingerprint(deps)
+	if err != nil {
+		return "", err
+	}
+
+	return state.Fingerprint(string(definition), strings.Join(args, " "), files), nil
+}
+
 // getRelease confirms that a release is visible to helm
 func (h *Deployer) getRelease(ctx context.Context, releaseName string, namespace string) (bytes.Buffer, error) {
 	// Retry, because sometimes a release may not be immediately visible
@@ -27,6 +27,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
@@ -957,6 +958,71 @@ func TestHelmDeploy(t *testing.T) {
 	}
 }
 
+func TestHelmSkipsUnchangedReleases(t *testing.T) {
+	const getAll = "helm --kube-context kubecontext get all foo --kubeconfig kubeconfig"
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("testdata/foo/Chart.yaml", "name: foo").
+			Write("testdata/foo/templates/deployment.yaml", "kind: Deployment").
+			Chdir()
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunWithOutput("helm version --client", version31).
+			AndRunWithOutput(getAll, "NAME: foo\nREVISION: 1\n").
+			AndRun("helm --kube-context kubecontext dep build testdata/foo --kubeconfig kubeconfig").
+			AndRun("helm --kube-context kubecontext upgrade foo testdata/foo --set-string image=foo:v1 --kubeconfig kubeconfig").
+			AndRunWithOutput(getAll, "NAME: foo\nREVISION: 2\n").
+			AndRunWithOutput(getAll, "NAME: foo\nREVISION: 2\n").
+			AndRunWithOutput("helm version --client", version31).
+			AndRunWithOutput(getAll, "NAME: foo\nREVISION: 2\n").
+			AndRunWithOutput(getAll, "NAME: foo\nREVISION: 3\n").
+			AndRun("helm --kube-context kubecontext dep build testdata/foo --kubeconfig kubeconfig").
+			AndRun("helm --kube-context kubecontext upgrade foo testdata/foo --set-string image=foo:v1 --kubeconfig kubeconfig").
+			AndRunWithOutput(getAll, "NAME: foo\nREVISION: 4\n").
+			AndRunWithOutput(getAll, "NAME: foo\nREVISION: 4\n").
+			AndRun("helm --kube-context kubecontext dep build testdata/foo --kubeconfig kubeconfig").
+			AndRun("helm --kube-context kubecontext upgrade foo testdata/foo --set-string image=foo:v2 --kubeconfig kubeconfig").
+			AndRunWithOutput(getAll, "NAME: foo\nREVISION: 5\n"))
+
+		helm := latest.HelmDeploy{
+			Releases: []latest.HelmRelease{{
+				Name:              "foo",
+				ChartPath:         "testdata/foo",
+				ArtifactOverrides: map[string]string{"image": "foo"},
+			}},
+		}
+		stateFile := tmpDir.Path("deploy-state.yaml")
+		store := state.LoadFile(stateFile)
+
+		deployer, err := NewDeployer(&helmConfig{helm: helm, state: store}, nil)
+		t.RequireNoError(err)
+
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "foo", Tag: "foo:v1"}})
+		t.CheckNoError(err)
+
+		// Same chart, values and images: no upgrade.
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "foo", Tag: "foo:v1"}})
+		t.CheckNoError(err)
+
+		// A new session against the same cluster: no upgrade either.
+		t.CheckNoError(store.Save())
+		store = state.LoadFile(stateFile)
+		deployer, err = NewDeployer(&helmConfig{helm: helm, state: store}, nil)
+		t.RequireNoError(err)
+
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "foo", Tag: "foo:v1"}})
+		t.CheckNoError(err)
+
+		// The release was rolled back in the meantime: upgrade.
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "foo", Tag: "foo:v1"}})
+		t.CheckNoError(err)
+
+		// New image: upgrade.
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "foo", Tag: "foo:v2"}})
+		t.CheckNoError(err)
+	})
+}
+
 func TestHelmCleanup(t *testing.T) {
 	tests := []struct {
 		description      string
@@ -1416,6 +1482,7 @@ type helmConfig struct {
 	force                 bool
 	helm                  latest.HelmDeploy
 	configFile            string
+	state                 *state.Store
 }
 
 func (c *helmConfig) ForceDeploy() bool         { return c.force }
@@ -1423,6 +1490,7 @@ func (c *helmConfig) GetKubeConfig() string     { return kubectl.TestKubeConfig
 func (c *helmConfig) GetKubeContext() string    { return kubectl.TestKubeContext }
 func (c *helmConfig) GetKubeNamespace() string  { return c.namespace }
 func (c *helmConfig) ConfigurationFile() string { return c.configFile }
+func (c *helmConfig) DeployState() *state.Store { return c.state }
 func (c *helmConfig) Pipeline() latest.Pipeline {
 	var pipeline latest.Pipeline
 	pipeline.Deploy.DeployType.HelmDeploy = &c.helm
@@ -145,6 +145,9 @@ func (h *Deployer) generateSkaffoldDebugFilter(buildsFile string) []string {
 	if len(buildsFile) > 0 {
 		args = append(args, "--build-artifacts", buildsFile)
 	}
+	if h.probeTimeout != "" {
+		args = append(args, "--auto-probe-timeout", h.probeTimeout)
+	}
 	args = append(args, h.Flags.Global...)
 
 	if h.kubeConfig != "" {
@@ -28,6 +28,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/sirupsen/logrus"
 	"golang.org/x/mod/semver"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/kustomize/kyaml/fn/framework"
@@ -36,8 +37,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
+	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -66,15 +69,23 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	globalConfig       string
+	kubectl            kubectl.CLI
+
+	// state remembers the fingerprint of the last applied manifests
+	state *state.Store
+	// rendered holds the last render, reused as long as its inputs don't change
+	rendered deployutil.RenderCache
 }
 
 // NewDeployer generates a new Deployer object contains the kptDeploy schema.
-func NewDeployer(cfg types.Config, labels map[string]string) *Deployer {
+func NewDeployer(cfg kubectl.Config, labels map[string]string) *Deployer {
 	return &Deployer{
 		KptDeploy:          cfg.Pipeline().Deploy.KptDeploy,
 		insecureRegistries: cfg.GetInsecureRegistries(),
 		labels:             labels,
 		globalConfig:       cfg.GlobalConfig(),
+		kubectl:            kubectl.NewCLI(cfg, latest.KubectlFlags{}, ""),
+		state:              cfg.DeployState(),
 	}
 }
 
@@ -154,6 +165,23 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 			"This might cause port-forward and deploy health-check to fail: %w", err))
 	}
 
+	// Skip `kpt live apply` if the exact same manifests were already applied
+	// and are still live in the cluster.
+	key := "kpt/" + k.Dir
+	var fingerprint string
+	if k.state != nil {
+		var fingerprints []string
+		if manifests, fingerprints, err = kubectl.AnnotateFingerprints(manifests); err != nil {
+			return nil, err
+		}
+
+		fingerprint = state.Fingerprint(fingerprints...)
+		if k.state.Unchanged(key, fingerprint) && k.isDeployed(ctx, manifests, fingerprints) {
+			logrus.Infoln("Manifests are unchanged, skipping kpt live apply...")
+			return namespaces, nil
+		}
+	}
+
 	applyDir, err := k.getApplyDir(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("getting applyDir: %w", err)
@@ -167,9 +195,26 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 		return nil, err
 	}
 
+	k.state.Record(key, fingerprint)
 	return namespaces, nil
 }
 
+// isDeployed checks that the cluster runs all the given manifests, unchanged.
+func (k *Deployer) isDeployed(ctx context.Context, manifests manifest.ManifestList, fingerprints []string) bool {
+	deployed, err := k.kubectl.DeployedFingerprints(ctx, manifests)
+	if err != nil {
+		logrus.Debugf("Unable to read the fingerprints of deployed resources: %v", err)
+		return false
+	}
+
+	for _, fingerprint := range fingerprints {
+		if !deployed[fingerprint] {
+			return false
+		}
+	}
+	return true
+}
+
 // Dependencies returns a list of files that the deployer depends on. This does NOT include applyDir.
 // In dev mode, a redeploy will be triggered if one of these files is updated.
 func (k *Deployer) Dependencies() ([]string, error) {
@@ -246,7 +291,42 @@ func (k *Deployer) Render(ctx context.Context, out io.Writer, builds []build.Art
 // renderManifests handles a majority of the hydration process for manifests.
 // This involves reading configs from a source directory, running kustomize build, running kpt pipelines,
 // adding image digests, and adding run-id labels.
-func (k *Deployer) renderManifests(ctx context.Context, _ io.Writer, builds []build.Artifact,
+func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []build.Artifact,
+	flags []string) (manifest.ManifestList, error) {
+	fingerprint, err := k.renderFingerprint(builds, flags)
+	if err != nil {
+		logrus.Debugf("Unable to compute the fingerprint of kpt inputs: %v", err)
+	}
+	if manifests, found := k.rendered.Get(fingerprint); found {
+		logrus.Debugln("Kpt inputs are unchanged, reusing the last render")
+		return manifests, nil
+	}
+
+	manifests, err := k.render(ctx, out, builds, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	k.rendered.Set(fingerprint, manifests)
+	return manifests, nil
+}
+
+// renderFingerprint hashes everything a render depends on: the configuration files,
+// the kpt functions, the images and the labels.
+func (k *Deployer) renderFingerprint(builds []build.Artifact, flags []string) (string, error) {
+	deps, err := k.Dependencies()
+	if err != nil {
+		return "", err
+	}
+	files, err := state.FilesFingerprint(deps)
+	if err != nil {
+		return "", err
+	}
+
+	return state.Fingerprint(files, fmt.Sprint(flags), fmt.Sprint(builds), fmt.Sprint(k.labels)), nil
+}
+
+func (k *Deployer) render(ctx context.Context, _ io.Writer, builds []build.Artifact,
 	flags []string) (manifest.ManifestList, error) {
 	var err error
 	debugHelpersRegistry, err := config.GetDebugHelpersRegistry(k.globalConfig)
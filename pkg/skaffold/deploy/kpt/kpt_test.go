@@ -27,8 +27,11 @@ import (
 	"strings"
 	"testing"
 
+	k8syaml "sigs.k8s.io/yaml"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -236,6 +239,63 @@ func TestKpt_Deploy(t *testing.T) {
 	}
 }
 
+func TestKpt_SkipsUnchangedApply(t *testing.T) {
+	const getLive = "kubectl --context kubecontext --namespace testNamespace get -f - --ignore-not-found -ojson"
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&sanityCheck, func(string, io.Writer) error { return nil })
+		tmpDir := t.NewTempDir().Chdir()
+		os.Mkdir("valid_path", 0755)
+		stateFile := tmpDir.Path("deploy-state.yaml")
+
+		deploySession := func(commands util.Command) {
+			t.Override(&util.DefaultExecCommand, commands)
+			store := state.LoadFile(stateFile)
+
+			k := NewDeployer(&kptConfig{
+				kpt: latest.KptDeploy{
+					Dir:  ".",
+					Live: latest.KptLive{Apply: latest.KptApplyInventory{Dir: "valid_path"}},
+				},
+				state: store,
+			}, nil)
+
+			_, err := k.Deploy(context.Background(), ioutil.Discard, nil)
+			t.CheckNoError(err)
+			t.CheckNoError(store.Save())
+		}
+
+		deploySession(testutil.
+			CmdRunOut("kpt fn source .", ``).
+			AndRunOut("kpt fn run --dry-run", testPod).
+			AndRun("kpt live apply valid_path"))
+
+		applied, err := ioutil.ReadFile(tmpDir.Path("valid_path/resources.yaml"))
+		t.RequireNoError(err)
+		var pod struct {
+			Metadata struct {
+				Annotations map[string]string `json:"annotations"`
+			} `json:"metadata"`
+		}
+		t.RequireNoError(k8syaml.Unmarshal(applied, &pod))
+		fingerprint := pod.Metadata.Annotations[kubectl.FingerprintAnnotation]
+		t.CheckFalse(fingerprint == "")
+
+		// A second session against the same cluster applies nothing.
+		deploySession(testutil.
+			CmdRunOut("kpt fn source .", ``).
+			AndRunOut("kpt fn run --dry-run", testPod).
+			AndRunOut(getLive, fmt.Sprintf(`{"kind":"Pod","metadata":{"annotations":{%q:%q}}}`, kubectl.FingerprintAnnotation, fingerprint)))
+
+		// The pod was deleted from the cluster in the meantime: apply.
+		deploySession(testutil.
+			CmdRunOut("kpt fn source .", ``).
+			AndRunOut("kpt fn run --dry-run", testPod).
+			AndRunOut(getLive, ``).
+			AndRun("kpt live apply valid_path"))
+	})
+}
+
 func TestKpt_Dependencies(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -1137,11 +1197,13 @@ type kptConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	workingDir            string
 	kpt                   latest.KptDeploy
+	state                 *state.Store
 }
 
-func (c *kptConfig) WorkingDir() string       { return c.workingDir }
-func (c *kptConfig) GetKubeContext() string   { return kubectl.TestKubeContext }
-func (c *kptConfig) GetKubeNamespace() string { return kubectl.TestNamespace }
+func (c *kptConfig) WorkingDir() string        { return c.workingDir }
+func (c *kptConfig) GetKubeContext() string    { return kubectl.TestKubeContext }
+func (c *kptConfig) GetKubeNamespace() string  { return kubectl.TestNamespace }
+func (c *kptConfig) DeployState() *state.Store { return c.state }
 func (c *kptConfig) Pipeline() latest.Pipeline {
 	var pipeline latest.Pipeline
 	pipeline.Deploy.DeployType.KptDeploy = &c.kpt
@@ -28,6 +28,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
 	deploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/types"
 	kubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
@@ -42,6 +43,8 @@ type CLI struct {
 	forceDeploy      bool
 	waitForDeletions config.WaitForDeletions
 	previousApply    manifest.ManifestList
+	state            *state.Store
+	checkedCluster   bool
 }
 
 type Config interface {
@@ -58,6 +61,7 @@ func NewCLI(cfg Config, flags latest.KubectlFlags, defaultNameSpace string) CLI
 		Flags:            flags,
 		forceDeploy:      cfg.ForceDeploy(),
 		waitForDeletions: cfg.WaitForDeletions(),
+		state:            cfg.DeployState(),
 	}
 }
 
@@ -78,6 +82,13 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 	updated := c.previousApply.Diff(manifests)
 	logrus.Debugln(len(manifests), "manifests to deploy.", len(updated), "are updated or new")
 	c.previousApply = manifests
+
+	if c.state != nil {
+		var err error
+		if updated, err = c.skipDeployed(ctx, updated); err != nil {
+			return err
+		}
+	}
 	if len(updated) == 0 {
 		return nil
 	}
@@ -98,6 +109,36 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 	return nil
 }
 
+// skipDeployed annotates the manifests with their fingerprint. On the first apply of a session,
+// it also drops the ones that a previous session has already applied to the cluster.
+func (c *CLI) skipDeployed(ctx context.Context, manifests manifest.ManifestList) (manifest.ManifestList, error) {
+	annotated, fingerprints, err := AnnotateFingerprints(manifests)
+	if err != nil {
+		return nil, userErr(err)
+	}
+
+	if c.checkedCluster || c.forceDeploy {
+		return annotated, nil
+	}
+	c.checkedCluster = true
+
+	deployed, err := c.DeployedFingerprints(ctx, annotated)
+	if err != nil {
+		logrus.Debugf("Unable to read the fingerprints of deployed resources, applying everything: %v", err)
+		return annotated, nil
+	}
+
+	var updated manifest.ManifestList
+	for i, m := range annotated {
+		if !deployed[fingerprints[i]] {
+			updated = append(updated, m)
+		}
+	}
+	logrus.Debugln(len(annotated)-len(updated), "manifests are already deployed and unchanged")
+
+	return updated, nil
+}
+
 // Kustomize runs `kubectl kustomize` with the provided args
 func (c *CLI) Kustomize(ctx context.Context, args []string) ([]byte, error) {
 	return c.RunOut(ctx, "kustomize", c.args(nil, args...)...)
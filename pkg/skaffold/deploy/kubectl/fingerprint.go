@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// FingerprintAnnotation records, on each deployed resource, a hash of the manifest it was last applied from.
+const FingerprintAnnotation = "skaffold.dev/fingerprint"
+
+// AnnotateFingerprints adds to each manifest an annotation with the fingerprint of its content.
+func AnnotateFingerprints(manifests manifest.ManifestList) (manifest.ManifestList, []string, error) {
+	var annotated manifest.ManifestList
+	var fingerprints []string
+
+	for _, m := range manifests {
+		var obj map[string]interface{}
+		if err := yaml.Unmarshal(m, &obj); err != nil {
+			return nil, nil, fmt.Errorf("reading Kubernetes YAML: %w", err)
+		}
+
+		metadata, ok := obj["metadata"].(map[string]interface{})
+		if !ok {
+			metadata = map[string]interface{}{}
+			obj["metadata"] = metadata
+		}
+		annotations, ok := metadata["annotations"].(map[string]interface{})
+		if !ok {
+			annotations = map[string]interface{}{}
+			metadata["annotations"] = annotations
+		}
+
+		fingerprint := state.Fingerprint(string(bytes.TrimSpace(m)))
+		annotations[FingerprintAnnotation] = fingerprint
+
+		updated, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshalling yaml: %w", err)
+		}
+
+		annotated = append(annotated, updated)
+		fingerprints = append(fingerprints, fingerprint)
+	}
+
+	return annotated, fingerprints, nil
+}
+
+type liveResource struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Items []liveResource `json:"items"`
+}
+
+// DeployedFingerprints lists the fingerprints found on the live version of the given resources.
+func (c *CLI) DeployedFingerprints(ctx context.Context, manifests manifest.ManifestList) (map[string]bool, error) {
+	buf, err := c.RunOutInput(ctx, manifests.Reader(), "get", c.args(nil, "-f", "-", "--ignore-not-found", "-ojson")...)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprints := map[string]bool{}
+
+	// No resource found.
+	if len(buf) == 0 {
+		return fingerprints, nil
+	}
+
+	var result liveResource
+	if err := json.Unmarshal(buf, &result); err != nil {
+		return nil, err
+	}
+
+	// A single resource is not wrapped into a List.
+	items := result.Items
+	if result.Kind != "List" {
+		items = []liveResource{result}
+	}
+
+	for _, item := range items {
+		if fingerprint := item.Metadata.Annotations[FingerprintAnnotation]; fingerprint != "" {
+			fingerprints[fingerprint] = true
+		}
+	}
+
+	return fingerprints, nil
+}
@@ -19,16 +19,23 @@ package kubectl
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"sigs.k8s.io/yaml"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -427,6 +434,174 @@ func TestKubectlRedeploy(t *testing.T) {
 	})
 }
 
+func TestKubectlSkipsDeployedResources(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("deployment-web.yaml", DeploymentWebYAML).
+			Write("deployment-app.yaml", DeploymentAppYAML)
+
+		annotated, fingerprints, err := AnnotateFingerprints(manifest.ManifestList{[]byte(DeploymentAppYAMLv1), []byte(DeploymentWebYAMLv1)})
+		t.RequireNoError(err)
+		annotatedWeb := annotated[1:]
+		annotatedV2, _, err := AnnotateFingerprints(manifest.ManifestList{[]byte(DeploymentAppYAMLv2)})
+		t.RequireNoError(err)
+
+		// A previous session already deployed leeroy-app:v1.
+		deployed := fmt.Sprintf(`{"kind":"List","items":[{"kind":"Pod","metadata":{"name":"leeroy-app","annotations":{%q:%q}}},{"kind":"Pod","metadata":{"name":"leeroy-web"}}]}`, FingerprintAnnotation, fingerprints[0])
+
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOut("kubectl version --client -ojson", KubectlVersion112).
+			AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f "+tmpDir.Path("deployment-app.yaml")+" -f "+tmpDir.Path("deployment-web.yaml"), DeploymentAppYAML+"\n"+DeploymentWebYAML).
+			AndRunInputOut("kubectl --context kubecontext get -f - --ignore-not-found -ojson", annotated.String(), deployed).
+			AndRunInput("kubectl --context kubecontext apply -f -", annotatedWeb.String()).
+			AndRunOut("kubectl --context kubecontext create --dry-run -oyaml -f "+tmpDir.Path("deployment-app.yaml")+" -f "+tmpDir.Path("deployment-web.yaml"), DeploymentAppYAML+"\n"+DeploymentWebYAML).
+			AndRunInput("kubectl --context kubecontext apply -f -", annotatedV2.String()),
+		)
+
+		deployer, err := NewDeployer(&kubectlConfig{
+			workingDir: ".",
+			kubectl: latest.KubectlDeploy{
+				Manifests: []string{tmpDir.Path("deployment-app.yaml"), tmpDir.Path("deployment-web.yaml")}},
+			state: state.New(),
+		}, nil)
+		t.RequireNoError(err)
+
+		// Only leeroy-web is applied, leeroy-app is already deployed.
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{
+			{ImageName: "leeroy-web", Tag: "leeroy-web:v1"},
+			{ImageName: "leeroy-app", Tag: "leeroy-app:v1"},
+		})
+		t.CheckNoError(err)
+
+		// The cluster is only checked once per session.
+		_, err = deployer.Deploy(context.Background(), ioutil.Discard, []build.Artifact{
+			{ImageName: "leeroy-web", Tag: "leeroy-web:v1"},
+			{ImageName: "leeroy-app", Tag: "leeroy-app:v2"},
+		})
+		t.CheckNoError(err)
+	})
+}
+
+func TestKubectlSkipsResourcesDeployedByPreviousSession(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("deployment-web.yaml", DeploymentWebYAML).
+			Write("deployment-app.yaml", DeploymentAppYAML)
+		cluster := &fakeCluster{
+			manifests: DeploymentAppYAML + "\n" + DeploymentWebYAML,
+			live:      map[string]string{},
+		}
+		t.Override(&util.DefaultExecCommand, cluster)
+
+		stateFile := tmpDir.Path("deploy-state.yaml")
+		builds := []build.Artifact{
+			{ImageName: "leeroy-web", Tag: "leeroy-web:v1"},
+			{ImageName: "leeroy-app", Tag: "leeroy-app:v1"},
+		}
+
+		deploySession := func() {
+			store := state.LoadFile(stateFile)
+			labels := label.NewLabeller(true, nil, store.RunID()).Labels()
+
+			deployer, err := NewDeployer(&kubectlConfig{
+				workingDir: ".",
+				kubectl: latest.KubectlDeploy{
+					Manifests: []string{tmpDir.Path("deployment-app.yaml"), tmpDir.Path("deployment-web.yaml")}},
+				state: store,
+			}, labels)
+			t.RequireNoError(err)
+
+			_, err = deployer.Deploy(context.Background(), ioutil.Discard, builds)
+			t.CheckNoError(err)
+			t.CheckNoError(store.Save())
+		}
+
+		deploySession()
+		t.CheckDeepEqual(2, cluster.applied)
+
+		// A second session against the same cluster applies nothing.
+		deploySession()
+		t.CheckDeepEqual(2, cluster.applied)
+	})
+}
+
+// fakeCluster keeps the fingerprint annotation of the resources that were applied.
+type fakeCluster struct {
+	manifests string
+	live      map[string]string
+	applied   int
+}
+
+type fakeResource struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name        string            `json:"name"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"metadata"`
+}
+
+func (c *fakeCluster) RunCmdOut(cmd *exec.Cmd) ([]byte, error) {
+	command := strings.Join(cmd.Args, " ")
+
+	switch {
+	case strings.Contains(command, " version "):
+		return []byte(KubectlVersion112), nil
+	case strings.Contains(command, " create --dry-run "):
+		return []byte(c.manifests), nil
+	case strings.Contains(command, " get "):
+		resources, err := readResources(cmd)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []fakeResource
+		for _, r := range resources {
+			if fingerprint, found := c.live[r.Metadata.Name]; found {
+				r.Metadata.Annotations = map[string]string{FingerprintAnnotation: fingerprint}
+				items = append(items, r)
+			}
+		}
+		return json.Marshal(map[string]interface{}{"kind": "List", "items": items})
+	}
+
+	return nil, fmt.Errorf("unexpected command: %s", command)
+}
+
+func (c *fakeCluster) RunCmd(cmd *exec.Cmd) error {
+	command := strings.Join(cmd.Args, " ")
+	if !strings.Contains(command, " apply ") {
+		return fmt.Errorf("unexpected command: %s", command)
+	}
+
+	resources, err := readResources(cmd)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range resources {
+		c.live[r.Metadata.Name] = r.Metadata.Annotations[FingerprintAnnotation]
+		c.applied++
+	}
+	return nil
+}
+
+func readResources(cmd *exec.Cmd) ([]fakeResource, error) {
+	manifests, err := manifest.Load(cmd.Stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []fakeResource
+	for _, m := range manifests {
+		var r fakeResource
+		if err := yaml.Unmarshal(m, &r); err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
 func TestKubectlWaitForDeletions(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().Write("deployment-web.yaml", DeploymentWebYAML)
@@ -754,6 +929,7 @@ type kubectlConfig struct {
 	force                 bool
 	waitForDeletions      config.WaitForDeletions
 	kubectl               latest.KubectlDeploy
+	state                 *state.Store
 }
 
 func (c *kubectlConfig) GetKubeContext() string                    { return "kubecontext" }
@@ -763,6 +939,7 @@ func (c *kubectlConfig) SkipRender() bool                          { return c.sk
 func (c *kubectlConfig) ForceDeploy() bool                         { return c.force }
 func (c *kubectlConfig) DefaultRepo() *string                      { return &c.defaultRepo }
 func (c *kubectlConfig) WaitForDeletions() config.WaitForDeletions { return c.waitForDeletions }
+func (c *kubectlConfig) DeployState() *state.Store                 { return c.state }
 func (c *kubectlConfig) Pipeline() latest.Pipeline {
 	var pipeline latest.Pipeline
 	pipeline.Deploy.DeployType.KubectlDeploy = &c.kubectl
@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 
 	"github.com/segmentio/textio"
+	"github.com/sirupsen/logrus"
 	yamlv3 "gopkg.in/yaml.v3"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
@@ -32,6 +33,8 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
+	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -98,6 +101,9 @@ type Deployer struct {
 	labels              map[string]string
 	globalConfig        string
 	useKubectlKustomize bool
+
+	// rendered holds the last render, reused as long as its inputs don't change
+	rendered deployutil.RenderCache
 }
 
 func NewDeployer(cfg kubectl.Config, labels map[string]string) (*Deployer, error) {
@@ -170,6 +176,40 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 }
 
 func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []build.Artifact) (manifest.ManifestList, error) {
+	fingerprint, err := k.renderFingerprint(builds)
+	if err != nil {
+		logrus.Debugf("Unable to compute the fingerprint of kustomize inputs: %v", err)
+	}
+	if manifests, found := k.rendered.Get(fingerprint); found {
+		logrus.Debugln("Kustomize inputs are unchanged, reusing the last render")
+		return manifests, nil
+	}
+
+	manifests, err := k.render(ctx, out, builds)
+	if err != nil {
+		return nil, err
+	}
+
+	k.rendered.Set(fingerprint, manifests)
+	return manifests, nil
+}
+
+// renderFingerprint hashes everything a render depends on: the kustomizations and
+// the files they reference, the images and the labels.
+func (k *Deployer) renderFingerprint(builds []build.Artifact) (string, error) {
+	deps, err := k.Dependencies()
+	if err != nil {
+		return "", err
+	}
+	files, err := state.FilesFingerprint(deps)
+	if err != nil {
+		return "", err
+	}
+
+	return state.Fingerprint(files, fmt.Sprint(k.KustomizePaths, k.BuildArgs), fmt.Sprint(builds), fmt.Sprint(k.labels)), nil
+}
+
+func (k *Deployer) render(ctx context.Context, out io.Writer, builds []build.Artifact) (manifest.ManifestList, error) {
 	if err := k.kubectl.CheckVersion(ctx); err != nil {
 		color.Default.Fprintln(out, "kubectl client version:", k.kubectl.Version(ctx))
 		color.Default.Fprintln(out, err)
@@ -28,7 +28,7 @@ const (
 	RunIDLabel           = "skaffold.dev/run-id"
 )
 
-var runID = uuid.New().String()
+var sessionRunID = uuid.New().String()
 
 // DefaultLabeller adds K8s style managed-by label and a run-specific UUID label
 type DefaultLabeller struct {
@@ -37,7 +37,13 @@ type DefaultLabeller struct {
 	runID             string
 }
 
-func NewLabeller(addSkaffoldLabels bool, customLabels []string) *DefaultLabeller {
+// NewLabeller returns a labeller for the given run ID.
+// An empty run ID is replaced by one that is specific to this session.
+func NewLabeller(addSkaffoldLabels bool, customLabels []string, runID string) *DefaultLabeller {
+	if runID == "" {
+		runID = sessionRunID
+	}
+
 	return &DefaultLabeller{
 		addSkaffoldLabels: addSkaffoldLabels,
 		customLabels:      customLabels,
@@ -0,0 +1,626 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/diag"
+	"github.com/GoogleContainerTools/skaffold/pkg/diag/validator"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// Type is the kind of a Kubernetes workload whose status is checked.
+type Type string
+
+const (
+	Deployment  Type = "deployment"
+	StatefulSet Type = "statefulset"
+	DaemonSet   Type = "daemonset"
+	Job         Type = "job"
+	Pod         Type = "pod"
+)
+
+const (
+	rollOutSuccess          = "successfully rolled out"
+	rollingUpdateComplete   = "rolling update complete"
+	partitionedRollOut      = "partitioned roll out complete"
+	completionSuccess       = "successfully completed\n"
+	podReady                = "pod is ready\n"
+	resourceReady           = "resource is ready\n"
+	connectionErrMsg        = "Unable to connect to the server"
+	killedErrMsg            = "signal: killed"
+	defaultPodCheckDeadline = 30 * time.Second
+	tabHeader               = " -"
+	tab                     = "  "
+	maxLogLines             = 3
+	defaultBackoffLimit     = 6
+)
+
+var (
+	msgKubectlKilled     = "kubectl rollout status command interrupted\n"
+	MsgKubectlConnection = "kubectl connection error\n"
+
+	nonRetryContainerErrors = map[proto.StatusCode]struct{}{
+		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:       {},
+		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:    {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED: {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING: {},
+	}
+)
+
+// Resource is a Kubernetes workload whose status is checked until it
+// stabilizes, fails or its deadline is exceeded.
+type Resource struct {
+	name         string
+	namespace    string
+	rType        Type
+	status       Status
+	statusCode   proto.StatusCode
+	done         bool
+	deadline     time.Duration
+	pods         map[string]validator.Resource
+	podValidator diag.Diagnose
+	healthRule   *latest.HealthRule
+	kubectlType  string
+}
+
+func (r *Resource) Deadline() time.Duration {
+	return r.deadline
+}
+
+func (r *Resource) UpdateStatus(ae proto.ActionableErr) {
+	updated := newStatus(ae)
+	if r.status.Equal(updated) {
+		r.status.changed = false
+		return
+	}
+	r.status = updated
+	r.statusCode = updated.ActionableError().ErrCode
+	r.status.changed = true
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS || isErrAndNotRetryAble(ae.ErrCode) {
+		r.done = true
+	}
+}
+
+func NewResource(name string, rType Type, ns string, deadline time.Duration) *Resource {
+	return &Resource{
+		name:         name,
+		namespace:    ns,
+		rType:        rType,
+		status:       newStatus(proto.ActionableErr{}),
+		deadline:     deadline,
+		podValidator: diag.New(nil),
+	}
+}
+
+func (r *Resource) WithValidator(pd diag.Diagnose) *Resource {
+	r.podValidator = pd
+	return r
+}
+
+// WithHealthRule makes the status of a custom resource be checked with the given rule.
+// kubectlType is the fully qualified resource type passed to `kubectl get`.
+func (r *Resource) WithHealthRule(rule latest.HealthRule, kubectlType string) *Resource {
+	r.healthRule = &rule
+	r.kubectlType = kubectlType
+	return r
+}
+
+func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
+	kubeCtl := kubectl.NewCLI(cfg, "")
+
+	var ae proto.ActionableErr
+	switch {
+	case r.healthRule != nil:
+		ae = r.checkCustomResourceStatus(ctx, kubeCtl)
+	case r.rType == Job:
+		ae = r.checkJobStatus(ctx, kubeCtl)
+	case r.rType == Pod:
+		ae = r.checkPodStatus(ctx, kubeCtl)
+	default:
+		ae = r.checkRolloutStatus(ctx, kubeCtl)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	r.UpdateStatus(ae)
+	if err := r.fetchPods(ctx); err != nil {
+		logrus.Debugf("pod statuses could be fetched this time due to %s", err)
+	}
+}
+
+// checkRolloutStatus uses `kubectl rollout status` to check the status of
+// deployments, statefulsets and daemonsets.
+func (r *Resource) checkRolloutStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	b, err := kubeCtl.RunOut(ctx, "rollout", "status", string(r.rType), r.name, "--namespace", r.namespace, "--watch=false")
+	if ctx.Err() != nil {
+		return proto.ActionableErr{}
+	}
+
+	details := r.cleanupStatus(string(b))
+
+	ae := parseKubectlRolloutError(details, err)
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED {
+		ae.Message = fmt.Sprintf("received Ctrl-C or deployments could not stabilize within %v: %v", r.deadline, err)
+	}
+	return ae
+}
+
+// checkJobStatus waits for a job to report either a `Complete` or a `Failed` condition.
+func (r *Resource) checkJobStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	b, err := kubeCtl.RunOut(ctx, "get", string(r.rType), r.name, "--namespace", r.namespace, "-o", "json")
+	if err != nil {
+		return parseKubectlGetError(string(r.rType), err)
+	}
+
+	var job batchv1.Job
+	if err := json.Unmarshal(b, &job); err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("parsing job status: %s", err),
+		}
+	}
+	return jobStatus(job)
+}
+
+func jobStatus(job batchv1.Job) proto.ActionableErr {
+	for _, c := range job.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: completionSuccess,
+			}
+		case batchv1.JobFailed:
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED,
+				Message: fmt.Sprintf("job failed: %s\n", c.Message),
+			}
+		}
+	}
+
+	// the job controller retries failed pods until the backoff limit is exceeded
+	backoffLimit := int32(defaultBackoffLimit)
+	if job.Spec.BackoffLimit != nil {
+		backoffLimit = *job.Spec.BackoffLimit
+	}
+	if job.Status.Failed > backoffLimit {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED,
+			Message: fmt.Sprintf("job failed: %d failed pods exceed the backoff limit of %d\n", job.Status.Failed, backoffLimit),
+		}
+	}
+
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	msg := fmt.Sprintf("waiting for job to complete: %d of %d completions succeeded", job.Status.Succeeded, completions)
+	if job.Status.Failed > 0 {
+		msg += fmt.Sprintf(", %d of %d retries used", job.Status.Failed, backoffLimit)
+	}
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING,
+		Message: msg + "...\n",
+	}
+}
+
+// checkPodStatus waits for a standalone pod to be ready or to run to completion.
+func (r *Resource) checkPodStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	b, err := kubeCtl.RunOut(ctx, "get", string(r.rType), r.name, "--namespace", r.namespace, "-o", "json")
+	if err != nil {
+		return parseKubectlGetError(string(r.rType), err)
+	}
+
+	var pod v1.Pod
+	if err := json.Unmarshal(b, &pod); err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("parsing pod status: %s", err),
+		}
+	}
+	return podStatus(pod)
+}
+
+func podStatus(pod v1.Pod) proto.ActionableErr {
+	switch pod.Status.Phase {
+	case v1.PodSucceeded:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: completionSuccess,
+		}
+	case v1.PodFailed:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED,
+			Message: fmt.Sprintf("pod failed: %s\n", pod.Status.Reason),
+		}
+	case v1.PodRunning:
+		for _, c := range pod.Status.Conditions {
+			if c.Type == v1.PodReady && c.Status == v1.ConditionTrue {
+				return proto.ActionableErr{
+					ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+					Message: podReady,
+				}
+			}
+		}
+	}
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING,
+		Message: fmt.Sprintf("waiting for pod to be ready: phase %s\n", strings.ToLower(string(pod.Status.Phase))),
+	}
+}
+
+// checkCustomResourceStatus evaluates the health rule of a custom resource.
+func (r *Resource) checkCustomResourceStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	b, err := kubeCtl.RunOut(ctx, "get", r.kubectlType, r.name, "--namespace", r.namespace, "-o", "json")
+	if err != nil {
+		return parseKubectlGetError(r.kubectlType, err)
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("parsing %s status: %s", r.rType, err),
+		}
+	}
+	return customResourceStatus(obj, *r.healthRule)
+}
+
+func customResourceStatus(obj map[string]interface{}, rule latest.HealthRule) proto.ActionableErr {
+	if rule.Failed != nil {
+		matched, msg, err := matchHealthCondition(obj, *rule.Failed)
+		if err != nil {
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+				Message: err.Error(),
+			}
+		}
+		if matched {
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED,
+				Message: fmt.Sprintf("resource failed: %s\n", msg),
+			}
+		}
+	}
+
+	matched, _, err := matchHealthCondition(obj, rule.Ready)
+	if err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: err.Error(),
+		}
+	}
+	if matched {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: resourceReady,
+		}
+	}
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING,
+		Message: fmt.Sprintf("waiting for %s\n", describeHealthCondition(rule.Ready)),
+	}
+}
+
+// matchHealthCondition returns whether the object matches the condition, along with a message describing the match.
+func matchHealthCondition(obj map[string]interface{}, hc latest.HealthCondition) (bool, string, error) {
+	if hc.JSONPath != "" {
+		result, err := evalJSONPath(obj, hc.JSONPath)
+		if err != nil {
+			return false, "", err
+		}
+		if hc.Value == "" {
+			return result != "", fmt.Sprintf("%s is %q", hc.JSONPath, result), nil
+		}
+		return result == hc.Value, fmt.Sprintf("%s is %q", hc.JSONPath, result), nil
+	}
+
+	status, _ := obj["status"].(map[string]interface{})
+	conditions, _ := status["conditions"].([]interface{})
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != hc.Condition {
+			continue
+		}
+		if condition["status"] != conditionStatus(hc) {
+			return false, "", nil
+		}
+		msg := fmt.Sprintf("condition %s is %s", hc.Condition, conditionStatus(hc))
+		if m, ok := condition["message"].(string); ok && m != "" {
+			msg = fmt.Sprintf("%s: %s", msg, m)
+		}
+		return true, msg, nil
+	}
+	return false, "", nil
+}
+
+func evalJSONPath(obj map[string]interface{}, expr string) (string, error) {
+	jp := jsonpath.New("healthRule").AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return "", fmt.Errorf("parsing jsonpath %q: %w", expr, err)
+	}
+	var buf strings.Builder
+	if err := jp.Execute(&buf, obj); err != nil {
+		return "", fmt.Errorf("evaluating jsonpath %q: %w", expr, err)
+	}
+	return buf.String(), nil
+}
+
+func conditionStatus(hc latest.HealthCondition) string {
+	if hc.Status == "" {
+		return string(v1.ConditionTrue)
+	}
+	return hc.Status
+}
+
+func describeHealthCondition(hc latest.HealthCondition) string {
+	switch {
+	case hc.JSONPath != "" && hc.Value != "":
+		return fmt.Sprintf("%s to be %q", hc.JSONPath, hc.Value)
+	case hc.JSONPath != "":
+		return fmt.Sprintf("%s to be set", hc.JSONPath)
+	default:
+		return fmt.Sprintf("condition %s to be %s", hc.Condition, conditionStatus(hc))
+	}
+}
+
+func (r *Resource) String() string {
+	if r.namespace == "default" {
+		return fmt.Sprintf("%s/%s", r.rType, r.name)
+	}
+
+	return fmt.Sprintf("%s:%s/%s", r.namespace, r.rType, r.name)
+}
+
+func (r *Resource) Name() string {
+	return r.name
+}
+
+func (r *Resource) Status() Status {
+	return r.status
+}
+
+func (r *Resource) IsStatusCheckCompleteOrCancelled() bool {
+	return r.done || r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED
+}
+
+func (r *Resource) StatusMessage() string {
+	if r.rType == Job && r.statusCode == proto.StatusCode_STATUSCHECK_SUCCESS {
+		// pods of failed attempts don't matter once the job completed
+		return r.status.String()
+	}
+	for _, p := range r.pods {
+		if s := p.ActionableError(); s.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
+			return fmt.Sprintf("%s\n", s.Message)
+		}
+	}
+	return r.status.String()
+}
+
+func (r *Resource) MarkComplete() {
+	r.done = true
+}
+
+// ReportSinceLastUpdated returns a string representing resource status along with tab header
+// e.g.
+//   - testNs:deployment/leeroy-app: waiting for rollout to complete. (1/2) pending
+//   - testNs:pod/leeroy-app-xvbg : error pulling container image
+func (r *Resource) ReportSinceLastUpdated(isMuted bool) string {
+	if r.status.reported && !r.status.changed {
+		return ""
+	}
+	r.status.reported = true
+	if r.status.String() == "" {
+		return ""
+	}
+	var result strings.Builder
+	// Pod container statuses can be empty.
+	// This can happen when
+	// 1. No pods have been scheduled for the resource
+	// 2. All containers are in running phase with no errors.
+	// In such case, avoid printing any status update for the resource.
+	for _, p := range r.pods {
+		if s := p.ActionableError().Message; s != "" {
+			result.WriteString(fmt.Sprintf("%s %s %s: %s\n", tab, tabHeader, p, s))
+			// if logs are muted, write container logs to file and last 3 lines to
+			// result.
+			out, writeTrimLines, err := withLogFile(p.Name(), &result, p.Logs(), isMuted)
+			if err != nil {
+				logrus.Debugf("could not create log file %v", err)
+			}
+			trimLines := []string{}
+			for i, l := range p.Logs() {
+				formattedLine := fmt.Sprintf("%s %s > %s\n", tab, tab, strings.TrimSuffix(l, "\n"))
+				if isMuted && i >= len(p.Logs())-maxLogLines {
+					trimLines = append(trimLines, formattedLine)
+				}
+				out.Write([]byte(formattedLine))
+			}
+			writeTrimLines(trimLines)
+		}
+	}
+	return fmt.Sprintf("%s %s: %s%s", tabHeader, r, r.StatusMessage(), result.String())
+}
+
+func (r *Resource) cleanupStatus(msg string) string {
+	clean := strings.ReplaceAll(msg, kubectlDisplayName(r.rType)+` "`+r.Name()+`" `, "")
+	if len(clean) > 0 {
+		clean = strings.ToLower(clean[0:1]) + clean[1:]
+	}
+	return clean
+}
+
+// parses out connection error
+// $kubectl logs somePod -f
+// Unable to connect to the server: dial tcp x.x.x.x:443: connect: network is unreachable
+
+// Parses out errors when kubectl was killed on client side
+// $kubectl logs testPod  -f
+// 2020/06/18 17:28:31 service is running
+// Killed: 9
+func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
+	switch {
+	case err == nil && isRolloutComplete(details):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: details,
+		}
+	case err == nil:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			Message: details,
+		}
+	case strings.Contains(err.Error(), connectionErrMsg):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+			Message: MsgKubectlConnection,
+		}
+	case strings.Contains(err.Error(), killedErrMsg):
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED,
+			Message: msgKubectlKilled,
+		}
+	default:
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: err.Error(),
+		}
+	}
+}
+
+// parseKubectlGetError maps the failure of `kubectl get` when fetching the status of a resource.
+func parseKubectlGetError(kubectlType string, err error) proto.ActionableErr {
+	ae := parseKubectlRolloutError("", err)
+	if ae.ErrCode == proto.StatusCode_STATUSCHECK_UNKNOWN {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR,
+			Message: fmt.Sprintf("could not fetch %s: %s\n", kubectlType, err),
+		}
+	}
+	return ae
+}
+
+// isRolloutComplete checks the `kubectl rollout status` output of deployments, daemonsets and statefulsets.
+func isRolloutComplete(details string) bool {
+	return strings.Contains(details, rollOutSuccess) ||
+		strings.Contains(details, rollingUpdateComplete) ||
+		strings.Contains(details, partitionedRollOut)
+}
+
+// kubectlDisplayName returns how kubectl refers to a resource type in its rollout messages.
+func kubectlDisplayName(rType Type) string {
+	if rType == DaemonSet {
+		return "daemon set"
+	}
+	return string(rType)
+}
+
+func isErrAndNotRetryAble(statusCode proto.StatusCode) bool {
+	return statusCode != proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR &&
+		statusCode != proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_JOB_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING
+}
+
+// HasEncounteredUnrecoverableError goes through all pod statuses and return true
+// if any cannot be recovered
+func (r *Resource) HasEncounteredUnrecoverableError() bool {
+	if r.rType == Job {
+		// failed pods are replaced until the job's backoff limit is exceeded
+		return false
+	}
+	for _, p := range r.pods {
+		if _, ok := nonRetryContainerErrors[p.ActionableError().ErrCode]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Resource) fetchPods(ctx context.Context) error {
+	timeoutContext, cancel := context.WithTimeout(ctx, defaultPodCheckDeadline)
+	defer cancel()
+	pods, err := r.podValidator.Run(timeoutContext)
+	if err != nil {
+		return err
+	}
+
+	newPods := map[string]validator.Resource{}
+	r.status.changed = false
+	for _, p := range pods {
+		originalPod, found := r.pods[p.String()]
+		if !found || originalPod.StatusUpdated(p) {
+			r.status.changed = true
+			switch p.ActionableError().ErrCode {
+			case proto.StatusCode_STATUSCHECK_CONTAINER_CREATING,
+				proto.StatusCode_STATUSCHECK_POD_INITIALIZING:
+				event.ResourceStatusCheckEventUpdated(p.String(), p.ActionableError())
+			default:
+				event.ResourceStatusCheckEventCompleted(p.String(), p.ActionableError())
+			}
+		}
+		newPods[p.String()] = p
+	}
+	r.pods = newPods
+	return nil
+}
+
+// StatusCode() returns the resource status code if the status check is cancelled
+// or if no pod data exists for this resource.
+// If pods are fetched, this function returns the error code a pod container encountered.
+func (r *Resource) StatusCode() proto.StatusCode {
+	// do not process pod status codes if another resource failed
+	// or the user aborted the run.
+	if r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+		return r.statusCode
+	}
+	for _, p := range r.pods {
+		if s := p.ActionableError().ErrCode; s != proto.StatusCode_STATUSCHECK_SUCCESS {
+			return s
+		}
+	}
+	return r.statusCode
+}
+
+func (r *Resource) WithPodStatuses(scs []proto.StatusCode) *Resource {
+	r.pods = map[string]validator.Resource{}
+	for i, s := range scs {
+		name := fmt.Sprintf("%s-%d", r.name, i)
+		r.pods[name] = validator.NewResource("test", "pod", "foo", validator.Status("failed"),
+			proto.ActionableErr{Message: "pod failed", ErrCode: s}, nil)
+	}
+	return r
+}
@@ -0,0 +1,665 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	utilpointer "k8s.io/utils/pointer"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/diag/validator"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDeploymentCheckStatus(t *testing.T) {
+	rolloutCmd := "kubectl --context kubecontext rollout status deployment dep --namespace test --watch=false"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErr     string
+		expectedDetails string
+		cancelled       bool
+		complete        bool
+	}{
+		{
+			description: "rollout status success",
+			commands: testutil.CmdRunOut(
+				rolloutCmd,
+				"deployment \"dep\" successfully rolled out",
+			),
+			expectedDetails: "successfully rolled out",
+			complete:        true,
+		},
+		{
+			description: "resource not complete",
+			commands: testutil.CmdRunOut(
+				rolloutCmd,
+				"Waiting for replicas to be available",
+			),
+			expectedDetails: "waiting for replicas to be available",
+		},
+		{
+			description: "no output",
+			commands: testutil.CmdRunOut(
+				rolloutCmd,
+				"",
+			),
+		},
+		{
+			description: "rollout status error",
+			commands: testutil.CmdRunOutErr(
+				rolloutCmd,
+				"",
+				errors.New("error"),
+			),
+			expectedErr: "error",
+			complete:    true,
+		},
+		{
+			description: "rollout kubectl client connection error",
+			commands: testutil.CmdRunOutErr(
+				rolloutCmd,
+				"",
+				errors.New("Unable to connect to the server"),
+			),
+			expectedErr: MsgKubectlConnection,
+		},
+		{
+			description: "set status to cancel",
+			commands: testutil.CmdRunOutErr(
+				rolloutCmd,
+				"",
+				errors.New("waiting for replicas to be available"),
+			),
+			cancelled:   true,
+			complete:    true,
+			expectedErr: "context cancelled",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			r := NewResource("dep", Deployment, "test", 0)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			if test.cancelled {
+				r.UpdateStatus(proto.ActionableErr{
+					ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED,
+					Message: "context cancelled",
+				})
+			}
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+			if test.expectedErr != "" {
+				t.CheckErrorContains(test.expectedErr, r.Status().Error())
+			} else {
+				t.CheckDeepEqual(r.status.ae.Message, test.expectedDetails)
+			}
+		})
+	}
+}
+
+func TestRolloutCheckStatus(t *testing.T) {
+	tests := []struct {
+		description     string
+		rType           Type
+		commands        util.Command
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description: "statefulset pending",
+			rType:       StatefulSet,
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status statefulset dep --namespace test --watch=false",
+				"Waiting for 1 pods to be ready...",
+			),
+			expectedDetails: "waiting for 1 pods to be ready...",
+		},
+		{
+			description: "statefulset rolling update complete",
+			rType:       StatefulSet,
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status statefulset dep --namespace test --watch=false",
+				"statefulset rolling update complete 3 pods at revision dep-5d9f...",
+			),
+			expectedDetails: "statefulset rolling update complete 3 pods at revision dep-5d9f...",
+			complete:        true,
+		},
+		{
+			description: "daemonset successfully rolled out",
+			rType:       DaemonSet,
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext rollout status daemonset dep --namespace test --watch=false",
+				"daemon set \"dep\" successfully rolled out",
+			),
+			expectedDetails: "successfully rolled out",
+			complete:        true,
+		},
+		{
+			description: "job pending",
+			rType:       Job,
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get job dep --namespace test -o json",
+				`{"kind":"Job","spec":{"completions":2},"status":{"succeeded":1}}`,
+			),
+			expectedDetails: "waiting for job to complete: 1 of 2 completions succeeded...\n",
+		},
+		{
+			description: "standalone pod ready",
+			rType:       Pod,
+			commands: testutil.CmdRunOut(
+				"kubectl --context kubecontext get pod dep --namespace test -o json",
+				`{"kind":"Pod","status":{"phase":"Running","conditions":[{"type":"Ready","status":"True"}]}}`,
+			),
+			expectedDetails: podReady,
+			complete:        true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			r := NewResource("dep", test.rType, "test", 0)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+			t.CheckDeepEqual(test.expectedDetails, r.status.ae.Message)
+		})
+	}
+}
+
+func TestJobStatus(t *testing.T) {
+	tests := []struct {
+		description string
+		job         batchv1.Job
+		expected    proto.ActionableErr
+	}{
+		{
+			description: "job complete",
+			job: batchv1.Job{Status: batchv1.JobStatus{
+				Succeeded:  1,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}},
+			}},
+			expected: proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: completionSuccess},
+		},
+		{
+			description: "job failed",
+			job: batchv1.Job{Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Message: "Job has reached the specified backoff limit"}},
+			}},
+			expected: proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED, Message: "job failed: Job has reached the specified backoff limit\n"},
+		},
+		{
+			description: "job pending with default completions",
+			job:         batchv1.Job{},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING, Message: "waiting for job to complete: 0 of 1 completions succeeded...\n"},
+		},
+		{
+			description: "failed pods within the backoff limit",
+			job:         batchv1.Job{Spec: batchv1.JobSpec{BackoffLimit: utilpointer.Int32Ptr(2)}, Status: batchv1.JobStatus{Failed: 2}},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_JOB_PENDING, Message: "waiting for job to complete: 0 of 1 completions succeeded, 2 of 2 retries used...\n"},
+		},
+		{
+			description: "failed pods exceed the backoff limit",
+			job:         batchv1.Job{Spec: batchv1.JobSpec{BackoffLimit: utilpointer.Int32Ptr(2)}, Status: batchv1.JobStatus{Failed: 3}},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED, Message: "job failed: 3 failed pods exceed the backoff limit of 2\n"},
+		},
+		{
+			description: "failed pods exceed the default backoff limit",
+			job:         batchv1.Job{Status: batchv1.JobStatus{Failed: 7}},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED, Message: "job failed: 7 failed pods exceed the backoff limit of 6\n"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, jobStatus(test.job))
+		})
+	}
+}
+
+func TestPodStatus(t *testing.T) {
+	tests := []struct {
+		description string
+		pod         v1.Pod
+		expected    proto.ActionableErr
+	}{
+		{
+			description: "pod succeeded",
+			pod:         v1.Pod{Status: v1.PodStatus{Phase: v1.PodSucceeded}},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: completionSuccess},
+		},
+		{
+			description: "pod failed",
+			pod:         v1.Pod{Status: v1.PodStatus{Phase: v1.PodFailed, Reason: "Evicted"}},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED, Message: "pod failed: Evicted\n"},
+		},
+		{
+			description: "pod running but not ready",
+			pod: v1.Pod{Status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}},
+			}},
+			expected: proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING, Message: "waiting for pod to be ready: phase running\n"},
+		},
+		{
+			description: "pod pending",
+			pod:         v1.Pod{Status: v1.PodStatus{Phase: v1.PodPending}},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING, Message: "waiting for pod to be ready: phase pending\n"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, podStatus(test.pod))
+		})
+	}
+}
+
+func TestCustomResourceStatus(t *testing.T) {
+	conditionRule := latest.HealthRule{
+		Group:   "cert-manager.io",
+		Version: "v1",
+		Kind:    "Certificate",
+		Ready:   latest.HealthCondition{Condition: "Ready"},
+		Failed:  &latest.HealthCondition{Condition: "Issuing", Status: "False"},
+	}
+	jsonPathRule := latest.HealthRule{
+		Group:   "argoproj.io",
+		Version: "v1alpha1",
+		Kind:    "Rollout",
+		Ready:   latest.HealthCondition{JSONPath: "{.status.phase}", Value: "Healthy"},
+		Failed:  &latest.HealthCondition{JSONPath: "{.status.phase}", Value: "Degraded"},
+	}
+	tests := []struct {
+		description string
+		obj         string
+		rule        latest.HealthRule
+		expected    proto.ActionableErr
+	}{
+		{
+			description: "ready condition",
+			obj:         `{"status":{"conditions":[{"type":"Ready","status":"True"}]}}`,
+			rule:        conditionRule,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: resourceReady},
+		},
+		{
+			description: "failed condition",
+			obj:         `{"status":{"conditions":[{"type":"Ready","status":"False"},{"type":"Issuing","status":"False","message":"order failed"}]}}`,
+			rule:        conditionRule,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED, Message: "resource failed: condition Issuing is False: order failed\n"},
+		},
+		{
+			description: "no conditions yet",
+			obj:         `{"metadata":{"name":"cert"}}`,
+			rule:        conditionRule,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING, Message: "waiting for condition Ready to be True\n"},
+		},
+		{
+			description: "ready jsonpath",
+			obj:         `{"status":{"phase":"Healthy"}}`,
+			rule:        jsonPathRule,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: resourceReady},
+		},
+		{
+			description: "failed jsonpath",
+			obj:         `{"status":{"phase":"Degraded"}}`,
+			rule:        jsonPathRule,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED, Message: "resource failed: {.status.phase} is \"Degraded\"\n"},
+		},
+		{
+			description: "pending jsonpath",
+			obj:         `{"status":{"phase":"Progressing"}}`,
+			rule:        jsonPathRule,
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING, Message: "waiting for {.status.phase} to be \"Healthy\"\n"},
+		},
+		{
+			description: "jsonpath without value matches any result",
+			obj:         `{"status":{"url":"http://hello.default.example.com"}}`,
+			rule:        latest.HealthRule{Kind: "Service", Ready: latest.HealthCondition{JSONPath: "{.status.url}"}},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS, Message: resourceReady},
+		},
+		{
+			description: "invalid jsonpath",
+			obj:         `{}`,
+			rule:        latest.HealthRule{Kind: "Service", Ready: latest.HealthCondition{JSONPath: "{.status["}},
+			expected:    proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: `parsing jsonpath "{.status[": unterminated array`},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var obj map[string]interface{}
+			t.CheckNoError(json.Unmarshal([]byte(test.obj), &obj))
+			t.CheckDeepEqual(test.expected, customResourceStatus(obj, test.rule))
+		})
+	}
+}
+
+func TestParseKubectlError(t *testing.T) {
+	tests := []struct {
+		description string
+		details     string
+		err         error
+		expectedAe  proto.ActionableErr
+	}{
+		{
+			description: "rollout status connection error",
+			err:         errors.New("Unable to connect to the server"),
+			expectedAe: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+				Message: MsgKubectlConnection,
+			},
+		},
+		{
+			description: "rollout status kubectl command killed",
+			err:         errors.New("signal: killed"),
+			expectedAe: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED,
+				Message: msgKubectlKilled,
+			},
+		},
+		{
+			description: "rollout status random error",
+			err:         errors.New("deployment test not found"),
+			expectedAe: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+				Message: "deployment test not found",
+			},
+		},
+		{
+			description: "rollout status nil error",
+			details:     "successfully rolled out",
+			expectedAe: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: "successfully rolled out",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ae := parseKubectlRolloutError(test.details, test.err)
+			t.CheckDeepEqual(test.expectedAe, ae)
+		})
+	}
+}
+
+func TestParseKubectlGetError(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expectedAe  proto.ActionableErr
+	}{
+		{
+			description: "connection error",
+			err:         errors.New("Unable to connect to the server"),
+			expectedAe: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+				Message: MsgKubectlConnection,
+			},
+		},
+		{
+			description: "resource not found",
+			err:         errors.New(`jobs.batch "test" not found`),
+			expectedAe: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR,
+				Message: "could not fetch job: jobs.batch \"test\" not found\n",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ae := parseKubectlGetError("job", test.err)
+			t.CheckDeepEqual(test.expectedAe, ae)
+		})
+	}
+}
+
+func TestJobPodErrorsAreRecoverable(t *testing.T) {
+	job := NewResource("job", Job, "test", 0).WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED})
+	testutil.CheckDeepEqual(t, false, job.HasEncounteredUnrecoverableError())
+
+	pod := NewResource("pod", Pod, "test", 0).WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED})
+	testutil.CheckDeepEqual(t, true, pod.HasEncounteredUnrecoverableError())
+}
+
+func TestIsErrAndNotRetriable(t *testing.T) {
+	tests := []struct {
+		description string
+		statusCode  proto.StatusCode
+		expected    bool
+	}{
+		{
+			description: "rollout status connection error",
+			statusCode:  proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
+		},
+		{
+			description: "rollout status kubectl command killed",
+			statusCode:  proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED,
+			expected:    true,
+		},
+		{
+			description: "rollout status random error",
+			statusCode:  proto.StatusCode_STATUSCHECK_UNKNOWN,
+			expected:    true,
+		},
+		{
+			description: "standalone pods pending",
+			statusCode:  proto.StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING,
+		},
+		{
+			description: "job pending",
+			statusCode:  proto.StatusCode_STATUSCHECK_JOB_PENDING,
+		},
+		{
+			description: "custom resource pending",
+			statusCode:  proto.StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING,
+		},
+		{
+			description: "rollout status parent context canceled",
+			statusCode:  proto.StatusCode_STATUSCHECK_USER_CANCELLED,
+			expected:    true,
+		},
+		{
+			description: "rollout status parent context timed out",
+			statusCode:  proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED,
+			expected:    true,
+		},
+		{
+			description: "rollout status nil error",
+			statusCode:  proto.StatusCode_STATUSCHECK_SUCCESS,
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			actual := isErrAndNotRetryAble(test.statusCode)
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
+func TestReportSinceLastUpdated(t *testing.T) {
+	tmpDir := filepath.Clean(os.TempDir())
+	var tests = []struct {
+		description  string
+		ae           proto.ActionableErr
+		logs         []string
+		expected     string
+		expectedMute string
+	}{
+		{
+			description: "logs more than 3 lines",
+			ae:          proto.ActionableErr{Message: "waiting for 0/1 deplotment to rollout\n"},
+			logs: []string{
+				"[pod container] Waiting for mongodb to start...",
+				"[pod container] Waiting for connection for 2 sec",
+				"[pod container] Retrying 1st attempt ....",
+				"[pod container] Waiting for connection for 2 sec",
+				"[pod container] Terminating with exit code 11",
+			},
+			expectedMute: fmt.Sprintf(` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+      > [pod container] Retrying 1st attempt ....
+      > [pod container] Waiting for connection for 2 sec
+      > [pod container] Terminating with exit code 11
+      Full logs at %s
+`, filepath.Join(tmpDir, "skaffold", "statuscheck", "foo.log")),
+			expected: ` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+      > [pod container] Waiting for mongodb to start...
+      > [pod container] Waiting for connection for 2 sec
+      > [pod container] Retrying 1st attempt ....
+      > [pod container] Waiting for connection for 2 sec
+      > [pod container] Terminating with exit code 11
+`,
+		},
+		{
+			description: "logs less than 3 lines",
+			ae:          proto.ActionableErr{Message: "waiting for 0/1 deplotment to rollout\n"},
+			logs: []string{
+				"[pod container] Waiting for mongodb to start...",
+				"[pod container] Waiting for connection for 2 sec",
+				"[pod container] Terminating with exit code 11",
+			},
+			expected: ` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+      > [pod container] Waiting for mongodb to start...
+      > [pod container] Waiting for connection for 2 sec
+      > [pod container] Terminating with exit code 11
+`,
+			expectedMute: ` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+      > [pod container] Waiting for mongodb to start...
+      > [pod container] Waiting for connection for 2 sec
+      > [pod container] Terminating with exit code 11
+`,
+		},
+		{
+			description: "no logs or empty",
+			ae:          proto.ActionableErr{Message: "waiting for 0/1 deplotment to rollout\n"},
+			expected: ` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+`,
+			expectedMute: ` - test-ns:deployment/test: container terminated with exit code 11
+    - test:pod/foo: container terminated with exit code 11
+`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			dep := NewResource("test", Deployment, "test-ns", 1)
+			dep.pods = map[string]validator.Resource{
+				"foo": validator.NewResource(
+					"test",
+					"pod",
+					"foo",
+					"Pending",
+					proto.ActionableErr{
+						ErrCode: proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR,
+						Message: "container terminated with exit code 11"},
+					test.logs,
+				),
+			}
+			dep.UpdateStatus(test.ae)
+			t.CheckDeepEqual(test.expectedMute, dep.ReportSinceLastUpdated(true))
+			t.CheckTrue(dep.status.changed)
+			// force report to false and Report again with mute logs false
+			dep.status.reported = false
+			t.CheckDeepEqual(test.expected, dep.ReportSinceLastUpdated(false))
+		})
+	}
+}
+
+func TestReportSinceLastUpdatedMultipleTimes(t *testing.T) {
+	var tests = []struct {
+		description     string
+		podStatuses     []string
+		reportStatusSeq []bool
+		expected        string
+	}{
+		{
+			description:     "report first time should return status",
+			podStatuses:     []string{"cannot pull image"},
+			reportStatusSeq: []bool{true},
+			expected: ` - test-ns:deployment/test: cannot pull image
+    - test:pod/foo: cannot pull image
+`,
+		},
+		{
+			description:     "report 2nd time should not return when same status",
+			podStatuses:     []string{"cannot pull image", "cannot pull image"},
+			reportStatusSeq: []bool{true, true},
+			expected:        "",
+		},
+		{
+			description:     "report called after multiple changes but last status was not changed.",
+			podStatuses:     []string{"cannot pull image", "changed but not reported", "changed but not reported", "changed but not reported"},
+			reportStatusSeq: []bool{true, false, false, true},
+			expected: ` - test-ns:deployment/test: changed but not reported
+    - test:pod/foo: changed but not reported
+`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			dep := NewResource("test", Deployment, "test-ns", 1)
+			var actual string
+			for i, status := range test.podStatuses {
+				dep.UpdateStatus(proto.ActionableErr{
+					ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+					Message: status,
+				})
+				dep.pods = map[string]validator.Resource{
+					"foo": validator.NewResource(
+						"test",
+						"pod",
+						"foo",
+						"Pending",
+						proto.ActionableErr{
+							ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+							Message: status,
+						},
+						[]string{},
+					),
+				}
+				if test.reportStatusSeq[i] {
+					actual = dep.ReportSinceLastUpdated(false)
+				}
+			}
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
+type statusConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+}
+
+func (c *statusConfig) GetKubeContext() string { return "kubecontext" }
@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"syscall"
+
+	"github.com/google/uuid"
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// For testing
+var (
+	processRunning = isRunning
+	getpid         = os.Getpid
+)
+
+// Store remembers what was last deployed for a skaffold configuration
+// and a kube-context, so that deployers can skip what didn't change,
+// even across skaffold sessions. Deployers still check that what they skip
+// is live in the cluster.
+// Only one session at a time uses the persisted state: a concurrent session
+// gets its own run ID and only remembers what it deploys itself.
+// A nil Store is valid: it remembers nothing.
+type Store struct {
+	file  string
+	mu    sync.Mutex
+	state persistedState
+}
+
+type persistedState struct {
+	RunID string `yaml:"runID,omitempty"`
+	// Owner is the process ID of the session that last saved the state.
+	Owner        int               `yaml:"owner,omitempty"`
+	Fingerprints map[string]string `yaml:"fingerprints,omitempty"`
+}
+
+// New returns a Store that is kept in memory only.
+func New() *Store {
+	return &Store{}
+}
+
+// Load reads the state persisted for a given kube-context and configuration file.
+// Failing to read it is not an error: the deployment just starts from scratch.
+func Load(kubeContext, configFile string) *Store {
+	file, err := stateFile(kubeContext, configFile)
+	if err != nil {
+		logrus.Warnf("Error resolving deploy state file, deploys won't be incremental across sessions: %v", err)
+		return New()
+	}
+
+	return LoadFile(file)
+}
+
+// LoadFile reads the state persisted in a given file.
+func LoadFile(file string) *Store {
+	s := &Store{file: file}
+	contents, err := ioutil.ReadFile(file)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		logrus.Warnf("Error reading deploy state, starting from scratch: %v", err)
+	default:
+		if err := yaml.Unmarshal(contents, &s.state); err != nil {
+			logrus.Warnf("Error parsing deploy state %q, starting from scratch: %v", file, err)
+			s.state = persistedState{}
+		}
+	}
+
+	if owner := s.state.Owner; owner != 0 && owner != getpid() && processRunning(owner) {
+		logrus.Infof("Another skaffold session (pid %d) deploys the same configuration, deploys won't be incremental across sessions", owner)
+		return New()
+	}
+
+	return s
+}
+
+// stateFile returns the location of the state file for a given kube-context and configuration file.
+func stateFile(kubeContext, configFile string) (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("retrieving home directory: %w", err)
+	}
+
+	absConfigFile, err := filepath.Abs(configFile)
+	if err != nil {
+		return "", fmt.Errorf("resolving %q: %w", configFile, err)
+	}
+
+	name := Fingerprint(kubeContext, absConfigFile)[:16] + ".yaml"
+	return filepath.Join(home, constants.DefaultSkaffoldDir, constants.DefaultDeployState, name), nil
+}
+
+// RunID returns the run ID persisted by a previous session, or a new one.
+// Reusing the run ID is what keeps the labels, and therefore the manifests,
+// stable from one session to the next.
+func (s *Store) RunID() string {
+	if s == nil {
+		return ""
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.RunID == "" {
+		s.state.RunID = uuid.New().String()
+	}
+	return s.state.RunID
+}
+
+// Unchanged checks if the last recorded fingerprint for a key is the given one.
+func (s *Store) Unchanged(key, fingerprint string) bool {
+	if s == nil || fingerprint == "" {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.state.Fingerprints[key] == fingerprint
+}
+
+// Record remembers the fingerprint of what was just deployed for a key.
+func (s *Store) Record(key, fingerprint string) {
+	if s == nil || fingerprint == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.state.Fingerprints == nil {
+		s.state.Fingerprints = map[string]string{}
+	}
+	s.state.Fingerprints[key] = fingerprint
+}
+
+// Reset forgets every fingerprint. It's used once what was deployed is cleaned up.
+func (s *Store) Reset() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state.Fingerprints = nil
+}
+
+// Save persists the state, if the Store is backed by a file.
+func (s *Store) Save() error {
+	if s == nil || s.file == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state.Owner = getpid()
+	data, err := yaml.Marshal(s.state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.file), 0755); err != nil {
+		return fmt.Errorf("creating deploy state directory: %w", err)
+	}
+	return ioutil.WriteFile(s.file, data, 0644)
+}
+
+// isRunning checks whether a process is still running.
+func isRunning(pid int) bool {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	defer process.Release()
+
+	if runtime.GOOS == "windows" {
+		// On Windows, FindProcess fails when the process doesn't exist.
+		return true
+	}
+	err = process.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, os.ErrPermission)
+}
+
+// Fingerprint computes a stable hash of a list of strings.
+func Fingerprint(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		fmt.Fprintf(h, "%d:%s", len(part), part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// FilesFingerprint computes a stable hash of the names and contents of a list of files.
+func FilesFingerprint(paths []string) (string, error) {
+	h := sha256.New()
+	for _, path := range paths {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%d:%s%d:", len(path), path, len(contents))
+		h.Write(contents)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestStore(t *testing.T) {
+	s := New()
+
+	testutil.CheckDeepEqual(t, false, s.Unchanged("key", "fp1"))
+	s.Record("key", "fp1")
+	testutil.CheckDeepEqual(t, true, s.Unchanged("key", "fp1"))
+	testutil.CheckDeepEqual(t, false, s.Unchanged("key", "fp2"))
+
+	s.Reset()
+	testutil.CheckDeepEqual(t, false, s.Unchanged("key", "fp1"))
+}
+
+func TestNilStore(t *testing.T) {
+	var s *Store
+
+	s.Record("key", "fp")
+	testutil.CheckDeepEqual(t, false, s.Unchanged("key", "fp"))
+	testutil.CheckDeepEqual(t, "", s.RunID())
+	s.Reset()
+	testutil.CheckError(t, false, s.Save())
+}
+
+func TestPersistedStore(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		file := filepath.Join(t.NewTempDir().Root(), "state", "deploy.yaml")
+
+		s := LoadFile(file)
+		runID := s.RunID()
+		s.Record("key", "fp1")
+		t.CheckNoError(s.Save())
+
+		// The next session reuses the run ID and the fingerprints.
+		s = LoadFile(file)
+		t.CheckDeepEqual(runID, s.RunID())
+		t.CheckTrue(s.Unchanged("key", "fp1"))
+
+		s.Reset()
+		t.CheckNoError(s.Save())
+		s = LoadFile(file)
+		t.CheckDeepEqual(runID, s.RunID())
+		t.CheckFalse(s.Unchanged("key", "fp1"))
+	})
+}
+
+func TestConcurrentSessions(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		file := t.NewTempDir().Path("deploy.yaml")
+
+		s := LoadFile(file)
+		runID := s.RunID()
+		s.Record("key", "fp1")
+		t.CheckNoError(s.Save())
+
+		// Pretend that the session that saved the state is another process that is still running.
+		t.Override(&processRunning, func(pid int) bool { return pid == os.Getpid() })
+		t.Override(&getpid, func() int { return os.Getpid() + 1 })
+
+		s = LoadFile(file)
+		t.CheckFalse(s.RunID() == runID)
+		t.CheckFalse(s.Unchanged("key", "fp1"))
+		t.CheckNoError(s.Save())
+
+		// Once the other session is gone, the state can be reused.
+		t.Override(&processRunning, func(int) bool { return false })
+
+		s = LoadFile(file)
+		t.CheckDeepEqual(runID, s.RunID())
+		t.CheckTrue(s.Unchanged("key", "fp1"))
+	})
+}
+
+func TestIsRunning(t *testing.T) {
+	testutil.CheckDeepEqual(t, true, isRunning(os.Getpid()))
+}
+
+func TestFingerprint(t *testing.T) {
+	testutil.CheckDeepEqual(t, Fingerprint("a", "b"), Fingerprint("a", "b"))
+	testutil.CheckDeepEqual(t, false, Fingerprint("ab", "") == Fingerprint("a", "b"))
+}
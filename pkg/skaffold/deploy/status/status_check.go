@@ -26,7 +26,10 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/diag"
@@ -76,7 +79,7 @@ type Checker interface {
 	Check(context.Context, io.Writer) error
 }
 
-// statusChecker runs status checks for pods and deployments
+// statusChecker runs status checks for pods, deployments, statefulsets, daemonsets and jobs
 type statusChecker struct {
 	cfg             Config
 	labeller        *label.DefaultLabeller
@@ -84,7 +87,7 @@ type statusChecker struct {
 	muteLogs        bool
 }
 
-// NewStatusChecker returns a status checker which runs checks on deployments and pods.
+// NewStatusChecker returns a status checker which runs checks on workloads and pods.
 func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
 	return statusChecker{
 		muteLogs:        cfg.Muted().MuteStatusCheck(),
@@ -94,7 +97,7 @@ func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
 	}
 }
 
-// Run runs the status checks on deployments and pods deployed in current skaffold dev iteration.
+// Run runs the status checks on workloads and pods deployed in current skaffold dev iteration.
 func (s statusChecker) Check(ctx context.Context, out io.Writer) error {
 	event.StatusCheckEventStarted()
 	errCode, err := s.statusCheck(ctx, out)
@@ -108,50 +111,76 @@ func (s statusChecker) statusCheck(ctx context.Context, out io.Writer) (proto.St
 		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, 0)
+	resources := make([]*resource.Resource, 0)
 	for _, n := range s.cfg.GetNamespaces() {
-		newDeployments, err := getDeployments(ctx, client, n, s.labeller,
+		for _, fetch := range resourceFetchers {
+			newResources, err := fetch(ctx, client, n, s.labeller,
+				getDeadline(s.cfg.Pipeline().Deploy.StatusCheckDeadlineSeconds))
+			if err != nil {
+				return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch resources: %w", err)
+			}
+			resources = append(resources, newResources...)
+		}
+	}
+
+	if rules := s.cfg.Pipeline().Deploy.HealthRules; len(rules) > 0 {
+		dynClient, err := kubernetesclient.DynamicClient()
+		if err != nil {
+			return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes dynamic client: %w", err)
+		}
+		newResources, err := getCustomResources(ctx, client, dynClient, s.cfg.GetNamespaces(), s.labeller, rules,
 			getDeadline(s.cfg.Pipeline().Deploy.StatusCheckDeadlineSeconds))
 		if err != nil {
-			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
+			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch resources: %w", err)
 		}
-		deployments = append(deployments, newDeployments...)
+		resources = append(resources, newResources...)
 	}
 
 	var wg sync.WaitGroup
 
-	c := newCounter(len(deployments))
+	c := newCounter(len(resources))
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	for _, d := range deployments {
+	for _, d := range resources {
 		wg.Add(1)
-		go func(r *resource.Deployment) {
+		go func(r *resource.Resource) {
 			defer wg.Done()
 			// keep updating the resource status until it fails/succeeds/times out
-			pollDeploymentStatus(ctx, s.cfg, r)
+			pollResourceStatus(ctx, s.cfg, r)
 			rcCopy := c.markProcessed(r.Status().Error())
 			s.printStatusCheckSummary(out, r, rcCopy)
-			// if one deployment fails, cancel status checks for all deployments.
+			// if one resource fails, cancel status checks for all resources.
 			if r.Status().Error() != nil && r.StatusCode() != proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 				cancel()
 			}
 		}(d)
 	}
 
-	// Retrieve pending deployments statuses
+	// Retrieve pending resource statuses
 	go func() {
-		s.printDeploymentStatus(ctx, out, deployments)
+		s.printResourceStatus(ctx, out, resources)
 	}()
 
-	// Wait for all deployment statuses to be fetched
+	// Wait for all resource statuses to be fetched
 	wg.Wait()
 	cancel()
-	return getSkaffoldDeployStatus(c, deployments)
+	return getSkaffoldDeployStatus(c, resources)
+}
+
+// resourceFetcher lists the resources of one kind deployed in the current run.
+type resourceFetcher func(context.Context, kubernetes.Interface, string, *label.DefaultLabeller, time.Duration) ([]*resource.Resource, error)
+
+var resourceFetchers = []resourceFetcher{
+	getDeployments,
+	getStatefulSets,
+	getDaemonSets,
+	getJobs,
+	getStandalonePods,
 }
 
-func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Deployment, error) {
+func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
 	deps, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{
 		LabelSelector: l.RunIDSelector(),
 	})
@@ -159,7 +188,7 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		return nil, fmt.Errorf("could not fetch deployments: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, len(deps.Items))
+	deployments := make([]*resource.Resource, len(deps.Items))
 	for i, d := range deps.Items {
 		var deadline time.Duration
 		if d.Spec.ProgressDeadlineSeconds == nil || *d.Spec.ProgressDeadlineSeconds == kubernetesMaxDeadline {
@@ -167,20 +196,153 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		} else {
 			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
 		}
-		pd := diag.New([]string{d.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+		deployments[i] = newResource(client, l, d.Name, resource.Deployment, d.Namespace, d.Spec.Template.Labels, deadline)
+	}
+	return deployments, nil
+}
+
+func getStatefulSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch statefulsets: %w", err)
+	}
+
+	var statefulSets []*resource.Resource
+	for _, s := range sets.Items {
+		// `kubectl rollout status` is only available for the RollingUpdate strategy.
+		if s.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+			logrus.Debugf("skipping status check for statefulset %s with %s update strategy", s.Name, s.Spec.UpdateStrategy.Type)
+			continue
+		}
+		statefulSets = append(statefulSets, newResource(client, l, s.Name, resource.StatefulSet, s.Namespace, s.Spec.Template.Labels, deadlineDuration))
+	}
+	return statefulSets, nil
+}
+
+func getDaemonSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch daemonsets: %w", err)
+	}
 
-		for k, v := range d.Spec.Template.Labels {
-			pd = pd.WithLabel(k, v)
+	var daemonSets []*resource.Resource
+	for _, d := range sets.Items {
+		// `kubectl rollout status` is only available for the RollingUpdate strategy.
+		if d.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			logrus.Debugf("skipping status check for daemonset %s with %s update strategy", d.Name, d.Spec.UpdateStrategy.Type)
+			continue
 		}
+		daemonSets = append(daemonSets, newResource(client, l, d.Name, resource.DaemonSet, d.Namespace, d.Spec.Template.Labels, deadlineDuration))
+	}
+	return daemonSets, nil
+}
 
-		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(pd)
+func getJobs(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	list, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch jobs: %w", err)
 	}
-	return deployments, nil
+
+	jobs := make([]*resource.Resource, len(list.Items))
+	for i, j := range list.Items {
+		jobs[i] = newResource(client, l, j.Name, resource.Job, j.Namespace, j.Spec.Template.Labels, deadlineDuration)
+	}
+	return jobs, nil
+}
+
+// getStandalonePods returns the pods deployed in the current run which are not managed by a controller.
+func getStandalonePods(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	list, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch pods: %w", err)
+	}
+
+	var pods []*resource.Resource
+	for _, p := range list.Items {
+		if len(p.OwnerReferences) > 0 {
+			continue
+		}
+		pods = append(pods, newResource(client, l, p.Name, resource.Pod, p.Namespace, p.Labels, deadlineDuration))
+	}
+	return pods, nil
+}
+
+// getCustomResources returns the resources deployed in the current run whose kind has a health rule.
+// Kinds that the cluster doesn't serve are skipped.
+func getCustomResources(ctx context.Context, client kubernetes.Interface, dynClient dynamic.Interface, namespaces []string, l *label.DefaultLabeller, rules []latest.HealthRule, deadlineDuration time.Duration) ([]*resource.Resource, error) {
+	var resources []*resource.Resource
+	for _, rule := range rules {
+		gvk := schema.GroupVersionKind{Group: rule.Group, Version: rule.Version, Kind: rule.Kind}
+		gvr, namespaced, err := groupVersionResource(client, gvk)
+		if err != nil {
+			logrus.Warnf("Skipping the status check of %s resources: %v", gvk.Kind, err)
+			continue
+		}
+
+		kubectlType := strings.Join([]string{gvr.Resource, gvr.Version, gvr.Group}, ".")
+		if gvr.Group == "" {
+			kubectlType = gvr.Resource
+		}
+
+		// cluster-scoped resources are listed once, across all namespaces
+		listNamespaces := namespaces
+		if !namespaced {
+			listNamespaces = []string{""}
+		}
+		for _, ns := range listNamespaces {
+			list, err := dynClient.Resource(gvr).Namespace(ns).List(ctx, metav1.ListOptions{
+				LabelSelector: l.RunIDSelector(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not fetch %s: %w", gvr.Resource, err)
+			}
+
+			for _, item := range list.Items {
+				r := resource.NewResource(item.GetName(), resource.Type(strings.ToLower(rule.Kind)), item.GetNamespace(), deadlineDuration)
+				resources = append(resources, r.WithHealthRule(rule, kubectlType))
+			}
+		}
+	}
+	return resources, nil
+}
+
+// groupVersionResource looks up the resource name of a kind on the cluster, and whether it's namespaced.
+func groupVersionResource(client kubernetes.Interface, gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+	resources, err := client.Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if err != nil {
+		return schema.GroupVersionResource{}, false, fmt.Errorf("getting server resources for %s: %w", gvk.GroupVersion(), err)
+	}
+
+	for _, r := range resources.APIResources {
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			return gvk.GroupVersion().WithResource(r.Name), r.Namespaced, nil
+		}
+	}
+	return schema.GroupVersionResource{}, false, fmt.Errorf("could not find resource for %s", gvk)
+}
+
+// newResource creates a resource whose pods are selected by the given pod template labels.
+func newResource(client kubernetes.Interface, l *label.DefaultLabeller, name string, rType resource.Type, ns string, podLabels map[string]string, deadline time.Duration) *resource.Resource {
+	pd := diag.New([]string{ns}).
+		WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+		WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+
+	for k, v := range podLabels {
+		pd = pd.WithLabel(k, v)
+	}
+
+	return resource.NewResource(name, rType, ns, deadline).WithValidator(pd)
 }
 
-func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Deployment) {
+func pollResourceStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Resource) {
 	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
 	// Add poll duration to account for one last attempt after progressDeadlineSeconds.
 	timeoutContext, cancel := context.WithTimeout(ctx, r.Deadline()+pollDuration)
@@ -220,9 +382,9 @@ func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resourc
 	}
 }
 
-func getSkaffoldDeployStatus(c *counter, rs []*resource.Deployment) (proto.StatusCode, error) {
+func getSkaffoldDeployStatus(c *counter, rs []*resource.Resource) (proto.StatusCode, error) {
 	if c.failed > 0 {
-		err := fmt.Errorf("%d/%d deployment(s) failed", c.failed, c.total)
+		err := fmt.Errorf("%d/%d resource(s) failed", c.failed, c.total)
 		for _, r := range rs {
 			if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
 				return r.StatusCode(), err
@@ -239,11 +401,11 @@ func getDeadline(d int) time.Duration {
 	return defaultStatusCheckDeadline
 }
 
-func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deployment, c counter) {
+func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Resource, c counter) {
 	ae := r.Status().ActionableError()
 	if r.StatusCode() == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 		// Don't print the status summary if the user ctrl-C or
-		// another deployment failed
+		// another resource failed
 		return
 	}
 	event.ResourceStatusCheckEventCompleted(r.String(), ae)
@@ -263,15 +425,15 @@ func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deploy
 	fmt.Fprintln(out, status)
 }
 
-// printDeploymentStatus prints resource statuses until all status check are completed or context is cancelled.
-func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer, deployments []*resource.Deployment) {
+// printResourceStatus prints resource statuses until all status check are completed or context is cancelled.
+func (s statusChecker) printResourceStatus(ctx context.Context, out io.Writer, resources []*resource.Resource) {
 	for {
 		var allDone bool
 		select {
 		case <-ctx.Done():
 			return
 		case <-time.After(reportStatusTime):
-			allDone = s.printStatus(deployments, out)
+			allDone = s.printStatus(resources, out)
 		}
 		if allDone {
 			return
@@ -279,9 +441,9 @@ func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer,
 	}
 }
 
-func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Writer) bool {
+func (s statusChecker) printStatus(resources []*resource.Resource, out io.Writer) bool {
 	allDone := true
-	for _, r := range deployments {
+	for _, r := range resources {
 		if r.IsStatusCheckCompleteOrCancelled() {
 			continue
 		}
@@ -296,7 +458,7 @@ func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Wr
 
 func getPendingMessage(pending int32, total int) string {
 	if pending > 0 {
-		return fmt.Sprintf(" [%d/%d deployment(s) still pending]", pending, total)
+		return fmt.Sprintf(" [%d/%d resource(s) still pending]", pending, total)
 	}
 	return ""
 }
@@ -26,8 +26,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	fakedynclient "k8s.io/client-go/dynamic/fake"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	utilpointer "k8s.io/utils/pointer"
 
@@ -45,11 +49,11 @@ import (
 )
 
 func TestGetDeployments(t *testing.T) {
-	labeller := label.NewLabeller(true, nil)
+	labeller := label.NewLabeller(true, nil, "")
 	tests := []struct {
 		description string
 		deps        []*appsv1.Deployment
-		expected    []*resource.Deployment
+		expected    []*resource.Resource
 		shouldErr   bool
 	}{
 		{
@@ -77,9 +81,9 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(20)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 10*time.Second),
-				resource.NewDeployment("dep2", "test", 20*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.Deployment, "test", 10*time.Second),
+				resource.NewResource("dep2", resource.Deployment, "test", 20*time.Second),
 			},
 		},
 		{
@@ -97,8 +101,8 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(300)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 300*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.Deployment, "test", 300*time.Second),
 			},
 		},
 		{
@@ -124,9 +128,9 @@ func TestGetDeployments(t *testing.T) {
 					},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 100*time.Second),
-				resource.NewDeployment("dep2", "test", 200*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.Deployment, "test", 100*time.Second),
+				resource.NewResource("dep2", resource.Deployment, "test", 200*time.Second),
 			},
 		},
 		{
@@ -143,13 +147,13 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(600)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 200*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.Deployment, "test", 200*time.Second),
 			},
 		},
 		{
 			description: "no deployments",
-			expected:    []*resource.Deployment{},
+			expected:    []*resource.Resource{},
 		},
 		{
 			description: "multiple deployments in different namespaces",
@@ -175,8 +179,8 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{
-				resource.NewDeployment("dep1", "test", 100*time.Second),
+			expected: []*resource.Resource{
+				resource.NewResource("dep1", resource.Deployment, "test", 100*time.Second),
 			},
 		},
 		{
@@ -193,7 +197,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 		{
 			description: "deployment in correct namespace deployed by skaffold but different run",
@@ -209,7 +213,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 	}
 
@@ -222,7 +226,160 @@ func TestGetDeployments(t *testing.T) {
 			client := fakekubeclientset.NewSimpleClientset(objs...)
 			actual, err := getDeployments(context.Background(), client, "test", labeller, 200*time.Second)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, &test.expected, &actual,
-				cmp.AllowUnexported(resource.Deployment{}, resource.Status{}),
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestGetResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "")
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	tests := []struct {
+		description string
+		objs        []runtime.Object
+		fetcher     resourceFetcher
+		expected    []*resource.Resource
+	}{
+		{
+			description: "statefulsets with rolling update strategy",
+			objs: []runtime.Object{
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runLabels}},
+				&appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: "test", Labels: runLabels},
+					Spec:       appsv1.StatefulSetSpec{UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}},
+				},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test"}},
+			},
+			fetcher: getStatefulSets,
+			expected: []*resource.Resource{
+				resource.NewResource("db", resource.StatefulSet, "test", 200*time.Second),
+			},
+		},
+		{
+			description: "daemonsets",
+			objs: []runtime.Object{
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "test", Labels: runLabels}},
+			},
+			fetcher: getDaemonSets,
+			expected: []*resource.Resource{
+				resource.NewResource("agent", resource.DaemonSet, "test", 200*time.Second),
+			},
+		},
+		{
+			description: "jobs",
+			objs: []runtime.Object{
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: "test", Labels: runLabels}},
+			},
+			fetcher: getJobs,
+			expected: []*resource.Resource{
+				resource.NewResource("migration", resource.Job, "test", 200*time.Second),
+			},
+		},
+		{
+			description: "only standalone pods",
+			objs: []runtime.Object{
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bare", Namespace: "test", Labels: runLabels}},
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Name:            "dep-pod",
+					Namespace:       "test",
+					Labels:          runLabels,
+					OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "dep"}},
+				}},
+			},
+			fetcher: getStandalonePods,
+			expected: []*resource.Resource{
+				resource.NewResource("bare", resource.Pod, "test", 200*time.Second),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(test.objs...)
+			actual, err := test.fetcher(context.Background(), client, "test", labeller, 200*time.Second)
+			t.CheckErrorAndDeepEqual(false, err, test.expected, actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
+				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
+		})
+	}
+}
+
+func TestGetCustomResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil, "")
+	object := func(kind, name, ns string, labels map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("cert-manager.io/v1")
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetNamespace(ns)
+		obj.SetLabels(labels)
+		return obj
+	}
+	runLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+	rule := latest.HealthRule{
+		Group:   "cert-manager.io",
+		Version: "v1",
+		Kind:    "Certificate",
+		Ready:   latest.HealthCondition{Condition: "Ready"},
+	}
+	clusterRule := latest.HealthRule{
+		Group:   "cert-manager.io",
+		Version: "v1",
+		Kind:    "ClusterIssuer",
+		Ready:   latest.HealthCondition{Condition: "Ready"},
+	}
+	tests := []struct {
+		description string
+		rules       []latest.HealthRule
+		expected    []*resource.Resource
+		shouldErr   bool
+	}{
+		{
+			description: "resources deployed in the current run",
+			rules:       []latest.HealthRule{rule},
+			expected: []*resource.Resource{
+				resource.NewResource("tls", "certificate", "test", 200*time.Second).WithHealthRule(rule, "certificates.v1.cert-manager.io"),
+				resource.NewResource("tls", "certificate", "other", 200*time.Second).WithHealthRule(rule, "certificates.v1.cert-manager.io"),
+			},
+		},
+		{
+			description: "cluster-scoped resources are listed once",
+			rules:       []latest.HealthRule{clusterRule},
+			expected: []*resource.Resource{
+				resource.NewResource("letsencrypt", "clusterissuer", "", 200*time.Second).WithHealthRule(clusterRule, "clusterissuers.v1.cert-manager.io"),
+			},
+		},
+		{
+			description: "unknown kind is skipped",
+			rules:       []latest.HealthRule{{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}, rule},
+			expected: []*resource.Resource{
+				resource.NewResource("tls", "certificate", "test", 200*time.Second).WithHealthRule(rule, "certificates.v1.cert-manager.io"),
+				resource.NewResource("tls", "certificate", "other", 200*time.Second).WithHealthRule(rule, "certificates.v1.cert-manager.io"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset()
+			client.Resources = []*metav1.APIResourceList{{
+				GroupVersion: "cert-manager.io/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "certificates", Kind: "Certificate", Namespaced: true},
+					{Name: "certificates/status", Kind: "Certificate", Namespaced: true},
+					{Name: "clusterissuers", Kind: "ClusterIssuer", Namespaced: false},
+				},
+			}}
+			dynClient := fakedynclient.NewSimpleDynamicClient(runtime.NewScheme(),
+				object("Certificate", "tls", "test", runLabels),
+				object("Certificate", "tls", "other", runLabels),
+				object("Certificate", "other", "test", nil),
+				object("ClusterIssuer", "letsencrypt", "", runLabels))
+
+			actual, err := getCustomResources(context.Background(), client, dynClient, []string{"test", "other"}, labeller, test.rules, 200*time.Second)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, actual,
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
 				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
 		})
 	}
@@ -232,7 +389,7 @@ func TestGetDeployStatus(t *testing.T) {
 	tests := []struct {
 		description  string
 		counter      *counter
-		deployments  []*resource.Deployment
+		deployments  []*resource.Resource
 		expected     string
 		expectedCode proto.StatusCode
 		shouldErr    bool
@@ -240,24 +397,24 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "one error",
 			counter:     &counter{total: 2, failed: 1},
-			deployments: []*resource.Deployment{
-				resource.NewDeployment("foo", "test", time.Second).
+			deployments: []*resource.Resource{
+				resource.NewResource("foo", resource.Deployment, "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
-			expected:     "1/2 deployment(s) failed",
+			expected:     "1/2 resource(s) failed",
 			expectedCode: proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE,
 			shouldErr:    true,
 		},
 		{
 			description: "no error",
 			counter:     &counter{total: 2},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1),
+					resource.NewResource("r2", resource.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 			},
@@ -265,9 +422,9 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "multiple errors",
 			counter:     &counter{total: 3, failed: 2},
-			expected:    "2/3 deployment(s) failed",
-			deployments: []*resource.Deployment{
-				resource.NewDeployment("foo", "test", time.Second).
+			expected:    "2/3 resource(s) failed",
+			deployments: []*resource.Resource{
+				resource.NewResource("foo", resource.Deployment, "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
 			expectedCode: proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE,
@@ -280,9 +437,9 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "unable to retrieve pods for deployment",
 			counter:     &counter{total: 1, failed: 1},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("deployment", "test", 1),
+					resource.NewResource("deployment", resource.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR},
 				),
 			},
@@ -305,7 +462,7 @@ func TestGetDeployStatus(t *testing.T) {
 }
 
 func TestPrintSummaryStatus(t *testing.T) {
-	labeller := label.NewLabeller(true, nil)
+	labeller := label.NewLabeller(true, nil, "")
 	tests := []struct {
 		description string
 		namespace   string
@@ -344,7 +501,7 @@ func TestPrintSummaryStatus(t *testing.T) {
 			deployment:  "dep",
 			pending:     4,
 			ae:          proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
-			expected:    " - test:deployment/dep is ready. [4/10 deployment(s) still pending]\n",
+			expected:    " - test:deployment/dep is ready. [4/10 resource(s) still pending]\n",
 		},
 		{
 			description: "more than 1 deployment left and current is in error",
@@ -371,7 +528,7 @@ func TestPrintSummaryStatus(t *testing.T) {
 			rc := newCounter(10)
 			rc.pending = test.pending
 			event.InitializeState(latest.Pipeline{}, "test", true, true, true)
-			r := withStatus(resource.NewDeployment(test.deployment, test.namespace, 0), test.ae)
+			r := withStatus(resource.NewResource(test.deployment, resource.Deployment, test.namespace, 0), test.ae)
 			// report status once and set it changed to false.
 			r.ReportSinceLastUpdated(false)
 			r.UpdateStatus(test.ae)
@@ -382,18 +539,18 @@ func TestPrintSummaryStatus(t *testing.T) {
 }
 
 func TestPrintStatus(t *testing.T) {
-	labeller := label.NewLabeller(true, nil)
+	labeller := label.NewLabeller(true, nil, "")
 	tests := []struct {
 		description string
-		rs          []*resource.Deployment
+		rs          []*resource.Resource
 		expectedOut string
 		expected    bool
 	}{
 		{
 			description: "single resource successful marked complete - skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 			},
@@ -401,9 +558,9 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "single resource in error marked complete -skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "error"},
 				),
 			},
@@ -411,13 +568,13 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1).
+					resource.NewResource("r2", resource.Deployment, "test", 1).
 						WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR}),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 						Message: "pending\n"},
@@ -429,13 +586,13 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete and retry-able error",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
 				),
 				withStatus(
-					resource.NewDeployment("r2", "test", 1),
+					resource.NewResource("r2", resource.Deployment, "test", 1),
 					proto.ActionableErr{
 						ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
 						Message: resource.MsgKubectlConnection},
@@ -446,9 +603,9 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "skip printing if status check is cancelled",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
-					resource.NewDeployment("r1", "test", 1),
+					resource.NewResource("r1", resource.Deployment, "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED},
 				),
 			},
@@ -469,7 +626,7 @@ func TestPrintStatus(t *testing.T) {
 	}
 }
 
-func withStatus(d *resource.Deployment, ae proto.ActionableErr) *resource.Deployment {
+func withStatus(d *resource.Resource, ae proto.ActionableErr) *resource.Resource {
 	d.UpdateStatus(ae)
 	return d
 }
@@ -538,14 +695,14 @@ func TestPollDeployment(t *testing.T) {
 	rolloutCmd := "kubectl --context kubecontext rollout status deployment dep --namespace test --watch=false"
 	tests := []struct {
 		description string
-		dep         *resource.Deployment
+		dep         *resource.Resource
 		runs        [][]validator.Resource
 		command     util.Command
 		expected    proto.StatusCode
 	}{
 		{
-			description: "pollDeploymentStatus errors out immediately when container error can't recover",
-			dep:         resource.NewDeployment("dep", "test", time.Second),
+			description: "pollResourceStatus errors out immediately when container error can't recover",
+			dep:         resource.NewResource("dep", resource.Deployment, "test", time.Second),
 			command:     testutil.CmdRunOut(rolloutCmd, "Waiting for replicas to be available"),
 			runs: [][]validator.Resource{
 				{validator.NewResource(
@@ -559,8 +716,8 @@ func TestPollDeployment(t *testing.T) {
 			expected: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 		},
 		{
-			description: "pollDeploymentStatus waits when a container can recover and eventually succeeds",
-			dep:         resource.NewDeployment("dep", "test", time.Second),
+			description: "pollResourceStatus waits when a container can recover and eventually succeeds",
+			dep:         resource.NewResource("dep", resource.Deployment, "test", time.Second),
 			command: testutil.CmdRunOutErr(
 				// pending due to recoverable error
 				rolloutCmd, "", errors.New("Unable to connect to the server")).
@@ -595,7 +752,7 @@ func TestPollDeployment(t *testing.T) {
 			mockVal := mockValidator{runs: test.runs}
 			dep := test.dep.WithValidator(mockVal)
 
-			pollDeploymentStatus(context.Background(), &statusConfig{}, dep)
+			pollResourceStatus(context.Background(), &statusConfig{}, dep)
 
 			t.CheckDeepEqual(test.expected, test.dep.Status().ActionableError().ErrCode)
 		})
@@ -19,6 +19,7 @@ package types
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
@@ -32,6 +33,9 @@ type Config interface {
 	ConfigurationFile() string
 	DefaultRepo() *string
 	SkipRender() bool
+	DeployState() *state.Store
+	DebugMode() string
+	AutoProbeTimeout() string
 }
 
 // Artifact contains all information about a completed deployment
@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sync"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+)
+
+// RenderCache holds the output of the last render along with the fingerprint
+// of its inputs so that a deployer doesn't render the same inputs twice.
+type RenderCache struct {
+	mu          sync.Mutex
+	fingerprint string
+	manifests   manifest.ManifestList
+}
+
+// Get returns the last rendered manifests if they were rendered from the same inputs.
+func (c *RenderCache) Get(fingerprint string) (manifest.ManifestList, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if fingerprint == "" || fingerprint != c.fingerprint {
+		return nil, false
+	}
+	return c.manifests, true
+}
+
+// Set remembers the manifests rendered from the inputs with the given fingerprint.
+func (c *RenderCache) Set(fingerprint string, manifests manifest.ManifestList) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fingerprint = fingerprint
+	c.manifests = manifests
+}
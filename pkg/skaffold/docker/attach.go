@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sirupsen/logrus"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+)
+
+// for testing
+var remoteWrite = remote.Write
+
+// AttachmentTag returns the tag of a document attached to the image with the given digest.
+// Like cosign, it's derived from the digest: `gcr.io/project/app:sha256-<hex>.<suffix>`.
+func AttachmentTag(image, digest, suffix string) (string, error) {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing reference %q: %w", image, err)
+	}
+	h, err := v1.NewHash(digest)
+	if err != nil {
+		return "", fmt.Errorf("parsing digest %q: %w", digest, err)
+	}
+	return fmt.Sprintf("%s:%s-%s.%s", ref.Context().Name(), h.Algorithm, h.Hex, suffix), nil
+}
+
+// PushAttachment pushes a document as a single layer image with the given tag.
+// It returns the digest of the pushed image.
+func PushAttachment(tag string, content []byte, mediaType types.MediaType, cfg Config) (string, error) {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	img, err := mutate.AppendLayers(empty.Image, newStaticLayer(content, mediaType))
+	if err != nil {
+		return "", fmt.Errorf("creating image for %q: %w", tag, err)
+	}
+	return writeImage(ref, img)
+}
+
+// AppendAttachment adds a document, with annotations on its layer, to the image with the given tag.
+// The image is created if it doesn't exist yet. It returns the digest of the pushed image.
+func AppendAttachment(tag string, content []byte, mediaType types.MediaType, annotations map[string]string, cfg Config) (string, error) {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	base, err := remoteImage(ref, remote.WithAuthFromKeychain(primaryKeychain))
+	if err != nil {
+		logrus.Debugf("Creating %s: %v", tag, err)
+		base = empty.Image
+	}
+
+	img, err := mutate.Append(base, mutate.Addendum{
+		Layer:       newStaticLayer(content, mediaType),
+		Annotations: annotations,
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating image for %q: %w", tag, err)
+	}
+	return writeImage(ref, img)
+}
+
+func writeImage(ref name.Reference, img v1.Image) (string, error) {
+	if err := remoteWrite(ref, img, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("%s %q: %w", sErrors.PushImageErr, ref, err)
+	}
+	return digest(img)
+}
+
+// RetrieveRemoteImage retrieves an image from its registry.
+func RetrieveRemoteImage(identifier string, cfg Config) (v1.Image, error) {
+	return getRemoteImage(identifier, cfg)
+}
+
+// staticLayer is an uncompressed layer holding a single document.
+type staticLayer struct {
+	content   []byte
+	hash      v1.Hash
+	mediaType types.MediaType
+}
+
+func newStaticLayer(content []byte, mediaType types.MediaType) v1.Layer {
+	h, _, _ := v1.SHA256(bytes.NewReader(content))
+	return &staticLayer{content: content, hash: h, mediaType: mediaType}
+}
+
+func (l *staticLayer) Digest() (v1.Hash, error) { return l.hash, nil }
+func (l *staticLayer) DiffID() (v1.Hash, error) { return l.hash, nil }
+func (l *staticLayer) Size() (int64, error)     { return int64(len(l.content)), nil }
+
+func (l *staticLayer) MediaType() (types.MediaType, error) { return l.mediaType, nil }
+
+func (l *staticLayer) Compressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
+
+func (l *staticLayer) Uncompressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
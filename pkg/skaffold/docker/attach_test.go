@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestAttachmentTag(t *testing.T) {
+	tests := []struct {
+		description string
+		image       string
+		digest      string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "tagged image",
+			image:       "gcr.io/project/app:v1@sha256:9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a",
+			digest:      "sha256:9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a",
+			expected:    "gcr.io/project/app:sha256-9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a.sbom",
+		},
+		{
+			description: "docker hub image",
+			image:       "user/app",
+			digest:      "sha256:9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a",
+			expected:    "index.docker.io/user/app:sha256-9c5e7f9f5d2b3c7f4f0c8a1f2a5b6e1d7c3e9b0a4d6f8e2c1b3a5d7f9e0c2b4a.sbom",
+		},
+		{
+			description: "invalid digest",
+			image:       "gcr.io/project/app",
+			digest:      "latest",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tag, err := AttachmentTag(test.image, test.digest, "sbom")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
+
+func TestPushAttachment(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var pushed v1.Image
+		t.Override(&remoteWrite, func(ref name.Reference, img v1.Image, options ...remote.Option) error {
+			t.CheckDeepEqual("gcr.io/project/app:sha256-abc.att", ref.Name())
+			pushed = img
+			return nil
+		})
+
+		digest, err := PushAttachment("gcr.io/project/app:sha256-abc.att", []byte(`{"_type":"statement"}`), "application/vnd.in-toto+json", &mockConfig{})
+		t.CheckNoError(err)
+
+		expected, err := pushed.Digest()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expected.String(), digest)
+
+		layers, err := pushed.Layers()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(layers))
+		mediaType, err := layers[0].MediaType()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(types.MediaType("application/vnd.in-toto+json"), mediaType)
+		r, err := layers[0].Uncompressed()
+		t.CheckNoError(err)
+		content, err := ioutil.ReadAll(r)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`{"_type":"statement"}`, string(content))
+	})
+}
+
+func TestAppendAttachment(t *testing.T) {
+	tests := []struct {
+		description    string
+		existing       bool
+		expectedLayers int
+	}{
+		{
+			description:    "new image",
+			expectedLayers: 1,
+		},
+		{
+			description:    "existing image",
+			existing:       true,
+			expectedLayers: 2,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&remoteImage, func(ref name.Reference, options ...remote.Option) (v1.Image, error) {
+				if !test.existing {
+					return nil, fmt.Errorf("not found: %s", ref.Name())
+				}
+				return mutate.AppendLayers(empty.Image, newStaticLayer([]byte("first"), "text/plain"))
+			})
+			var pushed v1.Image
+			t.Override(&remoteWrite, func(ref name.Reference, img v1.Image, options ...remote.Option) error {
+				pushed = img
+				return nil
+			})
+
+			_, err := AppendAttachment("gcr.io/project/app:sha256-abc.sig", []byte("payload"), "text/plain", map[string]string{"key": "value"}, &mockConfig{})
+			t.CheckNoError(err)
+
+			manifest, err := pushed.Manifest()
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedLayers, len(manifest.Layers))
+			t.CheckDeepEqual(map[string]string{"key": "value"}, manifest.Layers[len(manifest.Layers)-1].Annotations)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DeployerLabel is set to DeployerLabelValue on the containers and networks created by the docker deployer.
+	DeployerLabel      = "skaffold.dev/deployer"
+	DeployerLabelValue = "docker"
+
+	// ImageLabel records the image a container was started from.
+	ImageLabel = "skaffold.dev/image"
+
+	// ProjectLabel identifies the project that a container was deployed by, so that
+	// projects sharing a Docker daemon don't touch each other's containers.
+	ProjectLabel = "skaffold.dev/project"
+)
+
+// DeployerLabels returns the labels set on the containers that the docker deployer
+// runs for the project in a working directory.
+func DeployerLabels(workingDir string) map[string]string {
+	if abs, err := filepath.Abs(workingDir); err == nil {
+		workingDir = abs
+	}
+	sum := sha256.Sum256([]byte(workingDir))
+
+	return map[string]string{
+		DeployerLabel: DeployerLabelValue,
+		ProjectLabel:  hex.EncodeToString(sum[:])[:16],
+	}
+}
+
+// ContainerCreateOpts describes a container to run.
+type ContainerCreateOpts struct {
+	Name    string
+	Image   string
+	Network string
+	Args    []string
+	Env     []string
+	Labels  map[string]string
+	// Ports are published like with `docker run -p`: `[[hostIP:]hostPort:]containerPort[/protocol]`.
+	Ports []string
+	// Binds are mounted like with `docker run -v`: `source:target[:options]`.
+	Binds []string
+}
+
+// Run creates and starts a container. Returns the ID of the container.
+func (l *localDaemon) Run(ctx context.Context, opts ContainerCreateOpts) (string, error) {
+	exposed, bindings, err := nat.ParsePortSpecs(opts.Ports)
+	if err != nil {
+		return "", fmt.Errorf("parsing ports: %w", err)
+	}
+
+	var networking *network.NetworkingConfig
+	if opts.Network != "" {
+		networking = &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				opts.Network: {Aliases: []string{opts.Name}},
+			},
+		}
+	}
+
+	config := &container.Config{
+		Image:        opts.Image,
+		Cmd:          opts.Args,
+		Env:          opts.Env,
+		Labels:       opts.Labels,
+		ExposedPorts: exposed,
+	}
+	hostConfig := &container.HostConfig{
+		Binds:        opts.Binds,
+		PortBindings: bindings,
+		NetworkMode:  container.NetworkMode(opts.Network),
+	}
+
+	created, err := l.apiClient.ContainerCreate(ctx, config, hostConfig, networking, nil, opts.Name)
+	if client.IsErrNotFound(err) {
+		// Like `docker run`, pull the images that are not in the daemon yet.
+		if err := l.Pull(ctx, ioutil.Discard, opts.Image); err != nil {
+			return "", fmt.Errorf("pulling image %q: %w", opts.Image, err)
+		}
+		created, err = l.apiClient.ContainerCreate(ctx, config, hostConfig, networking, nil, opts.Name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("creating container %q: %w", opts.Name, err)
+	}
+
+	if err := l.apiClient.ContainerStart(ctx, created.ID, types.ContainerStartOptions{}); err != nil {
+		return "", fmt.Errorf("starting container %q: %w", opts.Name, err)
+	}
+	return created.ID, nil
+}
+
+// ContainerLogs streams the logs of a container until it stops or the context is cancelled.
+func (l *localDaemon) ContainerLogs(ctx context.Context, w io.Writer, id string) error {
+	rc, err := l.apiClient.ContainerLogs(ctx, id, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+	})
+	if err != nil {
+		return fmt.Errorf("getting logs of container %q: %w", id, err)
+	}
+	defer rc.Close()
+
+	_, err = stdcopy.StdCopy(w, w, rc)
+	return err
+}
+
+// ContainerIDs lists the containers, running or not, that have the given labels.
+func (l *localDaemon) ContainerIDs(ctx context.Context, labels map[string]string) (map[string]string, error) {
+	args := filters.NewArgs()
+	for k, v := range labels {
+		args.Add("label", k+"="+v)
+	}
+
+	containers, err := l.apiClient.ContainerList(ctx, types.ContainerListOptions{All: true, Filters: args})
+	if err != nil {
+		return nil, fmt.Errorf("listing containers: %w", err)
+	}
+
+	ids := map[string]string{}
+	for _, c := range containers {
+		for _, name := range c.Names {
+			// Names are prefixed with a `/`
+			ids[name[1:]] = c.ID
+		}
+	}
+	return ids, nil
+}
+
+// RemoveContainer stops and removes a container. It's not an error if the container doesn't exist.
+func (l *localDaemon) RemoveContainer(ctx context.Context, id string) error {
+	err := l.apiClient.ContainerRemove(ctx, id, types.ContainerRemoveOptions{Force: true, RemoveVolumes: true})
+	if err != nil && !client.IsErrNotFound(err) {
+		return fmt.Errorf("removing container %q: %w", id, err)
+	}
+	return nil
+}
+
+// CopyToContainer extracts a tar archive into a container.
+func (l *localDaemon) CopyToContainer(ctx context.Context, id, dir string, content io.Reader) error {
+	return l.apiClient.CopyToContainer(ctx, id, dir, content, types.CopyToContainerOptions{})
+}
+
+// Exec runs a command in a running container and waits for it to complete.
+func (l *localDaemon) Exec(ctx context.Context, id string, command []string) error {
+	exec, err := l.apiClient.ContainerExecCreate(ctx, id, types.ExecConfig{Cmd: command, AttachStderr: true, AttachStdout: true})
+	if err != nil {
+		return fmt.Errorf("creating exec in container %q: %w", id, err)
+	}
+
+	resp, err := l.apiClient.ContainerExecAttach(ctx, exec.ID, types.ExecStartCheck{})
+	if err != nil {
+		return fmt.Errorf("running %v in container %q: %w", command, id, err)
+	}
+	defer resp.Close()
+	if _, err := stdcopy.StdCopy(ioutil.Discard, ioutil.Discard, resp.Reader); err != nil {
+		return fmt.Errorf("running %v in container %q: %w", command, id, err)
+	}
+
+	inspect, err := l.apiClient.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return fmt.Errorf("running %v in container %q: %w", command, id, err)
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("running %v in container %q: exit code %d", command, id, inspect.ExitCode)
+	}
+	return nil
+}
+
+// EnsureNetwork creates a network if it doesn't exist yet.
+func (l *localDaemon) EnsureNetwork(ctx context.Context, name string, labels map[string]string) error {
+	if _, err := l.apiClient.NetworkInspect(ctx, name, types.NetworkInspectOptions{}); err == nil {
+		return nil
+	}
+
+	if _, err := l.apiClient.NetworkCreate(ctx, name, types.NetworkCreate{CheckDuplicate: true, Labels: labels}); err != nil {
+		return fmt.Errorf("creating network %q: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveNetwork removes a network once no container is connected to it anymore.
+// It's not an error if the network doesn't exist.
+func (l *localDaemon) RemoveNetwork(ctx context.Context, name string) error {
+	resource, err := l.apiClient.NetworkInspect(ctx, name, types.NetworkInspectOptions{})
+	if client.IsErrNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("inspecting network %q: %w", name, err)
+	}
+	if len(resource.Containers) > 0 {
+		logrus.Debugf("Not removing network %q: %d container(s) still connected", name, len(resource.Containers))
+		return nil
+	}
+
+	if err := l.apiClient.NetworkRemove(ctx, name); err != nil && !client.IsErrNotFound(err) {
+		return fmt.Errorf("removing network %q: %w", name, err)
+	}
+	return nil
+}
@@ -117,7 +117,7 @@ func getDependencies(workspace string, dockerfilePath string, absDockerfilePath
 		return err
 	}
 
-	excludes, err := readDockerignore(workspace, absDockerfilePath)
+	excludes, err := ReadDockerignore(workspace, absDockerfilePath)
 	if err != nil {
 		return fmt.Errorf("reading .dockerignore: %w", err)
 	}
@@ -151,8 +151,9 @@ func getDependencies(workspace string, dockerfilePath string, absDockerfilePath
 	return dependencies
 }
 
-// readDockerignore reads patterns to ignore
-func readDockerignore(workspace string, absDockerfilePath string) ([]string, error) {
+// ReadDockerignore reads the patterns to ignore from the `.dockerignore` file
+// of a Dockerfile or, if there's none, of its workspace.
+func ReadDockerignore(workspace string, absDockerfilePath string) ([]string, error) {
 	var excludes []string
 	dockerignorePaths := []string{
 		absDockerfilePath + ".dockerignore",
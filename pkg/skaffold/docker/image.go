@@ -78,6 +78,14 @@ type LocalDaemon interface {
 	Prune(ctx context.Context, images []string, pruneChildren bool) ([]string, error)
 	DiskUsage(ctx context.Context) (uint64, error)
 	RawClient() client.CommonAPIClient
+	Run(ctx context.Context, opts ContainerCreateOpts) (string, error)
+	ContainerLogs(ctx context.Context, w io.Writer, id string) error
+	ContainerIDs(ctx context.Context, labels map[string]string) (map[string]string, error)
+	RemoveContainer(ctx context.Context, id string) error
+	CopyToContainer(ctx context.Context, id, dir string, content io.Reader) error
+	Exec(ctx context.Context, id string, command []string) error
+	EnsureNetwork(ctx context.Context, name string, labels map[string]string) error
+	RemoveNetwork(ctx context.Context, name string) error
 }
 
 // BuildOptions provides parameters related to the LocalDaemon build.
@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sirupsen/logrus"
+)
+
+// for testing
+var remoteWriteIndex = remote.WriteIndex
+
+// PlatformImage is an image pushed to a registry for a single target platform.
+type PlatformImage struct {
+	// Platform is the target platform, in the `os/arch[/variant]` format.
+	Platform string
+	// Image is the fully qualified reference of the image built for that platform.
+	Image string
+}
+
+// ParsePlatform parses a platform in the `os/arch[/variant]` format, eg. `linux/arm64` or `linux/arm/v7`.
+func ParsePlatform(platform string) (v1.Platform, error) {
+	parts := strings.Split(platform, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v1.Platform{}, fmt.Errorf("invalid platform %q: expected format os/arch[/variant]", platform)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return v1.Platform{}, fmt.Errorf("invalid platform %q: expected format os/arch[/variant]", platform)
+		}
+	}
+
+	p := v1.Platform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+// FormatPlatform formats a platform in the `os/arch[/variant]` format.
+func FormatPlatform(p v1.Platform) string {
+	platform := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		platform += "/" + p.Variant
+	}
+	return platform
+}
+
+// HostPlatform returns the platform Skaffold runs on.
+func HostPlatform() string {
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
+
+// CreateManifestList pushes a manifest list referencing the given single platform images
+// to the registry and returns its digest.
+func CreateManifestList(tag string, images []PlatformImage, cfg Config) (string, error) {
+	var adds []mutate.IndexAddendum
+	for _, image := range images {
+		platform, err := ParsePlatform(image.Platform)
+		if err != nil {
+			return "", err
+		}
+
+		img, err := getRemoteImage(image.Image, cfg)
+		if err != nil {
+			return "", fmt.Errorf("getting image %q: %w", image.Image, err)
+		}
+
+		adds = append(adds, mutate.IndexAddendum{
+			Add: img,
+			Descriptor: v1.Descriptor{
+				Platform: &platform,
+			},
+		})
+	}
+
+	idx := mutate.IndexMediaType(mutate.AppendManifests(empty.Index, adds...), types.DockerManifestList)
+
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	logrus.Debugf("pushing manifest list %s for %d platforms", tag, len(images))
+	if err := remoteWriteIndex(ref, idx, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("pushing manifest list %q: %w", tag, err)
+	}
+
+	return digest(idx)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		description string
+		platform    string
+		expected    v1.Platform
+		shouldErr   bool
+	}{
+		{
+			description: "os and architecture",
+			platform:    "linux/amd64",
+			expected:    v1.Platform{OS: "linux", Architecture: "amd64"},
+		},
+		{
+			description: "with variant",
+			platform:    "linux/arm/v7",
+			expected:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+		},
+		{
+			description: "missing architecture",
+			platform:    "linux",
+			shouldErr:   true,
+		},
+		{
+			description: "empty architecture",
+			platform:    "linux/",
+			shouldErr:   true,
+		},
+		{
+			description: "too many parts",
+			platform:    "linux/arm/v7/extra",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			platform, err := ParsePlatform(test.platform)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, platform)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.platform, FormatPlatform(platform))
+			}
+		})
+	}
+}
+
+func TestCreateManifestList(t *testing.T) {
+	tests := []struct {
+		description string
+		images      []PlatformImage
+		shouldErr   bool
+	}{
+		{
+			description: "two platforms",
+			images: []PlatformImage{
+				{Platform: "linux/amd64", Image: "gcr.io/project/image:tag-linux-amd64"},
+				{Platform: "linux/arm64", Image: "gcr.io/project/image:tag-linux-arm64"},
+			},
+		},
+		{
+			description: "invalid platform",
+			images:      []PlatformImage{{Platform: "linux", Image: "gcr.io/project/image:tag-linux"}},
+			shouldErr:   true,
+		},
+		{
+			description: "image not found",
+			images:      []PlatformImage{{Platform: "linux/amd64", Image: "gcr.io/project/unknown:tag"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&remoteImage, func(ref name.Reference, options ...remote.Option) (v1.Image, error) {
+				if ref.Context().RepositoryStr() != "project/image" {
+					return nil, fmt.Errorf("not found: %s", ref.Name())
+				}
+				// Give each image a different digest
+				return mutate.ConfigFile(empty.Image, &v1.ConfigFile{Architecture: ref.Identifier()})
+			})
+			var pushed v1.ImageIndex
+			t.Override(&remoteWriteIndex, func(ref name.Reference, idx v1.ImageIndex, options ...remote.Option) error {
+				t.CheckDeepEqual("gcr.io/project/image:tag", ref.Name())
+				pushed = idx
+				return nil
+			})
+
+			digest, err := CreateManifestList("gcr.io/project/image:tag", test.images, &mockConfig{})
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			expected, err := pushed.Digest()
+			t.CheckNoError(err)
+			t.CheckDeepEqual(expected.String(), digest)
+
+			manifest, err := pushed.IndexManifest()
+			t.CheckNoError(err)
+			t.CheckDeepEqual(types.DockerManifestList, manifest.MediaType)
+			t.CheckDeepEqual(len(test.images), len(manifest.Manifests))
+			for i, image := range test.images {
+				t.CheckDeepEqual(image.Platform, FormatPlatform(*manifest.Manifests[i].Platform))
+			}
+		})
+	}
+}
@@ -40,7 +40,7 @@ func SyncMap(workspace string, dockerfilePath string, buildArgs map[string]*stri
 		return nil, err
 	}
 
-	excludes, err := readDockerignore(workspace, absDockerfilePath)
+	excludes, err := ReadDockerignore(workspace, absDockerfilePath)
 	if err != nil {
 		return nil, fmt.Errorf("reading .dockerignore: %w", err)
 	}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	//nolint:golint,staticcheck
 	"github.com/golang/protobuf/jsonpb"
@@ -115,19 +116,32 @@ func (ev *eventHandler) getState() proto.State {
 func (ev *eventHandler) logEvent(entry proto.LogEntry) {
 	ev.logLock.Lock()
 
+	ev.notifyListeners(&entry)
+	ev.eventLog = append(ev.eventLog, entry)
+
+	ev.logLock.Unlock()
+}
+
+// broadcast sends an entry to the listeners without recording it in the event log.
+func (ev *eventHandler) broadcast(entry proto.LogEntry) {
+	ev.logLock.Lock()
+
+	ev.notifyListeners(&entry)
+
+	ev.logLock.Unlock()
+}
+
+func (ev *eventHandler) notifyListeners(entry *proto.LogEntry) {
 	for _, listener := range ev.listeners {
 		if listener.closed {
 			continue
 		}
 
-		if err := listener.callback(&entry); err != nil {
+		if err := listener.callback(entry); err != nil {
 			listener.errors <- err
 			listener.closed = true
 		}
 	}
-	ev.eventLog = append(ev.eventLog, entry)
-
-	ev.logLock.Unlock()
 }
 
 func (ev *eventHandler) forEachEvent(callback func(*proto.LogEntry) error) error {
@@ -372,20 +386,21 @@ func DevLoopComplete(i int) {
 }
 
 // FileSyncInProgress notifies that a file sync has been started.
-func FileSyncInProgress(fileCount int, image string) {
-	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: InProgress})
+// postSyncAction describes the action applied to the containers after the files are synced, if any.
+func FileSyncInProgress(fileCount int, image, postSyncAction string) {
+	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: InProgress, PostSyncAction: postSyncAction})
 }
 
-// FileSyncFailed notifies that a file sync has failed.
-func FileSyncFailed(fileCount int, image string, err error) {
+// FileSyncFailed notifies that a file sync, or the action applied after it, has failed.
+func FileSyncFailed(fileCount int, image, postSyncAction string, err error) {
 	aiErr := sErrors.ActionableErr(sErrors.FileSync, err)
-	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: Failed,
+	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: Failed, PostSyncAction: postSyncAction,
 		Err: err.Error(), ErrCode: aiErr.ErrCode, ActionableErr: aiErr})
 }
 
-// FileSyncSucceeded notifies that a file sync has succeeded.
-func FileSyncSucceeded(fileCount int, image string) {
-	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: Succeeded})
+// FileSyncSucceeded notifies that a file sync, and the action applied after it, has succeeded.
+func FileSyncSucceeded(fileCount int, image, postSyncAction string) {
+	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: Succeeded, PostSyncAction: postSyncAction})
 }
 
 // PortForwarded notifies that a remote port has been forwarded locally.
@@ -451,6 +466,31 @@ func DebuggingContainerTerminated(podName, containerName, namespace, artifact, r
 	})
 }
 
+// ApplicationLog notifies the listeners of a line of log printed by a deployed container.
+// Unlike other events, application logs are not kept in the event log.
+func ApplicationLog(timestamp time.Time, podName, containerName, namespace, image, message string) {
+	ts, err := ptypes.TimestampProto(timestamp)
+	if err != nil {
+		ts = ptypes.TimestampNow()
+	}
+
+	handler.broadcast(proto.LogEntry{
+		Timestamp: ts,
+		Event: &proto.Event{
+			EventType: &proto.Event_ApplicationLogEvent{
+				ApplicationLogEvent: &proto.ApplicationLogEvent{
+					ContainerName: containerName,
+					PodName:       podName,
+					Namespace:     namespace,
+					Image:         image,
+					Message:       message,
+				},
+			},
+		},
+		Entry: message,
+	})
+}
+
 func (ev *eventHandler) setState(state proto.State) {
 	ev.stateLock.Lock()
 	ev.state = state
@@ -275,7 +275,7 @@ func TestFileSyncInProgress(t *testing.T) {
 	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == NotStarted })
-	FileSyncInProgress(5, "image")
+	FileSyncInProgress(5, "image", "")
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == InProgress })
 }
 
@@ -286,7 +286,7 @@ func TestFileSyncFailed(t *testing.T) {
 	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == NotStarted })
-	FileSyncFailed(5, "image", errors.New("BUG"))
+	FileSyncFailed(5, "image", "", errors.New("BUG"))
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == Failed })
 }
 
@@ -297,10 +297,25 @@ func TestFileSyncSucceeded(t *testing.T) {
 	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
 
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == NotStarted })
-	FileSyncSucceeded(5, "image")
+	FileSyncSucceeded(5, "image", "")
 	wait(t, func() bool { return handler.getState().FileSyncState.Status == Succeeded })
 }
 
+func TestFileSyncPostSyncAction(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	FileSyncSucceeded(5, "image", "restart")
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		n := len(handler.eventLog)
+		return n > 0 && handler.eventLog[n-1].Event.GetFileSyncEvent().GetPostSyncAction() == "restart"
+	})
+}
+
 func TestDebuggingContainer(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -323,6 +338,35 @@ func TestDebuggingContainer(t *testing.T) {
 	wait(t, notFound)
 }
 
+func TestApplicationLog(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(latest.Pipeline{}, "test", true, true, true)
+
+	received := make(chan *proto.LogEntry, 1)
+	handler.listeners = append(handler.listeners, &listener{
+		callback: func(entry *proto.LogEntry) error {
+			received <- entry
+			return nil
+		},
+		errors: make(chan error),
+	})
+
+	ApplicationLog(time.Unix(1600000000, 0), "pod", "container", "ns", "image", "hello")
+
+	entry := <-received
+	testutil.CheckDeepEqual(t, "hello", entry.Entry)
+	testutil.CheckDeepEqual(t, int64(1600000000), entry.Timestamp.Seconds)
+	testutil.CheckDeepEqual(t, "pod", entry.Event.GetApplicationLogEvent().PodName)
+	testutil.CheckDeepEqual(t, "container", entry.Event.GetApplicationLogEvent().ContainerName)
+	testutil.CheckDeepEqual(t, "ns", entry.Event.GetApplicationLogEvent().Namespace)
+	testutil.CheckDeepEqual(t, "image", entry.Event.GetApplicationLogEvent().Image)
+
+	// Application logs are not replayed to new listeners.
+	testutil.CheckDeepEqual(t, 0, len(handler.eventLog))
+}
+
 func wait(t *testing.T, condition func() bool) {
 	ticker := time.NewTicker(10 * time.Millisecond)
 	defer ticker.Stop()
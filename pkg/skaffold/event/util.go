@@ -88,6 +88,12 @@ func getDeploy(d latest.DeployConfig, c string) *proto.DeployMetadata {
 	if d.KustomizeDeploy != nil {
 		deployers = append(deployers, &proto.DeployMetadata_Deployer{Type: proto.DeployerType_KUSTOMIZE, Count: 1})
 	}
+	if d.ComposeDeploy != nil {
+		deployers = append(deployers, &proto.DeployMetadata_Deployer{Type: proto.DeployerType_COMPOSE, Count: int32(len(d.ComposeDeploy.Paths))})
+	}
+	if d.DockerDeploy != nil {
+		deployers = append(deployers, &proto.DeployMetadata_Deployer{Type: proto.DeployerType_DOCKER, Count: int32(len(d.DockerDeploy.Containers))})
+	}
 	if len(deployers) == 0 {
 		return &proto.DeployMetadata{}
 	}
@@ -19,8 +19,10 @@ package filemon
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -51,6 +53,45 @@ func Stat(deps func() ([]string, error)) (FileMap, error) {
 	return state, nil
 }
 
+// written records the files written by Skaffold itself, such as the files copied back
+// from the containers, with their modification time.
+var written = struct {
+	sync.Mutex
+	files FileMap
+}{files: FileMap{}}
+
+// Written records that Skaffold wrote a file, so that this change isn't reported
+// as an event. Later changes to the file are reported as usual.
+func Written(path string, modTime time.Time) {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+
+	written.Lock()
+	written.files[path] = modTime
+	written.Unlock()
+}
+
+// writtenBySkaffold checks whether the current version of a file was written by Skaffold.
+func writtenBySkaffold(path string, modTime time.Time) bool {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+
+	written.Lock()
+	defer written.Unlock()
+
+	t, found := written.files[path]
+	if !found {
+		return false
+	}
+	if !t.Equal(modTime) {
+		delete(written.files, path)
+		return false
+	}
+	return true
+}
+
 type Events struct {
 	Added    []string
 	Modified []string
@@ -86,7 +127,7 @@ func events(prev, curr FileMap) Events {
 			e.Deleted = append(e.Deleted, f)
 			continue
 		}
-		if !modtime.Equal(t) {
+		if !modtime.Equal(t) && !writtenBySkaffold(f, modtime) {
 			// file in both prev and curr
 			// time not equal -> file modified
 			e.Modified = append(e.Modified, f)
@@ -98,7 +139,7 @@ func events(prev, curr FileMap) Events {
 		// don't need to check case where file is in both curr and prev
 		// covered above
 		_, ok := prev[f]
-		if !ok {
+		if !ok && !writtenBySkaffold(f, curr[f]) {
 			// file in curr but not in prev -> file added
 			e.Added = append(e.Added, f)
 		}
@@ -94,6 +94,25 @@ func TestEvents(t *testing.T) {
 	}
 }
 
+func TestEventsIgnoreWrittenFiles(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		Written("written", today)
+		Written("added", today)
+
+		t.CheckDeepEqual(Events{Modified: []string{"other"}}, events(
+			FileMap{"written": yesterday, "other": yesterday},
+			FileMap{"written": today, "other": today, "added": today},
+		))
+
+		// Later changes are reported
+		later := today.Add(time.Hour)
+		t.CheckDeepEqual(Events{Modified: []string{"written"}}, events(
+			FileMap{"written": today},
+			FileMap{"written": later},
+		))
+	})
+}
+
 func TestStat(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().
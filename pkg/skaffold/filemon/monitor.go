@@ -16,16 +16,37 @@ limitations under the License.
 
 package filemon
 
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
 // Monitor monitors files changes for multiples components.
 type Monitor interface {
 	Register(deps func() ([]string, error), onChange func(Events)) error
 	Run(debounce bool) error
 	Reset()
+
+	// Changed is used by file watchers to report the files that changed.
+	// Once a watcher reports changes, Run only checks the components that
+	// depend on those files, instead of checking every dependency.
+	Changed(paths ...string)
+
+	// DependsOn tells if a component depends on a file, or on files in a directory.
+	DependsOn(path string) bool
 }
 
 type watchList struct {
+	mu                sync.Mutex
 	changedComponents map[int]bool
 	components        []*component
+
+	// changedPaths are the files reported by a watcher since the last run.
+	pathsMu      sync.Mutex
+	changedPaths map[string]bool
+	watched      bool
 }
 
 // NewMonitor creates a new Monitor.
@@ -40,6 +61,13 @@ type component struct {
 	onChange func(Events)
 	state    FileMap
 	events   Events
+
+	// files maps the absolute path of each dependency to its key in state.
+	files map[string]string
+	// dirs are the directories that contain dependencies, at any depth.
+	dirs map[string]bool
+	// checked is true once all the dependencies were checked by Run.
+	checked bool
 }
 
 // Register adds a new component to the watch list.
@@ -49,36 +77,84 @@ func (w *watchList) Register(deps func() ([]string, error), onChange func(Events
 		return err
 	}
 
-	w.components = append(w.components, &component{
+	c := &component{
 		deps:     deps,
 		onChange: onChange,
-		state:    state,
-	})
+	}
+	c.setState(state)
+
+	w.mu.Lock()
+	w.components = append(w.components, c)
+	w.mu.Unlock()
 	return nil
 }
 
 func (w *watchList) Reset() {
+	w.mu.Lock()
 	w.changedComponents = map[int]bool{}
+	w.mu.Unlock()
+}
+
+func (w *watchList) Changed(paths ...string) {
+	w.pathsMu.Lock()
+	defer w.pathsMu.Unlock()
+
+	if w.changedPaths == nil {
+		w.changedPaths = map[string]bool{}
+	}
+	for _, path := range paths {
+		w.changedPaths[path] = true
+	}
+	w.watched = true
+}
+
+func (w *watchList) DependsOn(path string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, component := range w.components {
+		if _, found := component.files[path]; found || component.dirs[path] {
+			return true
+		}
+	}
+	return false
 }
 
 // Run watches files until the context is cancelled or an error occurs.
 func (w *watchList) Run(debounce bool) error {
+	w.pathsMu.Lock()
+	watched, changedPaths := w.watched, w.changedPaths
+	w.changedPaths = nil
+	w.pathsMu.Unlock()
+
+	w.mu.Lock()
 	changed := 0
 	for i, component := range w.components {
-		state, err := Stat(component.deps)
+		var (
+			state FileMap
+			err   error
+		)
+		if watched && component.checked {
+			state, err = component.statChanged(changedPaths)
+		} else {
+			state, err = Stat(component.deps)
+		}
 		if err != nil {
+			w.mu.Unlock()
 			return err
 		}
+		component.checked = true
 		e := events(component.state, state)
 
 		if e.HasChanged() {
 			w.changedComponents[i] = true
-			component.state = state
+			component.setState(state)
 			component.events = e
 			changed++
 		}
 	}
 
+	var callbacks []func()
 	// Rapid file changes that are more frequent than the poll interval would trigger
 	// multiple rebuilds.
 	// To prevent that, we debounce changes that happen too quickly
@@ -87,9 +163,91 @@ func (w *watchList) Run(debounce bool) error {
 	if (!debounce && changed > 0) || (debounce && changed == 0 && len(w.changedComponents) > 0) {
 		for i, component := range w.components {
 			if w.changedComponents[i] {
-				component.onChange(component.events)
+				onChange, events := component.onChange, component.events
+				callbacks = append(callbacks, func() { onChange(events) })
 			}
 		}
 	}
+	w.mu.Unlock()
+
+	for _, callback := range callbacks {
+		callback()
+	}
 	return nil
 }
+
+// statChanged computes the new state of a component, given the files reported as changed by a watcher.
+// Only those files are checked. If files might have been added to a directory the component
+// depends on, the dependencies are listed again, but only the new ones are checked.
+func (c *component) statChanged(paths map[string]bool) (FileMap, error) {
+	toStat := map[string]bool{}
+	relist := false
+	for path := range paths {
+		if key, found := c.files[path]; found {
+			toStat[key] = true
+		} else if c.dirs[path] || c.dirs[filepath.Dir(path)] {
+			relist = true
+		}
+	}
+	if !relist && len(toStat) == 0 {
+		return c.state, nil
+	}
+
+	state := FileMap{}
+	for key, modTime := range c.state {
+		state[key] = modTime
+	}
+
+	if relist {
+		deps, err := c.deps()
+		if err != nil {
+			return nil, fmt.Errorf("listing files: %w", err)
+		}
+
+		listed := map[string]bool{}
+		for _, key := range deps {
+			listed[key] = true
+			if _, found := c.state[key]; !found {
+				toStat[key] = true
+			}
+		}
+		for key := range c.state {
+			if !listed[key] {
+				delete(state, key)
+			}
+		}
+	}
+
+	for key := range toStat {
+		stat, err := os.Stat(key)
+		switch {
+		case os.IsNotExist(err):
+			delete(state, key)
+		case err != nil:
+			return nil, fmt.Errorf("unable to stat file %q: %w", key, err)
+		default:
+			state[key] = stat.ModTime()
+		}
+	}
+
+	return state, nil
+}
+
+// setState records the state of a component and indexes its dependencies.
+func (c *component) setState(state FileMap) {
+	c.state = state
+	c.files = map[string]string{}
+	c.dirs = map[string]bool{}
+
+	for key := range state {
+		path, err := filepath.Abs(key)
+		if err != nil {
+			continue
+		}
+		c.files[path] = key
+
+		for dir := filepath.Dir(path); !c.dirs[dir]; dir = filepath.Dir(dir) {
+			c.dirs[dir] = true
+		}
+	}
+}
@@ -74,6 +74,57 @@ func TestFileMonitor(t *testing.T) {
 	}
 }
 
+func TestFileMonitorWithWatcher(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("src/file1", "src/file2", "other/file")
+
+		monitor := NewMonitor()
+		changed := callback{}
+		err := monitor.Register(
+			func() ([]string, error) { return tmpDir.Paths("src/file1", "src/file2", "src/new"), nil },
+			changed.call,
+		)
+		t.CheckNoError(err)
+		t.CheckTrue(monitor.DependsOn(tmpDir.Path("src/file1")))
+		t.CheckTrue(monitor.DependsOn(tmpDir.Path("src")))
+		t.CheckFalse(monitor.DependsOn(tmpDir.Path("other")))
+
+		// The first run checks every dependency.
+		monitor.Changed()
+		t.CheckNoError(monitor.Run(false))
+		t.CheckDeepEqual(0, changed.calls())
+
+		// Only the reported files are checked.
+		tmpDir.Chtimes("src/file1", time.Now().Add(2*time.Second))
+		tmpDir.Chtimes("src/file2", time.Now().Add(2*time.Second))
+		monitor.Changed(tmpDir.Path("src/file1"))
+		t.CheckNoError(monitor.Run(false))
+		t.CheckDeepEqual(1, changed.calls())
+		t.CheckDeepEqual([]string{tmpDir.Path("src/file1")}, changed.events[0].Modified)
+
+		// Files that the component doesn't depend on are not checked.
+		tmpDir.Chtimes("other/file", time.Now().Add(2*time.Second))
+		monitor.Changed(tmpDir.Path("other/file"))
+		t.CheckNoError(monitor.Run(false))
+		t.CheckDeepEqual(1, changed.calls())
+
+		// New files in the directories of the component are found.
+		tmpDir.Touch("src/new")
+		monitor.Changed(tmpDir.Path("src/new"))
+		t.CheckNoError(monitor.Run(false))
+		t.CheckDeepEqual(2, changed.calls())
+		t.CheckDeepEqual([]string{tmpDir.Path("src/new")}, changed.events[1].Added)
+		t.CheckTrue(monitor.DependsOn(tmpDir.Path("src/new")))
+
+		// Deleted files are found.
+		tmpDir.Remove("src/file2")
+		monitor.Changed(tmpDir.Path("src/file2"))
+		t.CheckNoError(monitor.Run(false))
+		t.CheckDeepEqual(3, changed.calls())
+		t.CheckDeepEqual([]string{tmpDir.Path("src/file2")}, changed.events[2].Deleted)
+	})
+}
+
 type callback struct {
 	events []Events
 }
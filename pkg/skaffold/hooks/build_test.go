@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildHooks(t *testing.T) {
+	workDir, _ := filepath.Abs("./foo")
+	expectedEnv := []string{
+		"SKAFFOLD_IMAGE=gcr.io/foo/img1:latest",
+		"SKAFFOLD_PUSH_IMAGE=true",
+		"SKAFFOLD_IMAGE_REPO=gcr.io/foo",
+		"SKAFFOLD_IMAGE_TAG=latest",
+		"SKAFFOLD_BUILD_CONTEXT=" + workDir,
+		"SKAFFOLD_KUBE_CONTEXT=kubecontext",
+	}
+	artifact := &latest.Artifact{
+		ImageName: "img1",
+		Workspace: "./foo",
+	}
+
+	tests := []struct {
+		description string
+		hooks       latest.BuildHooks
+		pre         bool
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "no hooks",
+			pre:         true,
+		},
+		{
+			description: "pre-build hooks",
+			hooks: latest.BuildHooks{
+				PreHooks: []latest.HostHook{
+					{Command: []string{"echo", "pre-build1"}},
+					{Command: []string{"echo", "pre-build2"}},
+				},
+				PostHooks: []latest.HostHook{{Command: []string{"echo", "post-build"}}},
+			},
+			pre:      true,
+			commands: testutil.CmdRunEnv("echo pre-build1", expectedEnv).AndRunEnv("echo pre-build2", expectedEnv),
+		},
+		{
+			description: "post-build hooks",
+			hooks: latest.BuildHooks{
+				PreHooks:  []latest.HostHook{{Command: []string{"echo", "pre-build"}}},
+				PostHooks: []latest.HostHook{{Command: []string{"echo", "post-build"}}},
+			},
+			commands: testutil.CmdRunEnv("echo post-build", expectedEnv),
+		},
+		{
+			description: "hook for another OS is skipped",
+			hooks: latest.BuildHooks{
+				PreHooks: []latest.HostHook{
+					{Command: []string{"echo", "skipped"}, OS: []string{"not-" + runtime.GOOS}},
+					{Command: []string{"echo", "run"}, OS: []string{runtime.GOOS}},
+				},
+			},
+			pre:      true,
+			commands: testutil.CmdRun("echo run"),
+		},
+		{
+			description: "failing hook",
+			hooks: latest.BuildHooks{
+				PreHooks: []latest.HostHook{
+					{Command: []string{"false"}},
+					{Command: []string{"echo", "never run"}},
+				},
+			},
+			pre:       true,
+			commands:  testutil.CmdRunErr("false", errors.New("exit status 1")),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if test.commands != nil {
+				t.Override(&util.DefaultExecCommand, test.commands)
+			}
+
+			opts, err := NewBuildEnvOpts(artifact, "gcr.io/foo/img1:latest", true, "kubecontext")
+			t.CheckNoError(err)
+			runner := BuildRunner(test.hooks, opts)
+
+			var out bytes.Buffer
+			if test.pre {
+				err = runner.RunPreHooks(context.Background(), &out)
+			} else {
+				err = runner.RunPostHooks(context.Background(), &out)
+			}
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// containerSelector selects the containers that a container hook is executed on.
+type containerSelector func(v1.Pod, v1.Container) bool
+
+// containerHook represents a lifecycle hook to be executed inside running containers
+type containerHook struct {
+	cfg        latest.ContainerHook
+	cli        *kubectl.CLI
+	selector   containerSelector
+	namespaces []string
+	// labelSelector restricts the hook to the pods deployed by this run
+	labelSelector string
+}
+
+// run executes the lifecycle hook in all the running containers matched by the selector
+func (h containerHook) run(ctx context.Context, out io.Writer) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	for _, ns := range h.namespaces {
+		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: h.labelSelector,
+		})
+		if err != nil {
+			return fmt.Errorf("getting pods for namespace %q: %w", ns, err)
+		}
+
+		for _, p := range pods.Items {
+			if p.Status.Phase != v1.PodRunning {
+				continue
+			}
+			for _, c := range p.Spec.Containers {
+				if !h.selector(p, c) {
+					continue
+				}
+				args := []string{p.Name, "--namespace", p.Namespace, "-c", c.Name, "--"}
+				args = append(args, h.cfg.Command...)
+				cmd := h.cli.Command(ctx, "exec", args...)
+				cmd.Stdout = out
+				cmd.Stderr = out
+
+				logrus.Debugf("Running container hook %q in %s/%s", h.cfg.Command, p.Name, c.Name)
+				if err := util.RunCmd(cmd); err != nil {
+					return fmt.Errorf("running command %q in container %s of pod %s: %w", h.cfg.Command, c.Name, p.Name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// runningImageSelector selects the containers running the given image.
+func runningImageSelector(image string) containerSelector {
+	return func(_ v1.Pod, c v1.Container) bool {
+		return c.Image == image
+	}
+}
+
+// namedContainerSelector selects the containers matching the pod and container name patterns of a `NamedContainerHook`.
+func namedContainerSelector(cfg latest.NamedContainerHook) containerSelector {
+	return func(p v1.Pod, c v1.Container) bool {
+		if matched, _ := filepath.Match(cfg.PodName, p.Name); !matched {
+			return false
+		}
+		if cfg.ContainerName == "" {
+			return true
+		}
+		matched, _ := filepath.Match(cfg.ContainerName, c.Name)
+		return matched
+	}
+}
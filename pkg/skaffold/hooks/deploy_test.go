@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDeployHooks(t *testing.T) {
+	pods := []runtime.Object{
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-1", Namespace: "np1", Labels: map[string]string{"skaffold.dev/run-id": "run-id"}},
+			Spec: v1.PodSpec{Containers: []v1.Container{
+				{Name: "app", Image: "gcr.io/foo/app:tag"},
+				{Name: "sidecar", Image: "gcr.io/foo/sidecar:tag"},
+			}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-2", Namespace: "np1", Labels: map[string]string{"skaffold.dev/run-id": "run-id"}},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: "gcr.io/foo/app:tag"}}},
+			Status:     v1.PodStatus{Phase: v1.PodPending},
+		},
+		// deployed by another run
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-3", Namespace: "np1", Labels: map[string]string{"skaffold.dev/run-id": "other"}},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: "gcr.io/foo/app:tag"}}},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "np1", Labels: map[string]string{"skaffold.dev/run-id": "run-id"}},
+			Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "db", Image: "postgres"}}},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		},
+	}
+	expectedEnv := []string{
+		"SKAFFOLD_RUN_ID=run-id",
+		"SKAFFOLD_KUBE_CONTEXT=kubecontext",
+		"SKAFFOLD_NAMESPACES=np1",
+	}
+
+	tests := []struct {
+		description string
+		hooks       latest.DeployHooks
+		pre         bool
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "host hook",
+			hooks: latest.DeployHooks{
+				PreHooks: []latest.DeployHookItem{{HostHook: &latest.HostHook{Command: []string{"echo", "pre-deploy"}}}},
+			},
+			pre:      true,
+			commands: testutil.CmdRunEnv("echo pre-deploy", expectedEnv),
+		},
+		{
+			description: "container hook in all containers of matching pods",
+			hooks: latest.DeployHooks{
+				PostHooks: []latest.DeployHookItem{{ContainerHook: &latest.NamedContainerHook{
+					ContainerHook: latest.ContainerHook{Command: []string{"echo", "post-deploy"}},
+					PodName:       "app-*",
+				}}},
+			},
+			commands: testutil.
+				CmdRun("kubectl --context kubecontext exec app-1 --namespace np1 -c app -- echo post-deploy").
+				AndRun("kubectl --context kubecontext exec app-1 --namespace np1 -c sidecar -- echo post-deploy"),
+		},
+		{
+			description: "container hook in named container",
+			hooks: latest.DeployHooks{
+				PostHooks: []latest.DeployHookItem{{ContainerHook: &latest.NamedContainerHook{
+					ContainerHook: latest.ContainerHook{Command: []string{"echo", "post-deploy"}},
+					PodName:       "app-*",
+					ContainerName: "side*",
+				}}},
+			},
+			commands: testutil.CmdRun("kubectl --context kubecontext exec app-1 --namespace np1 -c sidecar -- echo post-deploy"),
+		},
+		{
+			description: "failing container hook",
+			hooks: latest.DeployHooks{
+				PostHooks: []latest.DeployHookItem{{ContainerHook: &latest.NamedContainerHook{
+					ContainerHook: latest.ContainerHook{Command: []string{"false"}},
+					PodName:       "db",
+				}}},
+			},
+			commands:  testutil.CmdRunErr("kubectl --context kubecontext exec db --namespace np1 -c db -- false", errors.New("exit status 1")),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(pods...)
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			cli := &kubectl.CLI{KubeContext: "kubecontext"}
+			runner := DeployRunner(cli, test.hooks, []string{"np1"}, "skaffold.dev/run-id=run-id", NewDeployEnvOpts("run-id", "kubecontext", []string{"np1"}))
+
+			var out bytes.Buffer
+			var err error
+			if test.pre {
+				err = runner.RunPreHooks(context.Background(), &out)
+			} else {
+				err = runner.RunPostHooks(context.Background(), &out)
+			}
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckDeepEqual(proto.StatusCode_DEPLOY_HOOK_ERR, sErrors.ActionableErr(sErrors.Deploy, err).ErrCode)
+			}
+		})
+	}
+}
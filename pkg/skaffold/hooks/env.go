@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// BuildEnvOpts contains the environment variables to be set in a build type lifecycle hook executor.
+type BuildEnvOpts struct {
+	Image        string
+	PushImage    bool
+	ImageRepo    string
+	ImageTag     string
+	BuildContext string
+	KubeContext  string
+}
+
+// SyncEnvOpts contains the environment variables to be set in a sync type lifecycle hook executor.
+type SyncEnvOpts struct {
+	Image                string
+	BuildContext         string
+	FilesAddedOrModified string
+	FilesDeleted         string
+	KubeContext          string
+	Namespaces           string
+}
+
+// DeployEnvOpts contains the environment variables to be set in a deploy type lifecycle hook executor.
+type DeployEnvOpts struct {
+	RunID       string
+	KubeContext string
+	Namespaces  string
+}
+
+// NewBuildEnvOpts returns `BuildEnvOpts` for the artifact being built with the given image tag.
+func NewBuildEnvOpts(a *latest.Artifact, image string, pushImage bool, kubeContext string) (BuildEnvOpts, error) {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return BuildEnvOpts{}, fmt.Errorf("parsing image %q: %w", image, err)
+	}
+	w, err := filepath.Abs(a.Workspace)
+	if err != nil {
+		return BuildEnvOpts{}, fmt.Errorf("determining build workspace directory for image %q: %w", a.ImageName, err)
+	}
+	return BuildEnvOpts{
+		Image:        image,
+		PushImage:    pushImage,
+		ImageRepo:    ref.Repo,
+		ImageTag:     ref.Tag,
+		BuildContext: w,
+		KubeContext:  kubeContext,
+	}, nil
+}
+
+// NewSyncEnvOpts returns `SyncEnvOpts` for the files synced to the containers running the given image.
+func NewSyncEnvOpts(a *latest.Artifact, image string, addOrModifyFiles []string, deleteFiles []string, namespaces []string, kubeContext string) (SyncEnvOpts, error) {
+	w, err := filepath.Abs(a.Workspace)
+	if err != nil {
+		return SyncEnvOpts{}, fmt.Errorf("determining build workspace directory for image %q: %w", a.ImageName, err)
+	}
+	return SyncEnvOpts{
+		Image:                image,
+		BuildContext:         w,
+		FilesAddedOrModified: joinSorted(addOrModifyFiles),
+		FilesDeleted:         joinSorted(deleteFiles),
+		KubeContext:          kubeContext,
+		Namespaces:           strings.Join(namespaces, ","),
+	}, nil
+}
+
+// NewDeployEnvOpts returns `DeployEnvOpts` for the current run.
+func NewDeployEnvOpts(runID string, kubeContext string, namespaces []string) DeployEnvOpts {
+	return DeployEnvOpts{
+		RunID:       runID,
+		KubeContext: kubeContext,
+		Namespaces:  strings.Join(namespaces, ","),
+	}
+}
+
+func (o BuildEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_IMAGE=" + o.Image,
+		"SKAFFOLD_PUSH_IMAGE=" + strconv.FormatBool(o.PushImage),
+		"SKAFFOLD_IMAGE_REPO=" + o.ImageRepo,
+		"SKAFFOLD_IMAGE_TAG=" + o.ImageTag,
+		"SKAFFOLD_BUILD_CONTEXT=" + o.BuildContext,
+		"SKAFFOLD_KUBE_CONTEXT=" + o.KubeContext,
+	}
+}
+
+func (o SyncEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_IMAGE=" + o.Image,
+		"SKAFFOLD_BUILD_CONTEXT=" + o.BuildContext,
+		"SKAFFOLD_FILES_ADDED_OR_MODIFIED=" + o.FilesAddedOrModified,
+		"SKAFFOLD_FILES_DELETED=" + o.FilesDeleted,
+		"SKAFFOLD_KUBE_CONTEXT=" + o.KubeContext,
+		"SKAFFOLD_NAMESPACES=" + o.Namespaces,
+	}
+}
+
+func (o DeployEnvOpts) env() []string {
+	return []string{
+		"SKAFFOLD_RUN_ID=" + o.RunID,
+		"SKAFFOLD_KUBE_CONTEXT=" + o.KubeContext,
+		"SKAFFOLD_NAMESPACES=" + o.Namespaces,
+	}
+}
+
+func joinSorted(files []string) string {
+	sorted := append([]string{}, files...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// hostHook represents a lifecycle hook to be executed on the host machine
+type hostHook struct {
+	cfg latest.HostHook
+	env []string // environment variables to set in the hook process
+}
+
+// run executes the lifecycle hook on the host machine
+func (h hostHook) run(ctx context.Context, out io.Writer) error {
+	if len(h.cfg.OS) > 0 && !util.StrSliceContains(h.cfg.OS, runtime.GOOS) {
+		logrus.Infof("host hook execution skipped due to OS criteria %q not matched for command %q", strings.Join(h.cfg.OS, ","), h.cfg.Command)
+		return nil
+	}
+	cmd := exec.CommandContext(ctx, h.cfg.Command[0], h.cfg.Command[1:]...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.Env = append(util.OSEnviron(), h.env...)
+
+	logrus.Debugf("Running host hook %q", h.cfg.Command)
+	return util.RunCmd(cmd)
+}
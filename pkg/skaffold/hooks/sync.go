@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// SyncRunner creates a new runner for pre-sync and post-sync lifecycle hooks.
+// Container hooks run in the pods of the namespaces matching the label selector.
+func SyncRunner(cli *kubectl.CLI, imageName string, namespaces []string, labelSelector string, d latest.SyncHooks, opts SyncEnvOpts) Runner {
+	return syncRunner{
+		SyncHooks:     d,
+		cli:           cli,
+		imageName:     imageName,
+		namespaces:    namespaces,
+		labelSelector: labelSelector,
+		opts:          opts,
+	}
+}
+
+type syncRunner struct {
+	latest.SyncHooks
+	cli           *kubectl.CLI
+	imageName     string
+	namespaces    []string
+	labelSelector string
+	opts          SyncEnvOpts
+}
+
+func (r syncRunner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PreHooks, phases.PreSync)
+}
+
+func (r syncRunner) RunPostHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PostHooks, phases.PostSync)
+}
+
+func (r syncRunner) run(ctx context.Context, out io.Writer, hooks []latest.SyncHookItem, p phase) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+	color.Default.Fprintf(out, "Starting %s hooks for artifact %q...\n", p, r.imageName)
+	env := r.opts.env()
+	for _, h := range hooks {
+		var err error
+		switch {
+		case h.HostHook != nil:
+			err = hostHook{cfg: *h.HostHook, env: env}.run(ctx, out)
+		case h.ContainerHook != nil:
+			err = containerHook{
+				cfg:           *h.ContainerHook,
+				cli:           r.cli,
+				selector:      runningImageSelector(r.opts.Image),
+				namespaces:    r.namespaces,
+				labelSelector: r.labelSelector,
+			}.run(ctx, out)
+		}
+		if err != nil {
+			return hookErr(p, proto.StatusCode_SYNC_HOOK_ERR, err)
+		}
+	}
+	color.Default.Fprintf(out, "Completed %s hooks for artifact %q\n", p, r.imageName)
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncHooks(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		workDir, _ := filepath.Abs("./foo")
+		hooks := latest.SyncHooks{
+			PreHooks: []latest.SyncHookItem{
+				{HostHook: &latest.HostHook{Command: []string{"echo", "pre-sync"}}},
+			},
+			PostHooks: []latest.SyncHookItem{
+				{ContainerHook: &latest.ContainerHook{Command: []string{"echo", "post-sync"}}},
+			},
+		}
+		artifact := &latest.Artifact{
+			ImageName: "img1",
+			Workspace: "./foo",
+			Sync:      &latest.Sync{LifecycleHooks: hooks},
+		}
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "np1", Labels: map[string]string{"skaffold.dev/run-id": "run-id"}},
+			Spec: v1.PodSpec{Containers: []v1.Container{
+				{Name: "container1", Image: "gcr.io/foo/img1:latest"},
+				{Name: "container2", Image: "gcr.io/foo/img2:latest"},
+			}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+		expectedEnv := []string{
+			"SKAFFOLD_IMAGE=gcr.io/foo/img1:latest",
+			"SKAFFOLD_BUILD_CONTEXT=" + workDir,
+			"SKAFFOLD_FILES_ADDED_OR_MODIFIED=foo1,foo2",
+			"SKAFFOLD_FILES_DELETED=foo3",
+			"SKAFFOLD_KUBE_CONTEXT=kubecontext",
+			"SKAFFOLD_NAMESPACES=np1",
+		}
+
+		// deployed by another run
+		otherPod := pod.DeepCopy()
+		otherPod.Name = "pod2"
+		otherPod.Labels["skaffold.dev/run-id"] = "other"
+
+		client := fakekubeclientset.NewSimpleClientset(pod, otherPod)
+		t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunEnv("echo pre-sync", expectedEnv).
+			AndRun("kubectl --context kubecontext exec pod1 --namespace np1 -c container1 -- echo post-sync"))
+
+		opts, err := NewSyncEnvOpts(artifact, "gcr.io/foo/img1:latest", []string{"foo2", "foo1"}, []string{"foo3"}, []string{"np1"}, "kubecontext")
+		t.CheckNoError(err)
+		runner := SyncRunner(&kubectl.CLI{KubeContext: "kubecontext"}, "img1", []string{"np1"}, "skaffold.dev/run-id=run-id", hooks, opts)
+
+		var out bytes.Buffer
+		t.CheckNoError(runner.RunPreHooks(context.Background(), &out))
+		t.CheckNoError(runner.RunPostHooks(context.Background(), &out))
+	})
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+)
+
+var (
+	// For testing
+	getClient = kubernetesclient.Client
+)
+
+// attachDebuggers attaches debuggers to the containers of a running pod through ephemeral containers.
+// The debug configurations are recorded in the pod's `debug.cloud.google.com/config` annotation,
+// from which the debuggable containers are notified, as with transformed manifests.
+func (d *ContainerManager) attachDebuggers(ctx context.Context, pod *v1.Pod) {
+	key := string(pod.UID)
+	if d.attached[key] || pod.Status.Phase != v1.PodRunning || pod.DeletionTimestamp != nil {
+		return
+	}
+
+	containers, configurations := d.attacher.EphemeralContainers(ctx, pod)
+	// only try once per pod: attaching fails the same way each time
+	d.attached[key] = true
+	if len(containers) == 0 {
+		return
+	}
+
+	if err := attachEphemeralContainers(ctx, pod, containers, configurations); err != nil {
+		logrus.Warnf("Unable to attach debuggers to pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+}
+
+func attachEphemeralContainers(ctx context.Context, pod *v1.Pod, containers []v1.EphemeralContainer, configurations map[string]debug.ContainerDebugConfiguration) error {
+	client, err := getClient()
+	if err != nil {
+		return err
+	}
+	pods := client.CoreV1().Pods(pod.Namespace)
+
+	ephemeralContainers, err := pods.GetEphemeralContainers(ctx, pod.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting ephemeral containers (requires the EphemeralContainers feature gate): %w", err)
+	}
+	ephemeralContainers.EphemeralContainers = append(ephemeralContainers.EphemeralContainers, containers...)
+	if _, err := pods.UpdateEphemeralContainers(ctx, pod.Name, ephemeralContainers, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("adding ephemeral containers: %w", err)
+	}
+
+	annotation := debug.MergeConfigurations(pod.Annotations[debug.DebugConfigAnnotation], configurations)
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{debug.DebugConfigAnnotation: annotation},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := pods.Patch(ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("annotating pod with the debug configuration: %w", err)
+	}
+	return nil
+}
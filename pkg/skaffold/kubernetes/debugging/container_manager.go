@@ -38,15 +38,23 @@ type ContainerManager struct {
 	podWatcher kubernetes.PodWatcher
 	active     map[string]string // set of containers that have been notified
 	events     chan kubernetes.PodEvent
+
+	// attacher, if set, attaches debuggers to the running pods through ephemeral containers
+	attacher *debug.Attacher
+	attached map[string]bool // set of pods that debuggers have been attached to
 }
 
-func NewContainerManager(podSelector kubernetes.PodSelector, namespaces []string) *ContainerManager {
+// NewContainerManager creates a ContainerManager that notifies of the debuggable containers.
+// When an attacher is given, debuggers are first attached to the containers of the running pods.
+func NewContainerManager(podSelector kubernetes.PodSelector, namespaces []string, attacher *debug.Attacher) *ContainerManager {
 	// Create the channel here as Stop() may be called before Start() when a build fails, thus
 	// avoiding the possibility of closing a nil channel. Channels are cheap.
 	return &ContainerManager{
 		podWatcher: kubernetes.NewPodWatcher(podSelector, namespaces),
 		active:     map[string]string{},
 		events:     make(chan kubernetes.PodEvent),
+		attacher:   attacher,
+		attached:   map[string]bool{},
 	}
 }
 
@@ -74,6 +82,9 @@ func (d *ContainerManager) Start(ctx context.Context) error {
 					return
 				}
 
+				if d.attacher != nil {
+					d.attachDebuggers(ctx, evt.Pod)
+				}
 				d.checkPod(evt.Pod)
 			}
 		}
@@ -19,8 +19,12 @@ package kubernetes
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -29,19 +33,38 @@ import (
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
+const (
+	// LogFormatText prints the logs as colored text, prefixed with the name of the container.
+	LogFormatText = "text"
+	// LogFormatJSON prints the logs as JSON objects, one per line.
+	LogFormatJSON = "json"
+)
+
+// LogOptions configures the output of the logs, as set on the command line.
+type LogOptions struct {
+	// Format is either `text` or `json`.
+	Format string
+	// Dir is where the logs of each container are also written, to `<pod>/<container>.log` files.
+	// Empty means that the logs are not written to files.
+	Dir string
+}
+
 // LogAggregator aggregates the logs for all the deployed pods.
 type LogAggregator struct {
 	output      io.Writer
 	kubectlcli  *kubectl.CLI
 	config      latest.LogsConfig
+	options     LogOptions
 	podWatcher  PodWatcher
 	colorPicker ColorPicker
 
 	muted             int32
+	filter            atomic.Value
 	sinceTime         time.Time
 	events            chan PodEvent
 	trackedContainers trackedContainers
@@ -49,15 +72,60 @@ type LogAggregator struct {
 }
 
 // NewLogAggregator creates a new LogAggregator for a given output.
-func NewLogAggregator(out io.Writer, cli *kubectl.CLI, imageNames []string, podSelector PodSelector, namespaces []string, config latest.LogsConfig) *LogAggregator {
-	return &LogAggregator{
+func NewLogAggregator(out io.Writer, cli *kubectl.CLI, imageNames []string, podSelector PodSelector, namespaces []string, config latest.LogsConfig, options LogOptions) *LogAggregator {
+	a := &LogAggregator{
 		output:      out,
 		kubectlcli:  cli,
 		config:      config,
+		options:     options,
 		podWatcher:  NewPodWatcher(podSelector, namespaces),
 		colorPicker: NewColorPicker(imageNames),
 		events:      make(chan PodEvent),
 	}
+	a.initFilter()
+	return a
+}
+
+// NewContainerLogAggregator creates a LogAggregator that doesn't watch pods
+// and only prints the streams added with AddStream.
+func NewContainerLogAggregator(out io.Writer, imageNames []string, config latest.LogsConfig, options LogOptions) *LogAggregator {
+	a := &LogAggregator{
+		output:      out,
+		config:      config,
+		options:     options,
+		colorPicker: NewColorPicker(imageNames),
+		events:      make(chan PodEvent),
+	}
+	a.initFilter()
+	return a
+}
+
+func (a *LogAggregator) initFilter() {
+	if err := a.SetFilter(a.config); err != nil {
+		logrus.Warnf("Ignoring log filters: %s", err)
+	}
+}
+
+// SetFilter replaces the filters and the highlight rules of the logs.
+// The prefix of the log lines can't be changed.
+func (a *LogAggregator) SetFilter(config latest.LogsConfig) error {
+	if a == nil {
+		// Logs are not activated.
+		return nil
+	}
+
+	f, err := NewLogFilter(config)
+	if err != nil {
+		return err
+	}
+
+	a.filter.Store(f)
+	return nil
+}
+
+func (a *LogAggregator) logFilter() *LogFilter {
+	f, _ := a.filter.Load().(*LogFilter)
+	return f
 }
 
 func (a *LogAggregator) SetSince(t time.Time) {
@@ -77,6 +145,10 @@ func (a *LogAggregator) Start(ctx context.Context) error {
 		return nil
 	}
 
+	if a.podWatcher == nil {
+		return nil
+	}
+
 	a.podWatcher.Register(a.events)
 	stopWatcher, err := a.podWatcher.Start()
 	if err != nil {
@@ -126,6 +198,32 @@ func (a *LogAggregator) Stop() {
 	close(a.events)
 }
 
+// AddStream prints the log lines read from r, prefixed with the name of the container
+// and colored after its image.
+func (a *LogAggregator) AddStream(ctx context.Context, image, name string, r io.Reader) {
+	if a == nil {
+		// Logs are not activated.
+		return
+	}
+
+	pod := &v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Image: image}}}}
+	s := &logStream{
+		container:   name,
+		image:       image,
+		prefix:      fmt.Sprintf("[%s]", name),
+		headerColor: a.colorPicker.Pick(pod),
+	}
+	if a.config.Prefix == "none" {
+		s.prefix = ""
+	}
+
+	go func() {
+		if err := a.streamRequest(ctx, s, r); err != nil {
+			logrus.Errorf("streaming request %s", err)
+		}
+	}()
+}
+
 func sinceSeconds(d time.Duration) int64 {
 	since := int64((d + 999*time.Millisecond).Truncate(1 * time.Second).Seconds())
 	if since != 0 {
@@ -156,24 +254,101 @@ func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, co
 		_ = tw.Close()
 	}()
 
-	headerColor := a.colorPicker.Pick(pod)
-	prefix := a.prefix(pod, container)
-	if err := a.streamRequest(ctx, headerColor, prefix, tr); err != nil {
+	s := &logStream{
+		namespace:   pod.Namespace,
+		pod:         pod.Name,
+		container:   container.Name,
+		image:       containerImage(pod, container),
+		labels:      pod.Labels,
+		prefix:      a.prefix(pod, container),
+		headerColor: a.colorPicker.Pick(pod),
+	}
+	if err := a.streamRequest(ctx, s, tr); err != nil {
 		logrus.Errorf("streaming request %s", err)
 	}
 }
 
-func (a *LogAggregator) printLogLine(headerColor color.Color, prefix, text string) {
+// logStream describes where a stream of log lines comes from.
+type logStream struct {
+	namespace   string
+	pod         string
+	container   string
+	image       string
+	labels      map[string]string
+	prefix      string
+	headerColor color.Color
+	file        io.Writer
+}
+
+// logLine is a log line printed with the `json` format.
+type logLine struct {
+	Timestamp time.Time `json:"timestamp"`
+	Namespace string    `json:"namespace,omitempty"`
+	Pod       string    `json:"pod,omitempty"`
+	Container string    `json:"container"`
+	Image     string    `json:"image,omitempty"`
+	Message   string    `json:"message"`
+}
+
+// recordLogLine streams a log line to the clients of the API and writes it to a file.
+// This is done even for the lines that are not printed on the terminal.
+func (a *LogAggregator) recordLogLine(s *logStream, text string) {
+	event.ApplicationLog(time.Now(), s.pod, s.container, s.namespace, s.image, strings.TrimSuffix(text, "\n"))
+
+	if s.file != nil {
+		if _, err := io.WriteString(s.file, text); err != nil {
+			logrus.Debugf("Unable to write logs of %s to a file: %s", s.container, err)
+		}
+	}
+}
+
+func (a *LogAggregator) printLogLine(s *logStream, text string) {
 	if !a.IsMuted() {
 		a.outputLock.Lock()
 
-		headerColor.Fprintf(a.output, "%s ", prefix)
-		fmt.Fprint(a.output, text)
+		if a.options.Format == LogFormatJSON {
+			json.NewEncoder(a.output).Encode(logLine{
+				Timestamp: time.Now(),
+				Namespace: s.namespace,
+				Pod:       s.pod,
+				Container: s.container,
+				Image:     s.image,
+				Message:   strings.TrimSuffix(text, "\n"),
+			})
+		} else {
+			s.headerColor.Fprintf(a.output, "%s ", s.prefix)
+			for _, part := range a.logFilter().highlighted(text) {
+				part.color.Fprintf(a.output, "%s", part.text)
+			}
+		}
 
 		a.outputLock.Unlock()
 	}
 }
 
+// openLogFile opens the file where the logs of a container are written, if any.
+func (a *LogAggregator) openLogFile(s *logStream) (*os.File, error) {
+	if a.options.Dir == "" {
+		return nil, nil
+	}
+
+	path := filepath.Join(a.options.Dir, s.pod, s.container+".log")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+}
+
+// containerImage finds the image of a container in the spec of a pod.
+func containerImage(pod *v1.Pod, container v1.ContainerStatus) string {
+	for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if c.Name == container.Name {
+			return c.Image
+		}
+	}
+	return container.Image
+}
+
 func (a *LogAggregator) prefix(pod *v1.Pod, container v1.ContainerStatus) string {
 	switch a.config.Prefix {
 	case "auto":
@@ -207,12 +382,21 @@ func podAndContainerPrefix(pod *v1.Pod, container v1.ContainerStatus) string {
 	return fmt.Sprintf("[%s %s]", pod.Name, container.Name)
 }
 
-func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Color, prefix string, rc io.Reader) error {
+func (a *LogAggregator) streamRequest(ctx context.Context, s *logStream, rc io.Reader) error {
+	f, err := a.openLogFile(s)
+	if err != nil {
+		logrus.Warnf("Unable to write logs of %s to a file: %s", s.container, err)
+	}
+	if f != nil {
+		defer f.Close()
+		s.file = f
+	}
+
 	r := bufio.NewReader(rc)
 	for {
 		select {
 		case <-ctx.Done():
-			logrus.Infof("%s interrupted", prefix)
+			logrus.Infof("%s interrupted", s.prefix)
 			return nil
 		default:
 			// Read up to newline
@@ -224,7 +408,12 @@ func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Col
 				return fmt.Errorf("reading bytes from log stream: %w", err)
 			}
 
-			a.printLogLine(headerColor, prefix, line)
+			a.recordLogLine(s, line)
+
+			filter := a.logFilter()
+			if filter.selects(s) && filter.shows(line) {
+				a.printLogLine(s, line)
+			}
 		}
 	}
 }
@@ -0,0 +1,277 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// logLevels orders the levels found in logs in JSON format.
+var logLevels = map[string]int{
+	"trace":    10,
+	"debug":    20,
+	"info":     30,
+	"notice":   30,
+	"warn":     40,
+	"warning":  40,
+	"error":    50,
+	"critical": 60,
+	"fatal":    60,
+	"panic":    60,
+}
+
+var highlightColors = map[string]color.Color{
+	"red":    color.Red,
+	"green":  color.Green,
+	"yellow": color.Yellow,
+	"blue":   color.Blue,
+	"purple": color.Purple,
+	"cyan":   color.Cyan,
+	"white":  color.White,
+}
+
+// LogFilter decides which log lines are printed and highlights parts of them.
+// A nil LogFilter prints everything.
+type LogFilter struct {
+	include   []logSelector
+	exclude   []logSelector
+	filter    *regexp.Regexp
+	level     int
+	highlight []logHighlight
+}
+
+type logSelector struct {
+	image     string
+	container string
+	labels    labels.Selector
+}
+
+type logHighlight struct {
+	pattern *regexp.Regexp
+	color   color.Color
+}
+
+// NewLogFilter parses the filters and highlight rules of the logs configuration.
+func NewLogFilter(config latest.LogsConfig) (*LogFilter, error) {
+	f := &LogFilter{}
+
+	var err error
+	if f.include, err = logSelectors(config.Include); err != nil {
+		return nil, err
+	}
+	if f.exclude, err = logSelectors(config.Exclude); err != nil {
+		return nil, err
+	}
+
+	if config.Filter != "" {
+		if f.filter, err = regexp.Compile(config.Filter); err != nil {
+			return nil, fmt.Errorf("invalid log filter %q: %w", config.Filter, err)
+		}
+	}
+
+	if config.Level != "" {
+		level, found := logLevels[strings.ToLower(config.Level)]
+		if !found {
+			return nil, fmt.Errorf("invalid log level %q. Valid values are 'trace', 'debug', 'info', 'warn', 'error' or 'fatal'", config.Level)
+		}
+		f.level = level
+	}
+
+	for _, h := range config.Highlight {
+		pattern, err := regexp.Compile(h.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid highlight pattern %q: %w", h.Pattern, err)
+		}
+
+		c := color.Yellow
+		if h.Color != "" {
+			found := false
+			if c, found = highlightColors[strings.ToLower(h.Color)]; !found {
+				return nil, fmt.Errorf("invalid highlight color %q. Valid values are 'red', 'green', 'yellow', 'blue', 'purple', 'cyan' or 'white'", h.Color)
+			}
+		}
+
+		f.highlight = append(f.highlight, logHighlight{pattern: pattern, color: c})
+	}
+
+	return f, nil
+}
+
+func logSelectors(selectors []latest.LogSelector) ([]logSelector, error) {
+	var parsed []logSelector
+
+	for _, s := range selectors {
+		for _, pattern := range []string{s.Image, s.Container} {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid log selector pattern %q: %w", pattern, err)
+			}
+		}
+
+		selector, err := labels.Parse(s.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log selector %q: %w", s.LabelSelector, err)
+		}
+
+		parsed = append(parsed, logSelector{
+			image:     s.Image,
+			container: s.Container,
+			labels:    selector,
+		})
+	}
+
+	return parsed, nil
+}
+
+// selects tells if the logs of a container should be printed.
+func (f *LogFilter) selects(s *logStream) bool {
+	if f == nil {
+		return true
+	}
+
+	for _, selector := range f.exclude {
+		if selector.matches(s) {
+			return false
+		}
+	}
+
+	if len(f.include) == 0 {
+		return true
+	}
+	for _, selector := range f.include {
+		if selector.matches(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// shows tells if a log line should be printed.
+func (f *LogFilter) shows(text string) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.filter != nil && !f.filter.MatchString(text) {
+		return false
+	}
+
+	if f.level > 0 {
+		if level, found := lineLevel(text); found && level < f.level {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s logSelector) matches(stream *logStream) bool {
+	if s.image != "" {
+		if matches, _ := path.Match(s.image, imageWithoutTag(stream.image)); !matches {
+			return false
+		}
+	}
+	if s.container != "" {
+		if matches, _ := path.Match(s.container, stream.container); !matches {
+			return false
+		}
+	}
+	return s.labels.Matches(labels.Set(stream.labels))
+}
+
+// imageWithoutTag strips the tag and the digest of an image name.
+func imageWithoutTag(image string) string {
+	if i := strings.Index(image, "@"); i != -1 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
+
+// lineLevel reads the level of a log line in JSON format.
+// Levels can be names, as with zap or logrus, or numbers, as with bunyan or pino.
+func lineLevel(text string) (int, bool) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "{") {
+		return 0, false
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(text), &fields); err != nil {
+		return 0, false
+	}
+
+	for _, key := range []string{"level", "severity", "lvl"} {
+		switch value := fields[key].(type) {
+		case string:
+			if level, found := logLevels[strings.ToLower(value)]; found {
+				return level, true
+			}
+		case float64:
+			return int(value), true
+		}
+	}
+
+	return 0, false
+}
+
+// highlighted splits a log line into parts, with the color of each part.
+func (f *LogFilter) highlighted(text string) []coloredText {
+	if f == nil || len(f.highlight) == 0 {
+		return []coloredText{{text: text, color: color.None}}
+	}
+
+	// For each byte, the color of the last rule that matches it.
+	colors := make([]*color.Color, len(text))
+	for i := range f.highlight {
+		h := &f.highlight[i]
+		for _, match := range h.pattern.FindAllStringIndex(text, -1) {
+			for j := match[0]; j < match[1]; j++ {
+				colors[j] = &h.color
+			}
+		}
+	}
+
+	var parts []coloredText
+	start := 0
+	for i := 1; i <= len(text); i++ {
+		if i == len(text) || colors[i] != colors[start] {
+			part := coloredText{text: text[start:i], color: color.None}
+			if colors[start] != nil {
+				part.color = *colors[start]
+			}
+			parts = append(parts, part)
+			start = i
+		}
+	}
+	return parts
+}
+
+type coloredText struct {
+	text  string
+	color color.Color
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLogFilterSelects(t *testing.T) {
+	web := &logStream{container: "web", image: "gcr.io/project/web:v1", labels: map[string]string{"app": "web", "tier": "front"}}
+	cache := &logStream{container: "redis", image: "redis@sha256:abcd", labels: map[string]string{"app": "cache"}}
+	docker := &logStream{container: "worker", image: "localhost:5000/worker"}
+
+	tests := []struct {
+		description string
+		config      latest.LogsConfig
+		expected    []bool
+	}{
+		{
+			description: "no selector",
+			expected:    []bool{true, true, true},
+		},
+		{
+			description: "include by image",
+			config:      latest.LogsConfig{Include: []latest.LogSelector{{Image: "gcr.io/project/*"}, {Image: "redis"}}},
+			expected:    []bool{true, true, false},
+		},
+		{
+			description: "include by image without the tag",
+			config:      latest.LogsConfig{Include: []latest.LogSelector{{Image: "localhost:5000/worker"}}},
+			expected:    []bool{false, false, true},
+		},
+		{
+			description: "exclude by container name",
+			config:      latest.LogsConfig{Exclude: []latest.LogSelector{{Container: "red*"}}},
+			expected:    []bool{true, false, true},
+		},
+		{
+			description: "include by label selector",
+			config:      latest.LogsConfig{Include: []latest.LogSelector{{LabelSelector: "app in (web,cache),tier!=front"}}},
+			expected:    []bool{false, true, false},
+		},
+		{
+			description: "all the fields of a selector must match",
+			config:      latest.LogsConfig{Include: []latest.LogSelector{{Container: "web", LabelSelector: "app=cache"}}},
+			expected:    []bool{false, false, false},
+		},
+		{
+			description: "exclude wins",
+			config: latest.LogsConfig{
+				Include: []latest.LogSelector{{Container: "*"}},
+				Exclude: []latest.LogSelector{{LabelSelector: "app=web"}},
+			},
+			expected: []bool{false, true, true},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			f, err := NewLogFilter(test.config)
+			t.CheckNoError(err)
+
+			t.CheckDeepEqual(test.expected, []bool{f.selects(web), f.selects(cache), f.selects(docker)})
+		})
+	}
+}
+
+func TestLogFilterShows(t *testing.T) {
+	tests := []struct {
+		description string
+		config      latest.LogsConfig
+		line        string
+		expected    bool
+	}{
+		{
+			description: "no filter",
+			line:        "anything\n",
+			expected:    true,
+		},
+		{
+			description: "matching regexp",
+			config:      latest.LogsConfig{Filter: "GET /api/.*"},
+			line:        "GET /api/users 200\n",
+			expected:    true,
+		},
+		{
+			description: "not matching regexp",
+			config:      latest.LogsConfig{Filter: "GET /api/.*"},
+			line:        "GET /healthz 200\n",
+			expected:    false,
+		},
+		{
+			description: "level above",
+			config:      latest.LogsConfig{Level: "warn"},
+			line:        `{"level":"error","msg":"boom"}` + "\n",
+			expected:    true,
+		},
+		{
+			description: "level below",
+			config:      latest.LogsConfig{Level: "warn"},
+			line:        `{"level":"info","msg":"hello"}` + "\n",
+			expected:    false,
+		},
+		{
+			description: "severity",
+			config:      latest.LogsConfig{Level: "WARN"},
+			line:        `{"severity":"DEBUG","message":"hello"}` + "\n",
+			expected:    false,
+		},
+		{
+			description: "numeric level",
+			config:      latest.LogsConfig{Level: "warn"},
+			line:        `{"level":50,"msg":"boom"}` + "\n",
+			expected:    true,
+		},
+		{
+			description: "line without a level",
+			config:      latest.LogsConfig{Level: "error"},
+			line:        "plain text\n",
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			f, err := NewLogFilter(test.config)
+			t.CheckNoError(err)
+
+			t.CheckDeepEqual(test.expected, f.shows(test.line))
+		})
+	}
+}
+
+func TestLogFilterHighlighted(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		f, err := NewLogFilter(latest.LogsConfig{
+			Highlight: []latest.LogHighlight{
+				{Pattern: "ERROR"},
+				{Pattern: "[0-9]+ms", Color: "cyan"},
+			},
+		})
+		t.CheckNoError(err)
+
+		parts := f.highlighted("ERROR took 12ms\n")
+
+		var texts []string
+		for _, part := range parts {
+			texts = append(texts, part.text)
+		}
+		t.CheckDeepEqual([]string{"ERROR", " took ", "12ms", "\n"}, texts)
+		t.CheckTrue(parts[0].color == color.Yellow)
+		t.CheckTrue(parts[2].color == color.Cyan)
+	})
+}
+
+func TestNewLogFilterErrors(t *testing.T) {
+	tests := []struct {
+		description string
+		config      latest.LogsConfig
+	}{
+		{description: "invalid regexp", config: latest.LogsConfig{Filter: "("}},
+		{description: "invalid level", config: latest.LogsConfig{Level: "loud"}},
+		{description: "invalid label selector", config: latest.LogsConfig{Include: []latest.LogSelector{{LabelSelector: "a==b==c"}}}},
+		{description: "invalid glob", config: latest.LogsConfig{Exclude: []latest.LogSelector{{Image: "["}}}},
+		{description: "invalid highlight pattern", config: latest.LogsConfig{Highlight: []latest.LogHighlight{{Pattern: "("}}}},
+		{description: "invalid highlight color", config: latest.LogsConfig{Highlight: []latest.LogHighlight{{Pattern: "a", Color: "pink"}}}},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := NewLogFilter(test.config)
+
+			t.CheckError(true, err)
+		})
+	}
+}
+
+func TestSetFilter(t *testing.T) {
+	testutil.Run(t, "filters can be changed at runtime", func(t *testutil.T) {
+		var buf bytes.Buffer
+		logger := NewContainerLogAggregator(&buf, nil, latest.LogsConfig{Prefix: "container", Filter: "keep"}, LogOptions{})
+		s := &logStream{container: "app", prefix: "[app]"}
+
+		t.CheckNoError(logger.streamRequest(context.Background(), s, strings.NewReader("keep 1\ndrop 1\n")))
+		t.CheckNoError(logger.SetFilter(latest.LogsConfig{Filter: "drop"}))
+		t.CheckNoError(logger.streamRequest(context.Background(), s, strings.NewReader("keep 2\ndrop 2\n")))
+		t.CheckError(true, logger.SetFilter(latest.LogsConfig{Filter: "("}))
+		t.CheckNoError(logger.streamRequest(context.Background(), s, strings.NewReader("keep 3\ndrop 3\n")))
+
+		t.CheckDeepEqual("[app] keep 1\n[app] drop 2\n[app] drop 3\n", buf.String())
+	})
+}
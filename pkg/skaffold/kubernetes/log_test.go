@@ -19,6 +19,8 @@ package kubernetes
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"strings"
 	"sync"
 	"testing"
@@ -110,7 +112,7 @@ func TestPrintLogLine(t *testing.T) {
 
 			go func() {
 				for i := 0; i < 100; i++ {
-					logger.printLogLine(color.Default, "PREFIX", "TEXT\n")
+					logger.printLogLine(&logStream{headerColor: color.Default, prefix: "PREFIX"}, "TEXT\n")
 				}
 				wg.Done()
 			}()
@@ -124,6 +126,55 @@ func TestPrintLogLine(t *testing.T) {
 	})
 }
 
+func TestPrintLogLineJSON(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var buf bytes.Buffer
+
+		logger := &LogAggregator{
+			output:  &buf,
+			options: LogOptions{Format: LogFormatJSON},
+		}
+		logger.printLogLine(&logStream{
+			namespace: "ns",
+			pod:       "pod",
+			container: "app",
+			image:     "image:tag",
+			prefix:    "[pod app]",
+		}, "TEXT\n")
+
+		var line logLine
+		t.CheckNoError(json.Unmarshal(buf.Bytes(), &line))
+		t.CheckDeepEqual("ns", line.Namespace)
+		t.CheckDeepEqual("pod", line.Pod)
+		t.CheckDeepEqual("app", line.Container)
+		t.CheckDeepEqual("image:tag", line.Image)
+		t.CheckDeepEqual("TEXT", line.Message)
+		t.CheckFalse(line.Timestamp.IsZero())
+	})
+}
+
+func TestLogFiles(t *testing.T) {
+	testutil.Run(t, "write logs to files even when muted", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		var buf bytes.Buffer
+
+		logger := &LogAggregator{
+			output:  &buf,
+			options: LogOptions{Dir: tmpDir.Root()},
+		}
+		logger.Mute()
+
+		s := &logStream{pod: "pod", container: "app"}
+		err := logger.streamRequest(context.Background(), s, strings.NewReader("line1\nline2\n"))
+		t.CheckNoError(err)
+
+		content, err := ioutil.ReadFile(tmpDir.Path("pod/app.log"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual("line1\nline2\n", string(content))
+		t.CheckDeepEqual("", buf.String())
+	})
+}
+
 func TestLogAggregatorZeroValue(t *testing.T) {
 	var m *LogAggregator
 
@@ -131,9 +182,32 @@ func TestLogAggregatorZeroValue(t *testing.T) {
 	m.Start(context.Background())
 	m.Mute()
 	m.Unmute()
+	m.AddStream(context.Background(), "image", "name", strings.NewReader(""))
 	m.Stop()
 }
 
+func TestAddStream(t *testing.T) {
+	testutil.Run(t, "prefix lines with the container name", func(t *testutil.T) {
+		var buf bytes.Buffer
+		logger := NewContainerLogAggregator(&buf, []string{"image"}, latest.LogsConfig{Prefix: "container"}, LogOptions{})
+
+		err := logger.Start(context.Background())
+		t.CheckNoError(err)
+
+		logger.AddStream(context.Background(), "image:tag", "app", strings.NewReader("line1\nline2\n"))
+
+		var output string
+		for i := 0; i < 100 && strings.Count(output, "\n") < 2; i++ {
+			time.Sleep(10 * time.Millisecond)
+			logger.outputLock.Lock()
+			output = buf.String()
+			logger.outputLock.Unlock()
+		}
+		t.CheckDeepEqual("[app] line1\n[app] line2\n", output)
+		logger.Stop()
+	})
+}
+
 func TestPrefix(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -189,7 +263,7 @@ func TestPrefix(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			logger := NewLogAggregator(nil, nil, nil, nil, nil, latest.LogsConfig{
 				Prefix: test.prefix,
-			})
+			}, LogOptions{})
 
 			p := logger.prefix(&test.pod, test.container)
 
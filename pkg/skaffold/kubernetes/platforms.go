@@ -0,0 +1,57 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+)
+
+// GetClusterPlatforms returns the sorted list of distinct `os/arch` platforms of the cluster nodes.
+func GetClusterPlatforms(ctx context.Context) ([]string, error) {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return nil, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing nodes: %w", err)
+	}
+
+	seen := map[string]bool{}
+	var platforms []string
+	for _, node := range nodes.Items {
+		info := node.Status.NodeInfo
+		if info.OperatingSystem == "" || info.Architecture == "" {
+			continue
+		}
+		platform := info.OperatingSystem + "/" + info.Architecture
+		if !seen[platform] {
+			seen[platform] = true
+			platforms = append(platforms, platform)
+		}
+	}
+
+	sort.Strings(platforms)
+	return platforms, nil
+}
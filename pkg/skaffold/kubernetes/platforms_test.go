@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGetClusterPlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		nodes       []runtime.Object
+		expected    []string
+	}{
+		{
+			description: "no nodes",
+		},
+		{
+			description: "single platform",
+			nodes:       []runtime.Object{node("node1", "linux", "amd64"), node("node2", "linux", "amd64")},
+			expected:    []string{"linux/amd64"},
+		},
+		{
+			description: "mixed platforms",
+			nodes:       []runtime.Object{node("node1", "linux", "arm64"), node("node2", "linux", "amd64"), node("node3", "", "")},
+			expected:    []string{"linux/amd64", "linux/arm64"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&kubernetesclient.Client, mockClient(fakekubeclientset.NewSimpleClientset(test.nodes...)))
+
+			platforms, err := GetClusterPlatforms(context.Background())
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, platforms)
+		})
+	}
+}
+
+func node(name, os, arch string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			NodeInfo: v1.NodeSystemInfo{OperatingSystem: os, Architecture: arch},
+		},
+	}
+}
@@ -18,6 +18,7 @@ package portforward
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -27,6 +28,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
@@ -97,35 +99,63 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 	ownerReference := topLevelOwnerKey(ctx, pod, pod.Kind)
 	for _, c := range pod.Spec.Containers {
 		for _, port := range c.Ports {
-			// get current entry for this container
-			resource := latest.PortForwardResource{
-				Type:      constants.Pod,
-				Name:      pod.Name,
-				Namespace: pod.Namespace,
-				Port:      schemautil.FromInt(int(port.ContainerPort)),
-				Address:   constants.DefaultPortForwardAddress,
-				LocalPort: int(port.ContainerPort),
+			if err := p.portForwardContainerPort(ctx, pod, c.Name, port.Name, port.ContainerPort, ownerReference); err != nil {
+				return err
 			}
-
-			entry, err := p.podForwardingEntry(pod.ResourceVersion, c.Name, port.Name, ownerReference, resource)
-			if err != nil {
-				return fmt.Errorf("getting pod forwarding entry: %w", err)
-			}
-			if entry.resource.Port.IntVal != entry.localPort {
-				color.Yellow.Fprintf(p.entryManager.output, "Forwarding container %s/%s to local port %d.\n", pod.Name, c.Name, entry.localPort)
-			}
-			if prevEntry, ok := p.entryManager.forwardedResources.Load(entry.key()); ok {
-				// Check if this is a new generation of pod
-				if entry.resourceVersion > prevEntry.resourceVersion {
-					p.entryManager.Terminate(prevEntry)
-				}
+		}
+	}
+	// debuggers attached through ephemeral containers can't declare ports: they are recorded in the debug configuration
+	for _, c := range pod.Spec.EphemeralContainers {
+		for portName, port := range attachedDebuggerPorts(pod, c.TargetContainerName) {
+			if err := p.portForwardContainerPort(ctx, pod, c.TargetContainerName, portName, int32(port), ownerReference); err != nil {
+				return err
 			}
-			p.entryManager.forwardPortForwardEntry(ctx, entry)
 		}
 	}
 	return nil
 }
 
+func (p *WatchingPodForwarder) portForwardContainerPort(ctx context.Context, pod *v1.Pod, containerName, portName string, port int32, ownerReference string) error {
+	// get current entry for this container
+	resource := latest.PortForwardResource{
+		Type:      constants.Pod,
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Port:      schemautil.FromInt(int(port)),
+		Address:   constants.DefaultPortForwardAddress,
+		LocalPort: int(port),
+	}
+
+	entry, err := p.podForwardingEntry(pod.ResourceVersion, containerName, portName, ownerReference, resource)
+	if err != nil {
+		return fmt.Errorf("getting pod forwarding entry: %w", err)
+	}
+	if entry.resource.Port.IntVal != entry.localPort {
+		color.Yellow.Fprintf(p.entryManager.output, "Forwarding container %s/%s to local port %d.\n", pod.Name, containerName, entry.localPort)
+	}
+	if prevEntry, ok := p.entryManager.forwardedResources.Load(entry.key()); ok {
+		// Check if this is a new generation of pod
+		if entry.resourceVersion > prevEntry.resourceVersion {
+			p.entryManager.Terminate(prevEntry)
+		}
+	}
+	p.entryManager.forwardPortForwardEntry(ctx, entry)
+	return nil
+}
+
+// attachedDebuggerPorts returns the debug ports of a container, when a debugger is attached to it
+func attachedDebuggerPorts(pod *v1.Pod, containerName string) map[string]uint32 {
+	annotation, found := pod.Annotations[debug.DebugConfigAnnotation]
+	if !found {
+		return nil
+	}
+	var configurations map[string]debug.ContainerDebugConfiguration
+	if err := json.Unmarshal([]byte(annotation), &configurations); err != nil {
+		return nil
+	}
+	return configurations[containerName].Ports
+}
+
 func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, containerName, portName, ownerReference string, resource latest.PortForwardResource) (*portForwardEntry, error) {
 	rv, err := strconv.Atoi(resourceVersion)
 	if err != nil {
@@ -91,6 +91,47 @@ func TestAutomaticPortForwardPod(t *testing.T) {
 				},
 			},
 		},
+		{
+			description:    "debugger attached through an ephemeral container",
+			availablePorts: []int{56268},
+			expectedPorts:  []int{56268},
+			expectedEntries: map[string]*portForwardEntry{
+				"owner-containername-namespace-dlv-56268": {
+					resourceVersion: 1,
+					podName:         "podname",
+					containerName:   "containername",
+					resource: latest.PortForwardResource{
+						Type:      "pod",
+						Name:      "podname",
+						Namespace: "namespace",
+						Port:      schemautil.FromInt(56268),
+						Address:   "127.0.0.1",
+						LocalPort: 56268,
+					},
+					ownerReference:         "owner",
+					automaticPodForwarding: true,
+					portName:               "dlv",
+					localPort:              56268,
+				},
+			},
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "podname",
+						ResourceVersion: "1",
+						Namespace:       "namespace",
+						Annotations:     map[string]string{"debug.cloud.google.com/config": `{"containername":{"runtime":"go","ports":{"dlv":56268}}}`},
+					},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{{Name: "containername"}},
+						EphemeralContainers: []v1.EphemeralContainer{{
+							EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "skaffold-debug-containername"},
+							TargetContainerName:      "containername",
+						}},
+					},
+				},
+			},
+		},
 		{
 			description:    "unavailable container port",
 			availablePorts: []int{9000},
@@ -29,6 +29,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
@@ -55,12 +56,14 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 			bRes = append(bRes, build.Artifact{
 				ImageName: artifact.ImageName,
 				Tag:       tags[artifact.ImageName],
+				Platforms: artifact.Platforms,
 			})
 		}
 
 		return bRes, nil
 	}
 
+	started := time.Now()
 	bRes, err := r.cache.Build(ctx, out, tags, artifacts, func(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact) ([]build.Artifact, error) {
 		if len(artifacts) == 0 {
 			return nil, nil
@@ -68,11 +71,35 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 
 		r.hasBuilt = true
 
+		for _, a := range artifacts {
+			hooksRunner, err := r.buildHooksRunner(a, tags[a.ImageName])
+			if err != nil {
+				return nil, err
+			}
+			if err := hooksRunner.RunPreHooks(ctx, out); err != nil {
+				return nil, err
+			}
+		}
+
 		bRes, err := r.builder.Build(ctx, out, tags, artifacts)
 		if err != nil {
 			return nil, err
 		}
 
+		built := make(map[string]string, len(bRes))
+		for _, b := range bRes {
+			built[b.ImageName] = b.Tag
+		}
+		for _, a := range artifacts {
+			hooksRunner, err := r.buildHooksRunner(a, built[a.ImageName])
+			if err != nil {
+				return nil, err
+			}
+			if err := hooksRunner.RunPostHooks(ctx, out); err != nil {
+				return nil, err
+			}
+		}
+
 		return bRes, nil
 	})
 	if err != nil {
@@ -85,11 +112,25 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 		}
 	}
 
+	if r.signer != nil {
+		if bRes, err = r.signer.Sign(out, bRes); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.attester != nil {
+		if err := r.attester.Attest(ctx, out, artifacts, bRes, started, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update which images are logged.
 	r.addTagsToPodSelector(bRes)
 
 	// Make sure all artifacts are redeployed. Not only those that were just built.
 	r.builds = build.MergeWithPreviousBuilds(bRes, r.builds)
+	r.reverseSyncer.UpdateBuilds(r.builds)
+	r.debugAttacher.UpdateBuilds(r.builds)
 
 	return bRes, nil
 }
@@ -130,6 +171,15 @@ func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifa
 	return nil
 }
 
+// buildHooksRunner creates the lifecycle hooks runner for building the given artifact.
+func (r *SkaffoldRunner) buildHooksRunner(a *latest.Artifact, image string) (hooks.Runner, error) {
+	opts, err := hooks.NewBuildEnvOpts(a, image, !r.imagesAreLocal, r.runCtx.GetKubeContext())
+	if err != nil {
+		return nil, fmt.Errorf("creating build hooks environment for %q: %w", a.ImageName, err)
+	}
+	return hooks.BuildRunner(a.LifecycleHooks, opts), nil
+}
+
 // Update which images are logged.
 func (r *SkaffoldRunner) addTagsToPodSelector(artifacts []build.Artifact) {
 	for _, artifact := range artifacts {
@@ -19,8 +19,19 @@ package runner
 import (
 	"context"
 	"io"
+
+	"github.com/sirupsen/logrus"
 )
 
 func (r *SkaffoldRunner) Cleanup(ctx context.Context, out io.Writer) error {
-	return r.deployer.Cleanup(ctx, out)
+	if err := r.deployer.Cleanup(ctx, out); err != nil {
+		return err
+	}
+
+	// Nothing that was deployed can be skipped anymore.
+	r.runCtx.DeployState().Reset()
+	if err := r.runCtx.DeployState().Save(); err != nil {
+		logrus.Warnf("Error saving deploy state: %v", err)
+	}
+	return nil
 }
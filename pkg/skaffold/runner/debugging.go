@@ -17,14 +17,43 @@ limitations under the License.
 package runner
 
 import (
+	"github.com/sirupsen/logrus"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/debugging"
+	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug/ide"
+	kubernetesdebugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/debugging"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
-func (r *SkaffoldRunner) createContainerManager() *debugging.ContainerManager {
+func (r *SkaffoldRunner) createContainerManager() *kubernetesdebugging.ContainerManager {
 	if r.runCtx.Mode() != config.RunModes.Debug {
 		return nil
 	}
 
-	return debugging.NewContainerManager(r.podSelector, r.runCtx.GetNamespaces())
+	r.debugAttacher = nil
+	if r.runCtx.DebugMode() == debugging.ModeAttach {
+		debugHelpersRegistry, err := config.GetDebugHelpersRegistry(r.runCtx.GlobalConfig())
+		if err != nil {
+			logrus.Warnln("Unable to attach debuggers: retrieving debug helpers registry:", err)
+		} else {
+			// the attacher runs on the pod watcher's goroutine, so the builds are handed to it after each build
+			r.debugAttacher = debugging.NewAttacher(r.builds, r.runCtx.GetInsecureRegistries(), debugHelpersRegistry)
+		}
+	}
+
+	return kubernetesdebugging.NewContainerManager(r.podSelector, r.runCtx.GetNamespaces(), r.debugAttacher)
+}
+
+func (r *SkaffoldRunner) createIDEConfigWriter(artifacts []*latest.Artifact) *ide.ConfigWriter {
+	if r.runCtx.Mode() != config.RunModes.Debug || r.runCtx.IDEConfig() == "" {
+		return nil
+	}
+
+	writer, err := ide.NewConfigWriter(r.runCtx.IDEConfig(), r.runCtx.GetWorkingDir(), artifacts)
+	if err != nil {
+		logrus.Warnln("Unable to write IDE launch configurations:", err)
+		return nil
+	}
+	return writer
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	deployutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 )
@@ -55,8 +56,10 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	// Check that the cluster is reachable.
 	// This gives a better error message when the cluster can't
 	// be reached.
-	if err := failIfClusterIsNotReachable(); err != nil {
-		return fmt.Errorf("unable to connect to Kubernetes: %w", err)
+	if r.runCtx.DeploysToKubernetes() {
+		if err := failIfClusterIsNotReachable(); err != nil {
+			return fmt.Errorf("unable to connect to Kubernetes: %w", err)
+		}
 	}
 
 	if r.imagesAreLocal && r.runCtx.Cluster.LoadImages {
@@ -72,6 +75,11 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 
 	event.DeployInProgress()
+	if err := r.deployHooksRunner().RunPreHooks(ctx, deployOut); err != nil {
+		postDeployFn()
+		event.DeployFailed(err)
+		return err
+	}
 	namespaces, err := r.deployer.Deploy(ctx, deployOut, artifacts)
 	postDeployFn()
 	if err != nil {
@@ -80,18 +88,33 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 
 	r.hasDeployed = true
+	if err := r.runCtx.DeployState().Save(); err != nil {
+		logrus.Warnf("Error saving deploy state: %v", err)
+	}
 
 	statusCheckOut, postStatusCheckFn, err := deployutil.WithStatusCheckLogFile(time.Now().Format(deployutil.TimeFormat)+".log", out, r.runCtx.Muted())
 	postStatusCheckFn()
 	if err != nil {
 		return err
 	}
-	event.DeployComplete()
 	r.runCtx.UpdateNamespaces(namespaces)
+	// Post-deploy hooks see the namespaces that were just deployed to.
+	if err := r.deployHooksRunner().RunPostHooks(ctx, out); err != nil {
+		event.DeployFailed(err)
+		return err
+	}
+	event.DeployComplete()
 	sErr := r.performStatusCheck(ctx, statusCheckOut)
 	return sErr
 }
 
+// deployHooksRunner creates the lifecycle hooks runner for the deploy phase.
+func (r *SkaffoldRunner) deployHooksRunner() hooks.Runner {
+	namespaces := r.runCtx.GetNamespaces()
+	opts := hooks.NewDeployEnvOpts(r.labeller.GetRunID(), r.runCtx.GetKubeContext(), namespaces)
+	return hooks.DeployRunner(r.kubectlCLI, r.runCtx.Pipeline().Deploy.LifecycleHooks, namespaces, r.labeller.RunIDSelector(), opts)
+}
+
 func (r *SkaffoldRunner) loadImagesIntoCluster(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
 	currentContext, err := r.getCurrentContext()
 	if err != nil {
@@ -146,7 +169,7 @@ func failIfClusterIsNotReachable() error {
 
 func (r *SkaffoldRunner) performStatusCheck(ctx context.Context, out io.Writer) error {
 	// Check if we need to perform deploy status
-	if !r.runCtx.StatusCheck() {
+	if !r.runCtx.StatusCheck() || !r.runCtx.DeploysToKubernetes() {
 		return nil
 	}
 
@@ -30,6 +30,7 @@ import (
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/hooks"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
@@ -78,17 +79,18 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 		meterUpdated = true
 		for _, s := range r.changeSet.needsResync {
 			fileCount := len(s.Copy) + len(s.Delete)
+			postSyncAction := sync.PostSyncAction(s.Artifact)
 			color.Default.Fprintf(out, "Syncing %d files for %s\n", fileCount, s.Image)
-			fileSyncInProgress(fileCount, s.Image)
+			fileSyncInProgress(fileCount, s.Image, postSyncAction)
 
-			if err := r.syncer.Sync(ctx, s); err != nil {
+			if err := r.syncWithHooks(ctx, out, s); err != nil {
 				logrus.Warnln("Skipping deploy due to sync error:", err)
-				fileSyncFailed(fileCount, s.Image, err)
+				fileSyncFailed(fileCount, s.Image, postSyncAction, err)
 				event.DevLoopFailedInPhase(r.devIteration, sErrors.FileSync, err)
 				return nil
 			}
 
-			fileSyncSucceeded(fileCount, s.Image)
+			fileSyncSucceeded(fileCount, s.Image, postSyncAction)
 		}
 	}
 
@@ -134,11 +136,41 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 	return nil
 }
 
+// syncWithHooks syncs the files of the given item, running the artifact's sync lifecycle hooks around it.
+func (r *SkaffoldRunner) syncWithHooks(ctx context.Context, out io.Writer, s *sync.Item) error {
+	if s.Artifact == nil || s.Artifact.Sync == nil {
+		return r.syncer.Sync(ctx, s)
+	}
+
+	var added, deleted []string
+	for f := range s.Copy {
+		added = append(added, f)
+	}
+	for f := range s.Delete {
+		deleted = append(deleted, f)
+	}
+	namespaces := r.runCtx.GetNamespaces()
+	opts, err := hooks.NewSyncEnvOpts(s.Artifact, s.Image, added, deleted, namespaces, r.runCtx.GetKubeContext())
+	if err != nil {
+		return err
+	}
+	hooksRunner := hooks.SyncRunner(r.kubectlCLI, s.Artifact.ImageName, namespaces, r.labeller.RunIDSelector(), s.Artifact.Sync.LifecycleHooks, opts)
+
+	if err := hooksRunner.RunPreHooks(ctx, out); err != nil {
+		return err
+	}
+	if err := r.syncer.Sync(ctx, s); err != nil {
+		return err
+	}
+	return hooksRunner.RunPostHooks(ctx, out)
+}
+
 // Dev watches for changes and runs the skaffold build and deploy
 // config until interrupted by the user.
 func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) error {
 	event.DevLoopInProgress(r.devIteration)
 	defer func() { r.devIteration++ }()
+	r.targetClusterPlatform(ctx, out, artifacts)
 	g := getTransposeGraph(artifacts)
 	// Watch artifacts
 	start := time.Now()
@@ -238,12 +270,21 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	forwarderManager := r.createForwarder(out)
 	defer forwarderManager.Stop()
 
+	if r.runCtx.DeploysToKubernetes() {
+		r.reverseSyncer = sync.NewReverseSyncer(r.kubectlCLI, r.runCtx.GetNamespaces(), r.labeller.RunIDSelector(), artifacts)
+	}
+	defer r.reverseSyncer.Stop()
+	r.reverseSyncer.Start(ctx, r.builds)
+
 	if err := forwarderManager.Start(ctx); err != nil {
 		logrus.Warnln("Error starting port forwarding:", err)
 	}
 	if err := debugContainerManager.Start(ctx); err != nil {
 		logrus.Warnln("Error starting debug container notification:", err)
 	}
+	ideConfigWriter := r.createIDEConfigWriter(artifacts)
+	defer ideConfigWriter.Stop()
+	ideConfigWriter.Start(ctx)
 	// Start printing the logs after deploy is finished
 	if err := logger.Start(ctx); err != nil {
 		return fmt.Errorf("starting logger: %w", err)
@@ -46,6 +46,10 @@ func (t *NoopMonitor) Run(bool) error {
 
 func (t *NoopMonitor) Reset() {}
 
+func (t *NoopMonitor) Changed(...string) {}
+
+func (t *NoopMonitor) DependsOn(string) bool { return false }
+
 type FailMonitor struct{}
 
 func (t *FailMonitor) Register(func() ([]string, error), func(filemon.Events)) error {
@@ -58,6 +62,10 @@ func (t *FailMonitor) Run(bool) error {
 
 func (t *FailMonitor) Reset() {}
 
+func (t *FailMonitor) Changed(...string) {}
+
+func (t *FailMonitor) DependsOn(string) bool { return false }
+
 type TestMonitor struct {
 	events    []filemon.Events
 	callbacks []func(filemon.Events)
@@ -88,6 +96,10 @@ func (t *TestMonitor) Run(bool) error {
 
 func (t *TestMonitor) Reset() {}
 
+func (t *TestMonitor) Changed(...string) {}
+
+func (t *TestMonitor) DependsOn(string) bool { return false }
+
 func mockK8sClient() (k8s.Interface, error) {
 	return fakekubeclientset.NewSimpleClientset(), nil
 }
@@ -489,9 +501,9 @@ func TestDevSync(t *testing.T) {
 			var actualFileSyncEventCalls fileSyncEventCalls
 			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
 			t.Override(&client.Client, mockK8sClient)
-			t.Override(&fileSyncInProgress, func(int, string) { actualFileSyncEventCalls.InProgress++ })
-			t.Override(&fileSyncFailed, func(int, string, error) { actualFileSyncEventCalls.Failed++ })
-			t.Override(&fileSyncSucceeded, func(int, string) { actualFileSyncEventCalls.Succeeded++ })
+			t.Override(&fileSyncInProgress, func(int, string, string) { actualFileSyncEventCalls.InProgress++ })
+			t.Override(&fileSyncFailed, func(int, string, string, error) { actualFileSyncEventCalls.Failed++ })
+			t.Override(&fileSyncSucceeded, func(int, string, string) { actualFileSyncEventCalls.Succeeded++ })
 			t.Override(&sync.WorkingDir, func(string, docker.Config) (string, error) { return "/", nil })
 			test.testBench.cycles = len(test.watchEvents)
 
@@ -580,9 +592,9 @@ func TestDevSync_WithDependencies(t *testing.T) {
 			var actualFileSyncEventCalls fileSyncEventCalls
 			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
 			t.Override(&client.Client, mockK8sClient)
-			t.Override(&fileSyncInProgress, func(int, string) { actualFileSyncEventCalls.InProgress++ })
-			t.Override(&fileSyncFailed, func(int, string, error) { actualFileSyncEventCalls.Failed++ })
-			t.Override(&fileSyncSucceeded, func(int, string) { actualFileSyncEventCalls.Succeeded++ })
+			t.Override(&fileSyncInProgress, func(int, string, string) { actualFileSyncEventCalls.InProgress++ })
+			t.Override(&fileSyncFailed, func(int, string, string, error) { actualFileSyncEventCalls.Failed++ })
+			t.Override(&fileSyncSucceeded, func(int, string, string) { actualFileSyncEventCalls.Succeeded++ })
 			t.Override(&sync.WorkingDir, func(string, docker.Config) (string, error) { return "/", nil })
 			test.testBench.cycles = len(test.watchEvents)
 
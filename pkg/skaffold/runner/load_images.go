@@ -27,6 +27,7 @@ import (
 	"github.com/docker/distribution/reference"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildkit"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -35,20 +36,28 @@ import (
 // loadImagesInKindNodes loads artifact images into every node of a kind cluster.
 func (r *SkaffoldRunner) loadImagesInKindNodes(ctx context.Context, out io.Writer, kindCluster string, artifacts []build.Artifact) error {
 	color.Default.Fprintln(out, "Loading images into kind cluster nodes...")
-	return r.loadImages(ctx, out, artifacts, func(tag string) *exec.Cmd {
-		return exec.CommandContext(ctx, "kind", "load", "docker-image", "--name", kindCluster, tag)
+	return r.loadImages(ctx, out, artifacts, func(artifact build.Artifact) *exec.Cmd {
+		if tarball, found := r.ociTarball(artifact.ImageName); found {
+			return exec.CommandContext(ctx, "kind", "load", "image-archive", "--name", kindCluster, tarball)
+		}
+		return exec.CommandContext(ctx, "kind", "load", "docker-image", "--name", kindCluster, artifact.Tag)
 	})
 }
 
 // loadImagesInK3dNodes loads artifact images into every node of a k3s cluster.
 func (r *SkaffoldRunner) loadImagesInK3dNodes(ctx context.Context, out io.Writer, k3dCluster string, artifacts []build.Artifact) error {
 	color.Default.Fprintln(out, "Loading images into k3d cluster nodes...")
-	return r.loadImages(ctx, out, artifacts, func(tag string) *exec.Cmd {
-		return exec.CommandContext(ctx, "k3d", "image", "import", "--cluster", k3dCluster, tag)
+	return r.loadImages(ctx, out, artifacts, func(artifact build.Artifact) *exec.Cmd {
+		// `k3d image import` takes either an image name or the path to a tarball.
+		source := artifact.Tag
+		if tarball, found := r.ociTarball(artifact.ImageName); found {
+			source = tarball
+		}
+		return exec.CommandContext(ctx, "k3d", "image", "import", "--cluster", k3dCluster, source)
 	})
 }
 
-func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifacts []build.Artifact, createCmd func(tag string) *exec.Cmd) error {
+func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifacts []build.Artifact, createCmd func(artifact build.Artifact) *exec.Cmd) error {
 	start := time.Now()
 
 	var knownImages []string
@@ -77,7 +86,7 @@ func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifact
 			continue
 		}
 
-		cmd := createCmd(artifact.Tag)
+		cmd := createCmd(artifact)
 		if output, err := util.RunCmdOut(cmd); err != nil {
 			color.Red.Fprintln(out, "Failed")
 			return fmt.Errorf("unable to load image %q into cluster: %w, %s", artifact.Tag, err, output)
@@ -90,6 +99,22 @@ func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifact
 	return nil
 }
 
+// ociTarball returns the OCI tarball an artifact was exported to by a standalone BuildKit daemon, if any.
+// Those images are never loaded in the local Docker daemon.
+func (r *SkaffoldRunner) ociTarball(imageName string) (string, bool) {
+	b := r.runCtx.Pipeline().Build
+	if b.LocalBuild == nil || b.LocalBuild.BuildKit == nil {
+		return "", false
+	}
+
+	for _, a := range b.Artifacts {
+		if a.ImageName == imageName && a.DockerArtifact != nil {
+			return buildkit.TarballPath(*b.LocalBuild.BuildKit, imageName), true
+		}
+	}
+	return "", false
+}
+
 func findKnownImages(ctx context.Context, cli *kubectl.CLI) ([]string, error) {
 	nodeGetOut, err := cli.RunOut(ctx, "get", "nodes", `-ojsonpath='{@.items[*].status.images[*].names[*]}'`)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -186,6 +187,71 @@ func TestLoadImagesInK3dNodes(t *testing.T) {
 	})
 }
 
+func TestLoadOCITarballs(t *testing.T) {
+	tests := []struct {
+		description string
+		load        func(r *SkaffoldRunner, artifacts []build.Artifact) error
+		commands    util.Command
+	}{
+		{
+			description: "kind",
+			load: func(r *SkaffoldRunner, artifacts []build.Artifact) error {
+				return r.loadImagesInKindNodes(context.Background(), ioutil.Discard, "kind", artifacts)
+			},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				AndRunOut("kind load image-archive --name kind oci/gcr.io_test_app.tar", "").
+				AndRunOut("kind load docker-image --name kind gcr.io/test/jib:tag", ""),
+		},
+		{
+			description: "k3d",
+			load: func(r *SkaffoldRunner, artifacts []build.Artifact) error {
+				return r.loadImagesInK3dNodes(context.Background(), ioutil.Discard, "k3d", artifacts)
+			},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				AndRunOut("k3d image import --cluster k3d oci/gcr.io_test_app.tar", "").
+				AndRunOut("k3d image import --cluster k3d gcr.io/test/jib:tag", ""),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			runCtx := &runcontext.RunContext{
+				Opts: config.SkaffoldOptions{
+					Namespace: "namespace",
+				},
+				KubeContext: "kubecontext",
+				Cfg: latest.Pipeline{
+					Build: latest.BuildConfig{
+						Artifacts: []*latest.Artifact{
+							{ImageName: "gcr.io/test/app", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+							{ImageName: "gcr.io/test/jib", ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}}},
+						},
+						BuildType: latest.BuildType{
+							LocalBuild: &latest.LocalBuild{BuildKit: &latest.BuildKitConfig{OutputDir: "oci"}},
+						},
+					},
+				},
+			}
+			artifacts := []build.Artifact{
+				{ImageName: "gcr.io/test/app", Tag: "gcr.io/test/app:tag@sha256:ebf526c198a14fa138634b9746c50ec38077ec9b3986227e79eb837d26f59dc6"},
+				{ImageName: "gcr.io/test/jib", Tag: "gcr.io/test/jib:tag"},
+			}
+
+			r := &SkaffoldRunner{
+				runCtx:     runCtx,
+				kubectlCLI: kubectl.NewCLI(runCtx, ""),
+				builds:     artifacts,
+			}
+			err := test.load(r, artifacts)
+
+			t.CheckNoError(err)
+		})
+	}
+}
+
 func runImageLoadingTests(t *testing.T, tests []ImageLoadingTest, loadingFunc func(r *SkaffoldRunner, test ImageLoadingTest) error) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -18,9 +18,13 @@ package runner
 
 import (
 	"io"
+	"path/filepath"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
 )
 
 func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []build.Artifact) *kubernetes.LogAggregator {
@@ -33,5 +37,22 @@ func (r *SkaffoldRunner) createLogger(out io.Writer, artifacts []build.Artifact)
 		imageNames = append(imageNames, artifact.Tag)
 	}
 
-	return kubernetes.NewLogAggregator(out, r.kubectlCLI, imageNames, r.podSelector, r.runCtx.GetNamespaces(), r.runCtx.Pipeline().Deploy.Logs)
+	options := kubernetes.LogOptions{Format: r.runCtx.LogFormat()}
+	if r.runCtx.LogFiles() {
+		options.Dir = filepath.Join(constants.DefaultSkaffoldDir, constants.DefaultLogsDir)
+	}
+
+	var logger *kubernetes.LogAggregator
+	if r.runCtx.DeploysToKubernetes() {
+		logger = kubernetes.NewLogAggregator(out, r.kubectlCLI, imageNames, r.podSelector, r.runCtx.GetNamespaces(), r.runCtx.Pipeline().Deploy.Logs, options)
+	} else {
+		logger = kubernetes.NewContainerLogAggregator(out, imageNames, r.runCtx.Pipeline().Deploy.Logs, options)
+	}
+
+	// The docker deployer streams the logs of its containers through the same logger.
+	deploy.WithLogAggregator(r.deployer, logger)
+
+	// The filters can be changed with the control API.
+	server.SetLogsCallback(logger.SetFilter)
+	return logger
 }
@@ -23,17 +23,24 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/attestation"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cluster"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/gcb"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/local"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/sign"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug/ide"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	composedeploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/compose"
+	dockerdeploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
@@ -52,12 +59,32 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	event.LogMetaEvent()
 	kubectlCLI := pkgkubectl.NewCLI(runCtx, "")
 
-	tagger, err := getTagger(runCtx)
+	switch runCtx.LogFormat() {
+	case "", kubernetes.LogFormatText, kubernetes.LogFormatJSON:
+	default:
+		return nil, fmt.Errorf("unsupported log format %q: must be %q or %q", runCtx.LogFormat(), kubernetes.LogFormatText, kubernetes.LogFormatJSON)
+	}
+
+	switch runCtx.DebugMode() {
+	case "", debug.ModeTransform, debug.ModeAttach:
+	default:
+		return nil, fmt.Errorf("unsupported debug mode %q: must be %q or %q", runCtx.DebugMode(), debug.ModeTransform, debug.ModeAttach)
+	}
+	if _, err := debug.ParseProbeTimeout(runCtx.AutoProbeTimeout()); err != nil {
+		return nil, err
+	}
+	switch runCtx.IDEConfig() {
+	case "", ide.VSCode, ide.JetBrains, ide.DAP:
+	default:
+		return nil, fmt.Errorf("unsupported IDE configuration %q: must be %q, %q or %q", runCtx.IDEConfig(), ide.VSCode, ide.JetBrains, ide.DAP)
+	}
+
+	store := build.NewArtifactStore()
+	tagger, err := getTagger(runCtx, store)
 	if err != nil {
 		return nil, fmt.Errorf("creating tagger: %w", err)
 	}
 
-	store := build.NewArtifactStore()
 	builder, imagesAreLocal, err := getBuilder(runCtx, store)
 	if err != nil {
 		return nil, fmt.Errorf("creating builder: %w", err)
@@ -68,9 +95,12 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		tryImportMissing = localBuilder.TryImportMissing()
 	}
 
-	labeller := label.NewLabeller(runCtx.AddSkaffoldLabels(), runCtx.CustomLabels())
+	labeller := label.NewLabeller(runCtx.AddSkaffoldLabels(), runCtx.CustomLabels(), runCtx.DeployState().RunID())
 	tester := getTester(runCtx, imagesAreLocal)
-	syncer := getSyncer(runCtx)
+	syncer, err := getSyncer(runCtx)
+	if err != nil {
+		return nil, fmt.Errorf("creating syncer: %w", err)
+	}
 	var deployer deploy.Deployer
 	deployer, err = getDeployer(runCtx, labeller.Labels())
 	if err != nil {
@@ -96,6 +126,15 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		return nil, fmt.Errorf("initializing cache: %w", err)
 	}
 
+	attester := attestation.NewAttester(runCtx, imagesAreLocal, func(ctx context.Context, a *latest.Artifact) (string, error) {
+		return cache.ArtifactHash(ctx, graph, depLister, runCtx.Mode(), a)
+	})
+
+	signer, err := sign.NewSigner(runCtx, imagesAreLocal)
+	if err != nil {
+		return nil, fmt.Errorf("creating image signer: %w", err)
+	}
+
 	builder, tester, deployer = WithTimings(builder, tester, deployer, runCtx.CacheArtifacts())
 	if runCtx.Notification() {
 		deployer = WithNotification(deployer)
@@ -103,7 +142,7 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 
 	monitor := filemon.NewMonitor()
 	intents, intentChan := setupIntents(runCtx)
-	trigger, err := trigger.NewTrigger(runCtx, intents.IsAnyAutoEnabled)
+	trigger, err := trigger.NewTrigger(runCtx, intents.IsAnyAutoEnabled, monitor)
 	if err != nil {
 		return nil, fmt.Errorf("creating watch trigger: %w", err)
 	}
@@ -125,6 +164,8 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		labeller:       labeller,
 		podSelector:    kubernetes.NewImageList(),
 		cache:          artifactCache,
+		attester:       attester,
+		signer:         signer,
 		runCtx:         runCtx,
 		intents:        intents,
 		imagesAreLocal: imagesAreLocal,
@@ -179,7 +220,7 @@ func getBuilder(runCtx *runcontext.RunContext, store build.ArtifactStore) (build
 			return nil, false, err
 		}
 		builder.ArtifactStore(store)
-		return builder, !builder.PushImages(), nil
+		return builder, builder.ImagesAreLocal(), nil
 
 	case b.GoogleCloudBuild != nil:
 		logrus.Debugln("Using builder: google cloud")
@@ -205,8 +246,17 @@ func getTester(cfg test.Config, imagesAreLocal bool) test.Tester {
 	return test.NewTester(cfg, imagesAreLocal)
 }
 
-func getSyncer(cfg sync.Config) sync.Syncer {
-	return sync.NewSyncer(cfg)
+func getSyncer(runCtx *runcontext.RunContext) (sync.Syncer, error) {
+	if runCtx.DeploysToKubernetes() {
+		return sync.NewSyncer(runCtx), nil
+	}
+
+	// Only the docker deployer is used: sync files into its containers.
+	client, err := docker.NewAPIClient(runCtx)
+	if err != nil {
+		return nil, err
+	}
+	return sync.NewContainerSyncer(client, runCtx.GetWorkingDir()), nil
 }
 
 func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer, error) {
@@ -214,6 +264,22 @@ func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer,
 
 	var deployers deploy.DeployerMux
 
+	if d.ComposeDeploy != nil {
+		deployer, err := composedeploy.NewDeployer(cfg, labels)
+		if err != nil {
+			return nil, err
+		}
+		deployers = append(deployers, deployer)
+	}
+
+	if d.DockerDeploy != nil {
+		deployer, err := dockerdeploy.NewDeployer(cfg, labels)
+		if err != nil {
+			return nil, err
+		}
+		deployers = append(deployers, deployer)
+	}
+
 	if d.HelmDeploy != nil {
 		h, err := helm.NewDeployer(cfg, labels)
 		if err != nil {
@@ -250,7 +316,7 @@ func getDeployer(cfg kubectl.Config, labels map[string]string) (deploy.Deployer,
 	return deployers, nil
 }
 
-func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
+func getTagger(runCtx *runcontext.RunContext, store build.ArtifactStore) (tag.Tagger, error) {
 	t := runCtx.Pipeline().Build.TagPolicy
 
 	switch {
@@ -280,6 +346,11 @@ func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
 
 		return tag.NewCustomTemplateTagger(t.CustomTemplateTagger.Template, components)
 
+	case t.InputDigest != nil:
+		return tag.NewInputDigestTagger(runCtx.Pipeline().Build.Artifacts, func(ctx context.Context, a *latest.Artifact) ([]string, error) {
+			return build.DependenciesForArtifact(ctx, a, runCtx, store)
+		}), nil
+
 	default:
 		return nil, fmt.Errorf("unknown tagger for strategy %+v", t)
 	}
@@ -312,6 +383,9 @@ func CreateComponents(t *latest.CustomTemplateTagger) (map[string]tag.Tagger, er
 		case c.CustomTemplateTagger != nil:
 			return nil, fmt.Errorf("nested customTemplate components are not supported in skaffold (%s)", name)
 
+		case c.InputDigest != nil:
+			return nil, fmt.Errorf("inputDigest components are not supported in customTemplate (%s)", name)
+
 		default:
 			return nil, fmt.Errorf("unknown component for custom template: %s %+v", name, c)
 		}
@@ -22,10 +22,13 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	composedeploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/compose"
+	dockerdeploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kpt"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -45,6 +48,16 @@ func TestGetDeployer(tOuter *testing.T) {
 				description: "no deployer",
 				expected:    deploy.DeployerMux{},
 			},
+			{
+				description: "compose deployer",
+				cfg:         latest.DeployType{ComposeDeploy: &latest.ComposeDeploy{}},
+				expected:    &composedeploy.Deployer{},
+			},
+			{
+				description: "docker deployer",
+				cfg:         latest.DeployType{DockerDeploy: &latest.DockerDeploy{}},
+				expected:    &dockerdeploy.Deployer{},
+			},
 			{
 				description: "helm deployer with 3.0.0 version",
 				cfg:         latest.DeployType{HelmDeploy: &latest.HelmDeploy{}},
@@ -114,6 +127,10 @@ func TestGetDeployer(tOuter *testing.T) {
 					))
 				}
 
+				t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+					return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, nil, false, nil), nil
+				})
+
 				deployer, err := getDeployer(&runcontext.RunContext{
 					Cfg: latest.Pipeline{
 						Deploy: latest.DeployConfig{
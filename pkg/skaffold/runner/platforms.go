@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+var (
+	// For testing
+	getClusterPlatforms = kubernetes.GetClusterPlatforms
+	hostPlatform        = docker.HostPlatform
+)
+
+// targetClusterPlatform makes local builds target the platform of the cluster nodes when
+// it differs from the platform Skaffold runs on, eg. when developing on an arm64 laptop against an amd64 cluster.
+// Artifacts that list their own platforms are left untouched.
+func (r *SkaffoldRunner) targetClusterPlatform(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) {
+	if r.runCtx.Pipeline().Build.LocalBuild == nil {
+		return
+	}
+
+	platforms, err := getClusterPlatforms(ctx)
+	if err != nil {
+		logrus.Debugf("unable to detect the platform of the cluster nodes: %v", err)
+		return
+	}
+	if len(platforms) != 1 {
+		logrus.Debugf("not targeting a cluster platform, nodes run on %v", platforms)
+		return
+	}
+	platform := platforms[0]
+	if platform == hostPlatform() {
+		return
+	}
+
+	for _, a := range artifacts {
+		if len(a.Platforms) > 0 || (a.DockerArtifact == nil && a.JibArtifact == nil) {
+			continue
+		}
+		color.Default.Fprintf(out, "Building %s for the cluster platform %s\n", a.ImageName, platform)
+		a.Platforms = []string{platform}
+	}
+}
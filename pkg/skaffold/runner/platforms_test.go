@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTargetClusterPlatform(t *testing.T) {
+	tests := []struct {
+		description      string
+		build            latest.BuildType
+		clusterPlatforms []string
+		clusterErr       error
+		artifact         *latest.Artifact
+		expected         []string
+	}{
+		{
+			description:      "cluster runs on another platform",
+			build:            latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+			clusterPlatforms: []string{"linux/amd64"},
+			artifact:         &latest.Artifact{ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+			expected:         []string{"linux/amd64"},
+		},
+		{
+			description:      "cluster runs on the host platform",
+			build:            latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+			clusterPlatforms: []string{"linux/arm64"},
+			artifact:         &latest.Artifact{ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+		},
+		{
+			description:      "artifact lists its platforms",
+			build:            latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+			clusterPlatforms: []string{"linux/amd64"},
+			artifact:         &latest.Artifact{Platforms: []string{"linux/s390x"}, ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+			expected:         []string{"linux/s390x"},
+		},
+		{
+			description:      "mixed cluster platforms",
+			build:            latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+			clusterPlatforms: []string{"linux/amd64", "linux/arm64"},
+			artifact:         &latest.Artifact{ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+		},
+		{
+			description:      "builder without platform support",
+			build:            latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+			clusterPlatforms: []string{"linux/amd64"},
+			artifact:         &latest.Artifact{ArtifactType: latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{}}},
+		},
+		{
+			description:      "cluster build",
+			build:            latest.BuildType{Cluster: &latest.ClusterDetails{}},
+			clusterPlatforms: []string{"linux/amd64"},
+			artifact:         &latest.Artifact{ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{}}},
+		},
+		{
+			description: "unable to list nodes",
+			build:       latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+			clusterErr:  errors.New("unreachable"),
+			artifact:    &latest.Artifact{ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&getClusterPlatforms, func(context.Context) ([]string, error) { return test.clusterPlatforms, test.clusterErr })
+			t.Override(&hostPlatform, func() string { return "linux/arm64" })
+			r := &SkaffoldRunner{
+				runCtx: &runcontext.RunContext{
+					Cfg: latest.Pipeline{Build: latest.BuildConfig{BuildType: test.build}},
+				},
+			}
+
+			r.targetClusterPlatform(context.Background(), ioutil.Discard, []*latest.Artifact{test.artifact})
+
+			t.CheckDeepEqual(test.expected, test.artifact.Platforms)
+		})
+	}
+}
@@ -23,7 +23,8 @@ import (
 )
 
 func (r *SkaffoldRunner) createForwarder(out io.Writer) *portforward.ForwarderManager {
-	if !r.runCtx.PortForward() {
+	// The docker deployer publishes the ports itself.
+	if !r.runCtx.PortForward() || !r.runCtx.DeploysToKubernetes() {
 		return nil
 	}
 
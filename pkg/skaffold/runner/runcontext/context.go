@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/state"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 	runnerutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -42,6 +43,8 @@ type RunContext struct {
 	WorkingDir         string
 	InsecureRegistries map[string]bool
 	Cluster            config.Cluster
+
+	deployState *state.Store
 }
 
 func (rc *RunContext) GetKubeContext() string                 { return rc.KubeContext }
@@ -50,24 +53,32 @@ func (rc *RunContext) Pipeline() latest.Pipeline              { return rc.Cfg }
 func (rc *RunContext) GetInsecureRegistries() map[string]bool { return rc.InsecureRegistries }
 func (rc *RunContext) GetWorkingDir() string                  { return rc.WorkingDir }
 func (rc *RunContext) GetCluster() config.Cluster             { return rc.Cluster }
+func (rc *RunContext) DeployState() *state.Store              { return rc.deployState }
 
 func (rc *RunContext) AddSkaffoldLabels() bool                   { return rc.Opts.AddSkaffoldLabels }
 func (rc *RunContext) AutoBuild() bool                           { return rc.Opts.AutoBuild }
 func (rc *RunContext) AutoDeploy() bool                          { return rc.Opts.AutoDeploy }
 func (rc *RunContext) AutoSync() bool                            { return rc.Opts.AutoSync }
+func (rc *RunContext) BuildOutputFile() string                   { return rc.Opts.BuildOutputFile }
 func (rc *RunContext) CacheArtifacts() bool                      { return rc.Opts.CacheArtifacts }
 func (rc *RunContext) CacheFile() string                         { return rc.Opts.CacheFile }
+func (rc *RunContext) RemoteCache() string                       { return rc.Opts.RemoteCache }
 func (rc *RunContext) ConfigurationFile() string                 { return rc.Opts.ConfigurationFile }
 func (rc *RunContext) CustomLabels() []string                    { return rc.Opts.CustomLabels }
 func (rc *RunContext) CustomTag() string                         { return rc.Opts.CustomTag }
 func (rc *RunContext) DefaultRepo() *string                      { return rc.Opts.DefaultRepo.Value() }
 func (rc *RunContext) Mode() config.RunMode                      { return rc.Opts.Mode() }
 func (rc *RunContext) DigestSource() string                      { return rc.Opts.DigestSource }
+func (rc *RunContext) DebugMode() string                         { return rc.Opts.DebugMode }
+func (rc *RunContext) AutoProbeTimeout() string                  { return rc.Opts.AutoProbeTimeout }
+func (rc *RunContext) IDEConfig() string                         { return rc.Opts.IDEConfig }
 func (rc *RunContext) DryRun() bool                              { return rc.Opts.DryRun }
 func (rc *RunContext) ForceDeploy() bool                         { return rc.Opts.Force }
 func (rc *RunContext) GetKubeConfig() string                     { return rc.Opts.KubeConfig }
 func (rc *RunContext) GetKubeNamespace() string                  { return rc.Opts.Namespace }
 func (rc *RunContext) GlobalConfig() string                      { return rc.Opts.GlobalConfig }
+func (rc *RunContext) LogFiles() bool                            { return rc.Opts.LogFiles }
+func (rc *RunContext) LogFormat() string                         { return rc.Opts.LogFormat }
 func (rc *RunContext) MinikubeProfile() string                   { return rc.Opts.MinikubeProfile }
 func (rc *RunContext) Muted() config.Muted                       { return rc.Opts.Muted }
 func (rc *RunContext) NoPruneChildren() bool                     { return rc.Opts.NoPruneChildren }
@@ -85,12 +96,17 @@ func (rc *RunContext) WaitForDeletions() config.WaitForDeletions { return rc.Opt
 func (rc *RunContext) WatchPollInterval() int                    { return rc.Opts.WatchPollInterval }
 
 func GetRunContext(opts config.SkaffoldOptions, cfg latest.Pipeline) (*RunContext, error) {
+	var kubeContext string
 	kubeConfig, err := kubectx.CurrentConfig()
-	if err != nil {
+	switch {
+	case err == nil:
+		kubeContext = kubeConfig.CurrentContext
+		logrus.Infof("Using kubectl context: %s", kubeContext)
+	case deploysToKubernetes(cfg):
 		return nil, fmt.Errorf("getting current cluster context: %w", err)
+	default:
+		logrus.Debugf("Ignoring the kubectl context since only the docker deployer is used: %v", err)
 	}
-	kubeContext := kubeConfig.CurrentContext
-	logrus.Infof("Using kubectl context: %s", kubeContext)
 
 	// TODO(dgageot): this should be the folder containing skaffold.yaml. Should also be moved elsewhere.
 	cwd, err := os.Getwd()
@@ -122,6 +138,10 @@ func GetRunContext(opts config.SkaffoldOptions, cfg latest.Pipeline) (*RunContex
 	if err != nil {
 		return nil, fmt.Errorf("getting cluster: %w", err)
 	}
+	if !deploysToKubernetes(cfg) {
+		// Images are run by the local Docker daemon.
+		cluster = config.Cluster{Local: true}
+	}
 
 	return &RunContext{
 		Opts:               opts,
@@ -131,9 +151,20 @@ func GetRunContext(opts config.SkaffoldOptions, cfg latest.Pipeline) (*RunContex
 		Namespaces:         namespaces,
 		InsecureRegistries: insecureRegistries,
 		Cluster:            cluster,
+		deployState:        state.Load(kubeContext, opts.ConfigurationFile),
 	}, nil
 }
 
+// DeploysToKubernetes returns false when only the docker deployer is configured.
+func (rc *RunContext) DeploysToKubernetes() bool {
+	return deploysToKubernetes(rc.Cfg)
+}
+
+func deploysToKubernetes(cfg latest.Pipeline) bool {
+	d := cfg.Deploy.DeployType
+	return d.DockerDeploy == nil || d.ComposeDeploy != nil || d.HelmDeploy != nil || d.KptDeploy != nil || d.KubectlDeploy != nil || d.KustomizeDeploy != nil
+}
+
 func (rc *RunContext) UpdateNamespaces(ns []string) {
 	if len(ns) == 0 {
 		return
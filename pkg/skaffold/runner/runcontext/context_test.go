@@ -19,6 +19,7 @@ package runcontext
 import (
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -90,3 +91,38 @@ func TestRunContext_UpdateNamespaces(t *testing.T) {
 		})
 	}
 }
+
+func TestRunContext_DeploysToKubernetes(t *testing.T) {
+	tests := []struct {
+		description string
+		deploy      latest.DeployType
+		expected    bool
+	}{
+		{
+			description: "kubectl",
+			deploy:      latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}},
+			expected:    true,
+		},
+		{
+			description: "docker only",
+			deploy:      latest.DeployType{DockerDeploy: &latest.DockerDeploy{}},
+			expected:    false,
+		},
+		{
+			description: "docker and helm",
+			deploy:      latest.DeployType{DockerDeploy: &latest.DockerDeploy{}, HelmDeploy: &latest.HelmDeploy{}},
+			expected:    true,
+		},
+		{
+			description: "no deployer",
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := &RunContext{Cfg: latest.Pipeline{Deploy: latest.DeployConfig{DeployType: test.deploy}}}
+
+			t.CheckDeepEqual(test.expected, runCtx.DeploysToKubernetes())
+		})
+	}
+}
@@ -21,8 +21,11 @@ import (
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/attestation"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/sign"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	debugging "github.com/GoogleContainerTools/skaffold/pkg/skaffold/debug"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/status"
@@ -66,11 +69,15 @@ type SkaffoldRunner struct {
 
 	kubectlCLI    *kubectl.CLI
 	cache         cache.Cache
+	attester      *attestation.Attester
+	signer        *sign.Signer
 	changeSet     changeSet
 	runCtx        *runcontext.RunContext
 	labeller      *label.DefaultLabeller
 	builds        []build.Artifact
 	artifactStore build.ArtifactStore
+	reverseSyncer *sync.ReverseSyncer
+	debugAttacher *debugging.Attacher
 	// podSelector is used to determine relevant pods for logging and portForwarding
 	podSelector *kubernetes.ImageList
 
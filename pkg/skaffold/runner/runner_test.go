@@ -232,6 +232,7 @@ func createRunner(t *testutil.T, testBench *TestBench, monitor filemon.Monitor)
 	}
 	runner, err := NewForConfig(runCtx)
 	t.CheckNoError(err)
+	t.Override(&getClusterPlatforms, func(context.Context) ([]string, error) { return nil, nil })
 
 	runner.builder = testBench
 	runner.syncer = testBench
@@ -115,3 +115,7 @@ func (w withTimings) Prune(ctx context.Context, out io.Writer) error {
 	logrus.Infoln("Image prune complete in", time.Since(start))
 	return nil
 }
+
+func (w withTimings) SetLogAggregator(l deploy.LogAggregator) {
+	deploy.WithLogAggregator(w.Deployer, l)
+}
@@ -46,6 +46,7 @@ func Set(c *latest.SkaffoldConfig) error {
 	setDefaultTagger(c)
 	setDefaultKustomizePath(c)
 	setDefaultKubectlManifests(c)
+	setDefaultComposePaths(c)
 	setDefaultLogsConfig(c)
 
 	for _, a := range c.Build.Artifacts {
@@ -190,6 +191,12 @@ func setDefaultKubectlManifests(c *latest.SkaffoldConfig) {
 	}
 }
 
+func setDefaultComposePaths(c *latest.SkaffoldConfig) {
+	if c.Deploy.ComposeDeploy != nil && len(c.Deploy.ComposeDeploy.Paths) == 0 {
+		c.Deploy.ComposeDeploy.Paths = constants.DefaultComposePaths
+	}
+}
+
 func setDefaultLogsConfig(c *latest.SkaffoldConfig) {
 	if c.Deploy.Logs.Prefix == "" {
 		c.Deploy.Logs.Prefix = "container"
@@ -44,6 +44,9 @@ type SkaffoldConfig struct {
 	// Metadata holds additional information about the config.
 	Metadata Metadata `yaml:"metadata,omitempty"`
 
+	// Dependencies describes a list of other required configs for the current config.
+	Dependencies []ConfigDependency `yaml:"requires,omitempty"`
+
 	// Pipeline defines the Build/Test/Deploy phases.
 	Pipeline `yaml:",inline"`
 
@@ -54,9 +57,30 @@ type SkaffoldConfig struct {
 // Metadata holds an optional name of the project.
 type Metadata struct {
 	// Name is an identifier for the project.
+	// It is used to select configs with the `--module` flag in multi-config projects.
 	Name string `yaml:"name,omitempty"`
 }
 
+// ConfigDependency describes a dependency on another skaffold configuration.
+type ConfigDependency struct {
+	// Path describes the path to the file containing the required config, or to the directory containing a `skaffold.yaml` file.
+	// Relative paths are resolved from the directory of the current config.
+	Path string `yaml:"path" yamltags:"required"`
+
+	// ActiveProfiles describes the list of profiles to activate when resolving the required config.
+	ActiveProfiles []ProfileDependency `yaml:"activeProfiles,omitempty"`
+}
+
+// ProfileDependency describes a mapping from referenced config profiles to the current config profiles.
+// If the current config is activated with a profile in this mapping then the dependency configs are also activated with the corresponding mapped profile.
+type ProfileDependency struct {
+	// Name describes name of the profile to activate in the dependency config. It should exist in the dependency config.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// ActivatedBy describes a list of profiles in the current config that when activated will also activate the named profile in the dependency config. If empty then the named profile is always activated.
+	ActivatedBy []string `yaml:"activatedBy,omitempty"`
+}
+
 // Pipeline describes a Skaffold pipeline.
 type Pipeline struct {
 	// Build describes how images are built.
@@ -115,9 +139,44 @@ type BuildConfig struct {
 	// If not specified, it defaults to `gitCommit: {variant: Tags}`.
 	TagPolicy TagPolicy `yaml:"tagPolicy,omitempty"`
 
+	// Attestations *alpha* describes the documents generated for every built image,
+	// like a software bill of materials or the build provenance.
+	Attestations *Attestations `yaml:"attestations,omitempty"`
+
+	// Sign *alpha* signs the pushed images with cosign-compatible signatures.
+	// Images that are not pushed to a registry are not signed.
+	Sign *SignConfig `yaml:"sign,omitempty"`
+
 	BuildType `yaml:",inline"`
 }
 
+// SignConfig describes how the pushed images are signed.
+type SignConfig struct {
+	// Key is the path to the ECDSA private key file, in PEM format.
+	// Keys generated and encrypted by `cosign generate-key-pair` are decrypted
+	// with the password from the `COSIGN_PASSWORD` environment variable.
+	Key string `yaml:"key" yamltags:"required"`
+
+	// Annotations are key-value pairs added to the signed payload.
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// Attestations describes the documents generated for the built images.
+// They are written next to the `--file-output` build result.
+type Attestations struct {
+	// SBOM is the format of the software bill of materials generated for each image.
+	// Valid values are `spdx` and `cyclonedx`.
+	// If empty, no SBOM is generated.
+	SBOM string `yaml:"sbom,omitempty"`
+
+	// Provenance generates an in-toto statement with the SLSA provenance of each image.
+	Provenance bool `yaml:"provenance,omitempty"`
+
+	// Push pushes the documents to the registry, next to the image they describe.
+	// Only applies to images pushed to a registry.
+	Push bool `yaml:"push,omitempty"`
+}
+
 // TagPolicy contains all the configuration for the tagging step.
 type TagPolicy struct {
 	// GitTagger *beta* tags images with the git tag or commit of the artifact's workspace.
@@ -134,11 +193,18 @@ type TagPolicy struct {
 
 	// CustomTemplateTagger *beta* tags images with a configurable template string *composed of other taggers*.
 	CustomTemplateTagger *CustomTemplateTagger `yaml:"customTemplate,omitempty" yamltags:"oneOf=tag"`
+
+	// InputDigest *alpha* tags images with a digest of their inputs.
+	InputDigest *InputDigest `yaml:"inputDigest,omitempty" yamltags:"oneOf=tag"`
 }
 
 // ShaTagger *beta* tags images with their sha256 digest.
 type ShaTagger struct{}
 
+// InputDigest *alpha* tags images with a digest of the files they depend on, of their builder configuration
+// and of the images they require. The tag only changes when the inputs of the artifact change.
+type InputDigest struct{}
+
 // GitTagger *beta* tags images with the git tag or commit of the artifact's workspace.
 type GitTagger struct {
 	// Variant determines the behavior of the git tagger. Valid variants are:
@@ -233,11 +299,36 @@ type LocalBuild struct {
 	// UseBuildkit use BuildKit to build Docker images.
 	UseBuildkit bool `yaml:"useBuildkit,omitempty"`
 
+	// BuildKit *alpha* builds Docker artifacts with a standalone BuildKit daemon instead of the Docker daemon.
+	BuildKit *BuildKitConfig `yaml:"buildkit,omitempty"`
+
 	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
 	// Defaults to `1`.
 	Concurrency *int `yaml:"concurrency,omitempty"`
 }
 
+// BuildKitConfig *alpha* describes how to build Docker artifacts with a standalone BuildKit daemon.
+// Builds go through `buildctl` and don't need a Docker daemon.
+type BuildKitConfig struct {
+	// Address is the address of the BuildKit daemon.
+	// For example: `unix:///run/user/1000/buildkit/buildkitd.sock` or `tcp://buildkitd:1234`.
+	// Defaults to the `BUILDKIT_HOST` environment variable, or to `buildctl`'s default.
+	Address string `yaml:"address,omitempty"`
+
+	// CacheRepo is a registry repository the build cache is imported from and exported to.
+	// The cache of each artifact is stored with a tag derived from its image name.
+	// For example: `gcr.io/k8s-skaffold/cache`.
+	CacheRepo string `yaml:"cacheRepo,omitempty"`
+
+	// CacheMode is the mode of the exported cache: `min` only exports the layers of the final image,
+	// `max` exports the layers of all the intermediate steps. Defaults to `min`.
+	CacheMode string `yaml:"cacheMode,omitempty"`
+
+	// OutputDir is the directory where images are written as OCI tarballs when they are not pushed.
+	// Defaults to `.skaffold/oci`.
+	OutputDir string `yaml:"outputDir,omitempty"`
+}
+
 // GoogleCloudBuild *beta* describes how to do a remote build on
 // [Google Cloud Build](https://cloud.google.com/cloud-build/docs/).
 // Docker and Jib artifacts can be built on Cloud Build. The `projectId` needs
@@ -444,18 +535,71 @@ type DeployConfig struct {
 	// StatusCheckDeadlineSeconds *beta* is the deadline for deployments to stabilize in seconds.
 	StatusCheckDeadlineSeconds int `yaml:"statusCheckDeadlineSeconds,omitempty"`
 
+	// HealthRules *alpha* describes how to check the status of custom resources.
+	// Deployed resources of the listed kinds are waited on like deployments.
+	HealthRules []HealthRule `yaml:"healthRules,omitempty"`
+
 	// KubeContext is the Kubernetes context that Skaffold should deploy to.
 	// For example: `minikube`.
 	KubeContext string `yaml:"kubeContext,omitempty"`
 
 	// Logs configures how container logs are printed as a result of a deployment.
 	Logs LogsConfig `yaml:"logs,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after every deploy.
+	LifecycleHooks DeployHooks `yaml:"hooks,omitempty"`
+}
+
+// HealthRule describes when the custom resources of a given kind are ready or have failed.
+type HealthRule struct {
+	// Group is the API group of the resource.
+	// For example: `cert-manager.io`.
+	Group string `yaml:"group,omitempty"`
+
+	// Version is the API version of the resource.
+	// For example: `v1`.
+	Version string `yaml:"version,omitempty" yamltags:"required"`
+
+	// Kind is the kind of the resource.
+	// For example: `Certificate`.
+	Kind string `yaml:"kind,omitempty" yamltags:"required"`
+
+	// Ready describes when the resource is ready.
+	Ready HealthCondition `yaml:"ready,omitempty" yamltags:"required"`
+
+	// Failed describes when the resource has failed.
+	Failed *HealthCondition `yaml:"failed,omitempty"`
+}
+
+// HealthCondition matches either a `.status.conditions` entry or the result of a JSONPath expression.
+type HealthCondition struct {
+	// Condition is the type of the `.status.conditions` entry to match.
+	// For example: `Ready`.
+	Condition string `yaml:"condition,omitempty" yamltags:"oneOf=healthCondition"`
+
+	// Status is the status of the condition to match.
+	// Defaults to `True`.
+	Status string `yaml:"status,omitempty"`
+
+	// JSONPath is a JSONPath expression evaluated against the resource, in the `kubectl get -o jsonpath` syntax.
+	// For example: `{.status.phase}`.
+	JSONPath string `yaml:"jsonPath,omitempty" yamltags:"oneOf=healthCondition"`
+
+	// Value is the result of the JSONPath expression to match.
+	// If empty, any non-empty result matches.
+	Value string `yaml:"value,omitempty"`
 }
 
 // DeployType contains the specific implementation and parameters needed
 // for the deploy step. All three deployer types can be used at the same
 // time for hybrid workflows.
 type DeployType struct {
+	// ComposeDeploy *alpha* translates Docker Compose files to Kubernetes objects and applies them with `kubectl`.
+	ComposeDeploy *ComposeDeploy `yaml:"compose,omitempty"`
+
+	// DockerDeploy *alpha* runs the built images as containers on the local Docker daemon, without Kubernetes.
+	DockerDeploy *DockerDeploy `yaml:"docker,omitempty"`
+
 	// HelmDeploy *beta* uses the `helm` CLI to apply the charts to the cluster.
 	HelmDeploy *HelmDeploy `yaml:"helm,omitempty"`
 
@@ -470,6 +614,58 @@ type DeployType struct {
 	KustomizeDeploy *KustomizeDeploy `yaml:"kustomize,omitempty"`
 }
 
+// ComposeDeploy *alpha* translates Docker Compose files to Kubernetes objects and applies them with `kubectl`.
+// Services with a `build` section are added to the artifacts to build.
+type ComposeDeploy struct {
+	// Paths lists the Docker Compose files.
+	// Defaults to `["docker-compose.yaml"]`.
+	Paths []string `yaml:"paths,omitempty"`
+
+	// Flags are additional flags passed to `kubectl`.
+	Flags KubectlFlags `yaml:"flags,omitempty"`
+
+	// DefaultNamespace is the default namespace passed to kubectl on deployment if no other override is given.
+	DefaultNamespace *string `yaml:"defaultNamespace,omitempty"`
+}
+
+// DockerDeploy *alpha* runs the built images as containers on the local Docker daemon, without Kubernetes.
+type DockerDeploy struct {
+	// Network is the Docker network the containers are connected to.
+	// The containers can reach each other by name on this network.
+	// Defaults to `skaffold-network`.
+	Network string `yaml:"network,omitempty"`
+
+	// Containers lists the containers to run.
+	// Defaults to one container per artifact, named after the last part of the image name.
+	Containers []DockerContainer `yaml:"containers,omitempty"`
+}
+
+// DockerContainer describes a container run by the docker deployer.
+type DockerContainer struct {
+	// Name is the name of the container, which is also its hostname on the network.
+	// Defaults to the last part of the image name.
+	Name string `yaml:"name,omitempty"`
+
+	// Image is the image to run. Artifact images are replaced with the tags that were built.
+	Image string `yaml:"image" yamltags:"required"`
+
+	// Args overrides the arguments of the image's entrypoint.
+	Args []string `yaml:"args,omitempty"`
+
+	// Env lists environment variables set in the container.
+	// For example: `{"PORT": "8080"}`.
+	Env map[string]string `yaml:"env,omitempty"`
+
+	// Ports lists the ports published on the host, with the same syntax as `docker run -p`.
+	// For example: `["8080:8080"]`.
+	Ports []string `yaml:"ports,omitempty"`
+
+	// Volumes lists the volumes mounted in the container, with the same syntax as `docker run -v`.
+	// Relative paths that start with `.` are resolved from the folder of `skaffold.yaml`.
+	// For example: `["./data:/data"]`.
+	Volumes []string `yaml:"volumes,omitempty"`
+}
+
 // KubectlDeploy *beta* uses a client side `kubectl apply` to deploy manifests.
 // You'll need a `kubectl` CLI version installed that's compatible with your cluster.
 type KubectlDeploy struct {
@@ -749,6 +945,47 @@ type LogsConfig struct {
 	// `none`: don't add a prefix.
 	// Defaults to `auto`.
 	Prefix string `yaml:"prefix,omitempty"`
+
+	// Include only prints the logs of the containers that match one of these selectors.
+	Include []LogSelector `yaml:"include,omitempty"`
+
+	// Exclude doesn't print the logs of the containers that match one of these selectors.
+	Exclude []LogSelector `yaml:"exclude,omitempty"`
+
+	// Filter only prints the log lines that match this regular expression.
+	Filter string `yaml:"filter,omitempty"`
+
+	// Level only prints the log lines with at least this level: `trace`, `debug`, `info`, `warn`, `error` or `fatal`.
+	// The level is read from the `level`, `severity` or `lvl` field of logs in JSON format.
+	// Lines without a level are always printed.
+	Level string `yaml:"level,omitempty"`
+
+	// Highlight colors the parts of the log lines that match patterns.
+	Highlight []LogHighlight `yaml:"highlight,omitempty"`
+}
+
+// LogSelector selects containers. All the fields that are set must match.
+type LogSelector struct {
+	// Image is a glob pattern matched against the image of the container, without its tag.
+	// For example: `gcr.io/k8s-skaffold/leeroy-*`.
+	Image string `yaml:"image,omitempty"`
+
+	// Container is a glob pattern matched against the name of the container.
+	Container string `yaml:"container,omitempty"`
+
+	// LabelSelector is a label selector matched against the labels of the pod.
+	// For example: `app=web,tier!=cache`.
+	LabelSelector string `yaml:"labelSelector,omitempty"`
+}
+
+// LogHighlight colors the parts of the log lines that match a pattern.
+type LogHighlight struct {
+	// Pattern is a regular expression.
+	Pattern string `yaml:"pattern" yamltags:"required"`
+
+	// Color of the matches: `red`, `green`, `yellow`, `blue`, `purple`, `cyan` or `white`.
+	// Defaults to `yellow`.
+	Color string `yaml:"color,omitempty"`
 }
 
 // Artifact are the items that need to be built, along with the context in which
@@ -773,6 +1010,47 @@ type Artifact struct {
 
 	// Dependencies describes build artifacts that this artifact depends on.
 	Dependencies []*ArtifactDependency `yaml:"requires,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after each build of the target artifact.
+	LifecycleHooks BuildHooks `yaml:"hooks,omitempty"`
+
+	// Platforms is the list of target platforms to build the image for.
+	// When more than one platform is listed, the images are pushed as a manifest list.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// Debug describes how `skaffold debug` configures the containers running this artifact.
+	// It takes precedence over the detection of the artifact's runtime.
+	Debug *DebugRecipe `yaml:"debug,omitempty"`
+}
+
+// DebugRecipe describes how to configure a container for debugging
+// when its runtime can't be recognized, for example when it is started by a custom launcher script.
+type DebugRecipe struct {
+	// Runtime is the name of the runtime reported to IDEs.
+	// For example: `elixir`.
+	Runtime string `yaml:"runtime,omitempty" yamltags:"required"`
+
+	// Env are environment variables to set in the container.
+	// Values are templates, as with `command`.
+	Env map[string]string `yaml:"env,omitempty"`
+
+	// Command rewrites the command-line of the container.
+	// Each element is a template where `{{.Ports.<name>}}` is the port allocated for `<name>`
+	// and `{{.Command}}` is the original command-line.
+	// An element that is only `{{.Command}}` is replaced by the original command-line's arguments.
+	// For example: `["dlv-launcher", "--port={{.Ports.debug}}", "--", "{{.Command}}"]`.
+	// Defaults to the original command-line.
+	Command []string `yaml:"command,omitempty"`
+
+	// Ports are the ports to expose on the container, by name.
+	// A different port is allocated if the port is already used in the pod.
+	// For example: `{"debug": 4000}`.
+	Ports map[string]int32 `yaml:"ports,omitempty"`
+
+	// Image is an optional image run as an init container to install debugging support files.
+	// The files it copies into `/dbg` are available to the container under `/dbg`.
+	Image string `yaml:"image,omitempty"`
 }
 
 // Sync *beta* specifies what files to sync into the container.
@@ -793,6 +1071,50 @@ type Sync struct {
 	// Auto delegates discovery of sync rules to the build system.
 	// Only available for jib and buildpacks.
 	Auto *bool `yaml:"auto,omitempty" yamltags:"oneOf=sync"`
+
+	// Transport *alpha* is how files are copied into the containers.
+	// `tar` pipes a tar archive into `tar` running in the containers.
+	// `helper` streams the files to a small helper binary that Skaffold adds to the pods, for images without `tar`.
+	// `auto` adds the helper to the pods, but only uses it when `tar` is not available in a container.
+	// Defaults to `auto`.
+	Transport string `yaml:"transport,omitempty"`
+
+	// Reverse *alpha* lists rules to copy the files generated in the containers back to the local workspace.
+	Reverse []*ReverseSyncRule `yaml:"reverse,omitempty"`
+
+	// PostSync *alpha* is an action applied to the containers after each file sync,
+	// so that the application picks up the synced files.
+	PostSync *PostSyncAction `yaml:"postSync,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle hooks that are executed before and after each file sync action on the target artifact's containers.
+	LifecycleHooks SyncHooks `yaml:"hooks,omitempty"`
+}
+
+// ReverseSyncRule specifies which files to copy from the containers to the local workspace.
+type ReverseSyncRule struct {
+	// Src is a glob pattern to match absolute paths in the container against.
+	// For example: `"/app/gen/**/*.go"`.
+	Src string `yaml:"src,omitempty" yamltags:"required"`
+
+	// Dest is the local folder, relative to the artifact's context, where the files are copied to.
+	// Files keep their path relative to the longest folder of `src` without wildcards.
+	// For example: `"gen/"`
+	Dest string `yaml:"dest,omitempty" yamltags:"required"`
+}
+
+// PostSyncAction describes how containers are notified of synced files.
+type PostSyncAction struct {
+	// Signal is sent to the process running as PID 1 in the containers.
+	// For example: `HUP`.
+	Signal string `yaml:"signal,omitempty" yamltags:"oneOf=postSync"`
+
+	// Command is run in the containers.
+	// For example: `["nginx", "-s", "reload"]`.
+	Command []string `yaml:"command,omitempty" yamltags:"oneOf=postSync"`
+
+	// Restart restarts the containers' process.
+	// Skaffold wraps the containers' entrypoint with a small supervisor that restarts the process on demand.
+	Restart bool `yaml:"restart,omitempty" yamltags:"oneOf=postSync"`
 }
 
 // SyncRule specifies which local files to sync to remote folders.
@@ -812,6 +1134,84 @@ type SyncRule struct {
 	Strip string `yaml:"strip,omitempty"`
 }
 
+// BuildHooks describes the list of lifecycle hooks to execute before and after each artifact build step.
+type BuildHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact build step.
+	PreHooks []HostHook `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact build step.
+	PostHooks []HostHook `yaml:"after,omitempty"`
+}
+
+// SyncHooks describes the list of lifecycle hooks to execute before and after each artifact sync step.
+type SyncHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each artifact sync step.
+	PreHooks []SyncHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each artifact sync step.
+	PostHooks []SyncHookItem `yaml:"after,omitempty"`
+}
+
+// SyncHookItem describes a single lifecycle hook to execute before or after each artifact sync step.
+type SyncHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=sync_hook"`
+
+	// ContainerHook describes a single lifecycle hook to run on the containers the files are synced to.
+	ContainerHook *ContainerHook `yaml:"container,omitempty" yamltags:"oneOf=sync_hook"`
+}
+
+// DeployHooks describes the list of lifecycle hooks to execute before and after each deployer step.
+type DeployHooks struct {
+	// PreHooks describes the list of lifecycle hooks to execute *before* each deployer step. Container hooks will only run if the container exists from a previous deployment step (for instance the successive iterations of a dev-loop during `skaffold dev`).
+	PreHooks []DeployHookItem `yaml:"before,omitempty"`
+
+	// PostHooks describes the list of lifecycle hooks to execute *after* each deployer step.
+	PostHooks []DeployHookItem `yaml:"after,omitempty"`
+}
+
+// DeployHookItem describes a single lifecycle hook to execute before or after each deployer step.
+type DeployHookItem struct {
+	// HostHook describes a single lifecycle hook to run on the host machine.
+	HostHook *HostHook `yaml:"host,omitempty" yamltags:"oneOf=deploy_hook"`
+
+	// ContainerHook describes a single lifecycle hook to run on a container.
+	ContainerHook *NamedContainerHook `yaml:"container,omitempty" yamltags:"oneOf=deploy_hook"`
+}
+
+// HostHook describes a lifecycle hook definition to execute on the host machine.
+type HostHook struct {
+	// Command is the command to execute.
+	// For example: `["sh", "-c", "make proto"]`.
+	Command []string `yaml:"command" yamltags:"required"`
+
+	// OS is an optional slice of operating system names. If the host machine OS is different, then it skips execution.
+	// For example: `["linux", "darwin"]`.
+	OS []string `yaml:"os,omitempty"`
+}
+
+// ContainerHook describes a lifecycle hook definition to execute on a container.
+// The container name is inferred from the scope in which this hook is defined.
+type ContainerHook struct {
+	// Command is the command to execute.
+	// For example: `["kill", "-HUP", "1"]`.
+	Command []string `yaml:"command" yamltags:"required"`
+}
+
+// NamedContainerHook describes a lifecycle hook definition to execute on a named container.
+type NamedContainerHook struct {
+	// ContainerHook describes a lifecycle hook definition to execute on a container.
+	ContainerHook `yaml:",inline"`
+
+	// PodName is the name of the pod to execute the command in. Supports glob patterns.
+	// For example: `"web-*"`.
+	PodName string `yaml:"podName" yamltags:"required"`
+
+	// ContainerName is the name of the container to execute the command in. Supports glob patterns.
+	// Defaults to all the containers of the matching pods.
+	ContainerName string `yaml:"containerName,omitempty"`
+}
+
 // Profile is used to override any `build`, `test` or `deploy` configuration.
 type Profile struct {
 	// Name is a unique profile name.
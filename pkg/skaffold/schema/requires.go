@@ -0,0 +1,421 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/compose"
+	cfg "github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/defaults"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	skutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const defaultConfigFile = "skaffold.yaml"
+
+// configResolver loads required configs and merges their pipelines.
+type configResolver struct {
+	opts    cfg.SkaffoldOptions
+	merged  latest.Pipeline
+	modules map[string]bool
+
+	// visiting is the set of absolute config file paths on the current path of required configs, used to detect cycles.
+	visiting map[string]bool
+	// loaded maps the absolute path of each loaded config file to the profiles it was activated with.
+	loaded map[string]string
+}
+
+// ResolveDependencies loads the configs required by `c`, recursively, and merges their pipelines into `c`.
+// The relative paths of a required config are rebased on the directory of its config file.
+// If modules are selected with `--module`, only the pipelines of the matching configs and
+// of the configs they require are kept.
+func ResolveDependencies(c *latest.SkaffoldConfig, opts cfg.SkaffoldOptions) error {
+	if len(c.Dependencies) == 0 && len(opts.Modules) == 0 {
+		return nil
+	}
+	if len(c.Dependencies) > 0 && (opts.ConfigurationFile == "-" || skutil.IsURL(opts.ConfigurationFile)) {
+		return fmt.Errorf("required configs are not supported for config %q: only local config files can declare `requires`", opts.ConfigurationFile)
+	}
+
+	r := &configResolver{
+		opts:     opts,
+		modules:  map[string]bool{},
+		visiting: map[string]bool{},
+		loaded:   map[string]string{},
+	}
+	for _, m := range opts.Modules {
+		r.modules[m] = false
+	}
+	r.merged.Build = latest.BuildConfig{
+		TagPolicy:          c.Build.TagPolicy,
+		BuildType:          c.Build.BuildType,
+		InsecureRegistries: c.Build.InsecureRegistries,
+		Attestations:       c.Build.Attestations,
+		Sign:               c.Build.Sign,
+	}
+	r.merged.Deploy = latest.DeployConfig{
+		StatusCheckDeadlineSeconds: c.Deploy.StatusCheckDeadlineSeconds,
+		KubeContext:                c.Deploy.KubeContext,
+		Logs:                       c.Deploy.Logs,
+		HealthRules:                c.Deploy.HealthRules,
+	}
+
+	profiles, _, err := activatedProfiles(c.Profiles, opts)
+	if err != nil {
+		return fmt.Errorf("finding auto-activated profiles: %w", err)
+	}
+	file := opts.ConfigurationFile
+	key, err := filepath.Abs(file)
+	if err != nil {
+		return err
+	}
+	r.visiting[key] = true
+	if err := r.resolve(c, file, profiles, false); err != nil {
+		return err
+	}
+
+	var missing []string
+	for m, found := range r.modules {
+		if !found {
+			missing = append(missing, m)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("did not find any configs matching selection %v", missing)
+	}
+
+	c.Pipeline = r.merged
+	return nil
+}
+
+// resolve walks through the configs required by `c` before adding the pipeline of `c` itself.
+func (r *configResolver) resolve(c *latest.SkaffoldConfig, file string, profiles []string, selected bool) error {
+	if _, found := r.modules[c.Metadata.Name]; found {
+		r.modules[c.Metadata.Name] = true
+		selected = true
+	}
+
+	for _, d := range c.Dependencies {
+		path := d.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(file), path)
+		}
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			path = filepath.Join(path, defaultConfigFile)
+		}
+
+		key, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+
+		depProfiles := dependencyProfiles(d, profiles)
+		if r.visiting[key] {
+			return fmt.Errorf("cycle detected in required configs: %q is required by %q", path, file)
+		}
+		if p, found := r.loaded[key]; found {
+			if p != strings.Join(depProfiles, ",") {
+				return fmt.Errorf("config %q is required multiple times with different profiles", path)
+			}
+			continue
+		}
+		r.loaded[key] = strings.Join(depProfiles, ",")
+
+		dep, depProfiles, err := r.load(path, depProfiles)
+		if err != nil {
+			return fmt.Errorf("loading config %q required by %q: %w", path, file, err)
+		}
+
+		r.visiting[key] = true
+		err = r.resolve(dep, path, depProfiles, selected)
+		r.visiting[key] = false
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(r.modules) > 0 && !selected {
+		logrus.Debugf("Skipping config %q not matching module selection", file)
+		return nil
+	}
+	return r.add(c.Pipeline, file)
+}
+
+// load parses a required config, activates its profiles, sets its default values and rebases its relative paths.
+// It returns the config along with all its active profiles.
+func (r *configResolver) load(file string, profiles []string) (*latest.SkaffoldConfig, []string, error) {
+	parsed, err := ParseConfigAndUpgrade(file, latest.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := parsed.(*latest.SkaffoldConfig)
+
+	opts := r.opts
+	opts.Profiles = profiles
+	active, _, err := activatedProfiles(c.Profiles, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("finding auto-activated profiles: %w", err)
+	}
+	if err := ApplyProfiles(c, opts); err != nil {
+		return nil, nil, fmt.Errorf("applying profiles: %w", err)
+	}
+	if err := compose.AddArtifacts(&c.Pipeline, filepath.Dir(file)); err != nil {
+		return nil, nil, fmt.Errorf("reading compose files: %w", err)
+	}
+	if err := defaults.Set(c); err != nil {
+		return nil, nil, fmt.Errorf("setting default values: %w", err)
+	}
+
+	rebasePaths(&c.Pipeline, filepath.Dir(file))
+	return c, active, nil
+}
+
+// dependencyProfiles returns the profiles to activate in a required config, given the active profiles of the current config.
+func dependencyProfiles(d latest.ConfigDependency, active []string) []string {
+	var profiles []string
+	for _, p := range d.ActiveProfiles {
+		if len(p.ActivatedBy) == 0 {
+			profiles = append(profiles, p.Name)
+			continue
+		}
+		for _, a := range p.ActivatedBy {
+			if skutil.StrSliceContains(active, a) {
+				profiles = append(profiles, p.Name)
+				break
+			}
+		}
+	}
+	return profiles
+}
+
+// add merges a pipeline into the resolved pipeline.
+func (r *configResolver) add(p latest.Pipeline, file string) error {
+	if buildTypeName(p.Build.BuildType) != buildTypeName(r.merged.Build.BuildType) {
+		return fmt.Errorf("config %q uses %s build, but all configs should use %s build", file, buildTypeName(p.Build.BuildType), buildTypeName(r.merged.Build.BuildType))
+	}
+	if p.Deploy.KubeContext != "" && p.Deploy.KubeContext != r.merged.Deploy.KubeContext {
+		return fmt.Errorf("config %q sets kube-context %q, but the effective kube-context is %q", file, p.Deploy.KubeContext, r.merged.Deploy.KubeContext)
+	}
+
+	// attestations apply to all the built images
+	if a := p.Build.Attestations; a != nil {
+		switch {
+		case r.merged.Build.Attestations == nil:
+			r.merged.Build.Attestations = a
+		case !reflect.DeepEqual(*a, *r.merged.Build.Attestations):
+			return fmt.Errorf("config %q configures different attestations, but the attestations apply to all configs", file)
+		}
+	}
+	// so does signing
+	if sc := p.Build.Sign; sc != nil {
+		switch {
+		case r.merged.Build.Sign == nil:
+			r.merged.Build.Sign = sc
+		case !reflect.DeepEqual(*sc, *r.merged.Build.Sign):
+			return fmt.Errorf("config %q configures a different signing key, but signing applies to all configs", file)
+		}
+	}
+
+	r.merged.Build.Artifacts = append(r.merged.Build.Artifacts, p.Build.Artifacts...)
+	for _, reg := range p.Build.InsecureRegistries {
+		if !skutil.StrSliceContains(r.merged.Build.InsecureRegistries, reg) {
+			r.merged.Build.InsecureRegistries = append(r.merged.Build.InsecureRegistries, reg)
+		}
+	}
+	r.merged.Test = append(r.merged.Test, p.Test...)
+	r.merged.PortForward = append(r.merged.PortForward, p.PortForward...)
+
+	d := &r.merged.Deploy
+	if p.Deploy.StatusCheckDeadlineSeconds > d.StatusCheckDeadlineSeconds {
+		d.StatusCheckDeadlineSeconds = p.Deploy.StatusCheckDeadlineSeconds
+	}
+	for _, rule := range p.Deploy.HealthRules {
+		existing := findHealthRule(d.HealthRules, rule.Group, rule.Kind)
+		switch {
+		case existing == nil:
+			d.HealthRules = append(d.HealthRules, rule)
+		case !reflect.DeepEqual(*existing, rule):
+			return fmt.Errorf("config %q defines a different health rule for %s, but only one rule per kind is supported across all configs", file, healthRuleKind(rule))
+		}
+	}
+	d.LifecycleHooks.PreHooks = append(d.LifecycleHooks.PreHooks, p.Deploy.LifecycleHooks.PreHooks...)
+	d.LifecycleHooks.PostHooks = append(d.LifecycleHooks.PostHooks, p.Deploy.LifecycleHooks.PostHooks...)
+
+	// the settings of a deployer apply to the manifests of all the configs
+	if k := p.Deploy.KubectlDeploy; k != nil {
+		switch {
+		case d.KubectlDeploy == nil:
+			d.KubectlDeploy = &latest.KubectlDeploy{Flags: k.Flags, DefaultNamespace: k.DefaultNamespace}
+		case !reflect.DeepEqual(k.Flags, d.KubectlDeploy.Flags) || !reflect.DeepEqual(k.DefaultNamespace, d.KubectlDeploy.DefaultNamespace):
+			return fmt.Errorf("config %q sets different kubectl flags or defaultNamespace, but they apply to all configs", file)
+		}
+		d.KubectlDeploy.Manifests = append(d.KubectlDeploy.Manifests, k.Manifests...)
+		d.KubectlDeploy.RemoteManifests = append(d.KubectlDeploy.RemoteManifests, k.RemoteManifests...)
+	}
+	if k := p.Deploy.KustomizeDeploy; k != nil {
+		switch {
+		case d.KustomizeDeploy == nil:
+			d.KustomizeDeploy = &latest.KustomizeDeploy{Flags: k.Flags, BuildArgs: k.BuildArgs, DefaultNamespace: k.DefaultNamespace}
+		case !reflect.DeepEqual(k.Flags, d.KustomizeDeploy.Flags) || !reflect.DeepEqual(k.BuildArgs, d.KustomizeDeploy.BuildArgs) || !reflect.DeepEqual(k.DefaultNamespace, d.KustomizeDeploy.DefaultNamespace):
+			return fmt.Errorf("config %q sets different kustomize flags, buildArgs or defaultNamespace, but they apply to all configs", file)
+		}
+		d.KustomizeDeploy.KustomizePaths = append(d.KustomizeDeploy.KustomizePaths, k.KustomizePaths...)
+	}
+	if c := p.Deploy.ComposeDeploy; c != nil {
+		switch {
+		case d.ComposeDeploy == nil:
+			d.ComposeDeploy = &latest.ComposeDeploy{Flags: c.Flags, DefaultNamespace: c.DefaultNamespace}
+		case !reflect.DeepEqual(c.Flags, d.ComposeDeploy.Flags) || !reflect.DeepEqual(c.DefaultNamespace, d.ComposeDeploy.DefaultNamespace):
+			return fmt.Errorf("config %q sets different compose flags or defaultNamespace, but they apply to all configs", file)
+		}
+		d.ComposeDeploy.Paths = append(d.ComposeDeploy.Paths, c.Paths...)
+	}
+	if c := p.Deploy.DockerDeploy; c != nil {
+		switch {
+		case d.DockerDeploy == nil:
+			d.DockerDeploy = &latest.DockerDeploy{Network: c.Network}
+		case c.Network != d.DockerDeploy.Network:
+			return fmt.Errorf("config %q sets docker network %q, but the containers of all configs share network %q", file, c.Network, d.DockerDeploy.Network)
+		}
+		d.DockerDeploy.Containers = append(d.DockerDeploy.Containers, c.Containers...)
+	}
+	if h := p.Deploy.HelmDeploy; h != nil {
+		switch {
+		case d.HelmDeploy == nil:
+			d.HelmDeploy = &latest.HelmDeploy{Flags: h.Flags}
+		case !reflect.DeepEqual(h.Flags, d.HelmDeploy.Flags):
+			return fmt.Errorf("config %q sets different helm flags, but they apply to all configs", file)
+		}
+		d.HelmDeploy.Releases = append(d.HelmDeploy.Releases, h.Releases...)
+	}
+	if k := p.Deploy.KptDeploy; k != nil {
+		if d.KptDeploy != nil {
+			return fmt.Errorf("config %q defines a kpt deployer, but only one kpt deployer is supported across all configs", file)
+		}
+		d.KptDeploy = k
+	}
+	return nil
+}
+
+func findHealthRule(rules []latest.HealthRule, group, kind string) *latest.HealthRule {
+	for i := range rules {
+		if rules[i].Group == group && rules[i].Kind == kind {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func healthRuleKind(rule latest.HealthRule) string {
+	if rule.Group == "" {
+		return rule.Kind
+	}
+	return rule.Kind + "." + rule.Group
+}
+
+func buildTypeName(b latest.BuildType) string {
+	switch {
+	case b.GoogleCloudBuild != nil:
+		return "googleCloudBuild"
+	case b.Cluster != nil:
+		return "cluster"
+	default:
+		return "local"
+	}
+}
+
+// rebasePaths makes the relative paths of a pipeline relative to the given directory.
+func rebasePaths(p *latest.Pipeline, dir string) {
+	rebase := func(path string) string {
+		if path == "" || filepath.IsAbs(path) || skutil.IsURL(path) {
+			return path
+		}
+		return filepath.Join(dir, path)
+	}
+	// rebaseAll returns a new slice as some slices are shared with default values.
+	rebaseAll := func(paths []string) []string {
+		var rebased []string
+		for _, path := range paths {
+			rebased = append(rebased, rebase(path))
+		}
+		return rebased
+	}
+
+	for _, a := range p.Build.Artifacts {
+		a.Workspace = rebase(a.Workspace)
+	}
+	if p.Build.Sign != nil {
+		p.Build.Sign.Key = rebase(p.Build.Sign.Key)
+	}
+	for _, t := range p.Test {
+		t.StructureTests = rebaseAll(t.StructureTests)
+	}
+
+	d := p.Deploy
+	if d.KubectlDeploy != nil {
+		d.KubectlDeploy.Manifests = rebaseAll(d.KubectlDeploy.Manifests)
+	}
+	if d.KustomizeDeploy != nil {
+		d.KustomizeDeploy.KustomizePaths = rebaseAll(d.KustomizeDeploy.KustomizePaths)
+	}
+	if d.HelmDeploy != nil {
+		for i := range d.HelmDeploy.Releases {
+			r := &d.HelmDeploy.Releases[i]
+			if !r.Remote {
+				r.ChartPath = rebase(r.ChartPath)
+			}
+			r.ValuesFiles = rebaseAll(r.ValuesFiles)
+			for k, v := range r.SetFiles {
+				r.SetFiles[k] = rebase(v)
+			}
+		}
+	}
+	if d.ComposeDeploy != nil {
+		d.ComposeDeploy.Paths = rebaseAll(d.ComposeDeploy.Paths)
+	}
+	if d.DockerDeploy != nil {
+		for i := range d.DockerDeploy.Containers {
+			c := &d.DockerDeploy.Containers[i]
+			var volumes []string
+			for _, v := range c.Volumes {
+				// Only paths starting with `.` are relative paths. Other sources are named volumes.
+				if strings.HasPrefix(v, ".") {
+					parts := strings.SplitN(v, ":", 2)
+					if parts[0] = rebase(parts[0]); !filepath.IsAbs(parts[0]) {
+						parts[0] = "." + string(filepath.Separator) + parts[0]
+					}
+					v = strings.Join(parts, ":")
+				}
+				volumes = append(volumes, v)
+			}
+			c.Volumes = volumes
+		}
+	}
+	if d.KptDeploy != nil {
+		d.KptDeploy.Dir = rebase(d.KptDeploy.Dir)
+		d.KptDeploy.Fn.FnPath = rebase(d.KptDeploy.Fn.FnPath)
+		d.KptDeploy.Fn.SinkDir = rebase(d.KptDeploy.Fn.SinkDir)
+		d.KptDeploy.Live.Apply.Dir = rebase(d.KptDeploy.Live.Apply.Dir)
+	}
+}
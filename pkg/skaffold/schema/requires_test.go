@@ -0,0 +1,453 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	cfg "github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestResolveDependencies(t *testing.T) {
+	tests := []struct {
+		description          string
+		files                map[string]string
+		modules              []string
+		profiles             []string
+		expectedImages       []string
+		expectedWorkspace    []string
+		expectedManifests    []string
+		expectedRules        []latest.HealthRule
+		expectedAttestations *latest.Attestations
+		expectedSign         *latest.SignConfig
+		shouldErr            bool
+	}{
+		{
+			description: "no dependencies",
+			files: map[string]string{
+				"skaffold.yaml": `build:
+  artifacts:
+  - image: root`,
+			},
+			expectedImages:    []string{"root"},
+			expectedWorkspace: []string{""},
+		},
+		{
+			description: "recursive dependencies with rebased paths",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+- path: svc2/skaffold.yaml
+build:
+  artifacts:
+  - image: root
+deploy:
+  kubectl:
+    manifests: [k8s/root.yaml]`,
+				"svc1/skaffold.yaml": `requires:
+- path: ../common
+build:
+  artifacts:
+  - image: svc1
+    context: src`,
+				"svc2/skaffold.yaml": `build:
+  artifacts:
+  - image: svc2`,
+				"common/skaffold.yaml": `build:
+  artifacts:
+  - image: common
+deploy:
+  kubectl:
+    manifests: [k8s/common.yaml]`,
+			},
+			expectedImages:    []string{"common", "svc1", "svc2", "root"},
+			expectedWorkspace: []string{"common", filepath.Join("svc1", "src"), "svc2", ""},
+			expectedManifests: []string{filepath.Join("common", "k8s", "common.yaml"), filepath.Join("svc1", "k8s", "*.yaml"), filepath.Join("svc2", "k8s", "*.yaml"), "k8s/root.yaml"},
+		},
+		{
+			description: "shared dependency is loaded once",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+- path: common`,
+				"svc1/skaffold.yaml": `requires:
+- path: ../common
+build:
+  artifacts:
+  - image: svc1`,
+				"common/skaffold.yaml": `build:
+  artifacts:
+  - image: common`,
+			},
+			expectedImages:    []string{"common", "svc1"},
+			expectedWorkspace: []string{"common", "svc1"},
+		},
+		{
+			description: "dependency profiles",
+			profiles:    []string{"dev"},
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+  activeProfiles:
+  - name: always
+  - name: dev-only
+    activatedBy: [dev]
+  - name: prod-only
+    activatedBy: [prod]
+profiles:
+- name: dev
+- name: prod`,
+				"svc1/skaffold.yaml": `build:
+  artifacts:
+  - image: svc1
+profiles:
+- name: always
+  patches:
+  - op: add
+    path: /build/artifacts/-
+    value:
+      image: always
+- name: dev-only
+  patches:
+  - op: add
+    path: /build/artifacts/-
+    value:
+      image: dev-only
+- name: prod-only
+  patches:
+  - op: add
+    path: /build/artifacts/-
+    value:
+      image: prod-only`,
+			},
+			expectedImages:    []string{"svc1", "always", "dev-only"},
+			expectedWorkspace: []string{"svc1", "svc1", "svc1"},
+		},
+		{
+			description: "module selection",
+			modules:     []string{"svc1"},
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+- path: svc2
+build:
+  artifacts:
+  - image: root`,
+				"svc1/skaffold.yaml": `metadata:
+  name: svc1
+requires:
+- path: ../common
+build:
+  artifacts:
+  - image: svc1`,
+				"svc2/skaffold.yaml": `metadata:
+  name: svc2
+build:
+  artifacts:
+  - image: svc2`,
+				"common/skaffold.yaml": `build:
+  artifacts:
+  - image: common`,
+			},
+			expectedImages:    []string{"common", "svc1"},
+			expectedWorkspace: []string{"common", "svc1"},
+		},
+		{
+			description: "unknown module",
+			modules:     []string{"unknown"},
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1`,
+				"svc1/skaffold.yaml": `metadata:
+  name: svc1`,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "cycle",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1`,
+				"svc1/skaffold.yaml": `requires:
+- path: ../svc2`,
+				"svc2/skaffold.yaml": `requires:
+- path: ../svc1`,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "missing dependency",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: missing`,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "health rules",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+- path: svc2
+deploy:
+  healthRules:
+  - group: cert-manager.io
+    version: v1
+    kind: Certificate
+    ready: {condition: Ready}`,
+				"svc1/skaffold.yaml": `deploy:
+  healthRules:
+  - group: cert-manager.io
+    version: v1
+    kind: Certificate
+    ready: {condition: Ready}
+  - group: example.com
+    version: v1
+    kind: Database
+    ready: {jsonPath: "{.status.phase}", value: Ready}`,
+				"svc2/skaffold.yaml": `metadata:
+  name: svc2`,
+			},
+			expectedRules: []latest.HealthRule{
+				{Group: "cert-manager.io", Version: "v1", Kind: "Certificate", Ready: latest.HealthCondition{Condition: "Ready"}},
+				{Group: "example.com", Version: "v1", Kind: "Database", Ready: latest.HealthCondition{JSONPath: "{.status.phase}", Value: "Ready"}},
+			},
+		},
+		{
+			description: "health rules of selected module",
+			modules:     []string{"svc1"},
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1`,
+				"svc1/skaffold.yaml": `metadata:
+  name: svc1
+deploy:
+  healthRules:
+  - version: v1
+    kind: Widget
+    ready: {condition: Ready}`,
+			},
+			expectedRules: []latest.HealthRule{
+				{Version: "v1", Kind: "Widget", Ready: latest.HealthCondition{Condition: "Ready"}},
+			},
+		},
+		{
+			description: "conflicting health rules",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+deploy:
+  healthRules:
+  - version: v1
+    kind: Widget
+    ready: {condition: Ready}`,
+				"svc1/skaffold.yaml": `deploy:
+  healthRules:
+  - version: v1
+    kind: Widget
+    ready: {condition: Available}`,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "attestations of required config",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1`,
+				"svc1/skaffold.yaml": `build:
+  attestations:
+    sbom: spdx
+    provenance: true`,
+			},
+			expectedAttestations: &latest.Attestations{SBOM: "spdx", Provenance: true},
+		},
+		{
+			description: "attestations with module selection",
+			modules:     []string{"svc1"},
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+build:
+  attestations:
+    sbom: cyclonedx`,
+				"svc1/skaffold.yaml": `metadata:
+  name: svc1`,
+			},
+			expectedAttestations: &latest.Attestations{SBOM: "cyclonedx"},
+		},
+		{
+			description: "conflicting attestations",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+build:
+  attestations:
+    sbom: cyclonedx`,
+				"svc1/skaffold.yaml": `build:
+  attestations:
+    sbom: spdx`,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "signing key of required config",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1`,
+				"svc1/skaffold.yaml": `build:
+  sign:
+    key: cosign.key`,
+			},
+			expectedSign: &latest.SignConfig{Key: filepath.Join("svc1", "cosign.key")},
+		},
+		{
+			description: "signing with module selection",
+			modules:     []string{"svc1"},
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+build:
+  sign:
+    key: cosign.key`,
+				"svc1/skaffold.yaml": `metadata:
+  name: svc1`,
+			},
+			expectedSign: &latest.SignConfig{Key: "cosign.key"},
+		},
+		{
+			description: "same signing key",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+build:
+  sign:
+    key: cosign.key`,
+				"svc1/skaffold.yaml": `build:
+  sign:
+    key: ../cosign.key`,
+			},
+			expectedSign: &latest.SignConfig{Key: "cosign.key"},
+		},
+		{
+			description: "different signing keys",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+build:
+  sign:
+    key: cosign.key`,
+				"svc1/skaffold.yaml": `build:
+  sign:
+    key: other.key`,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "different build types",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1`,
+				"svc1/skaffold.yaml": `build:
+  googleCloudBuild: {}`,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "same kubectl settings",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+deploy:
+  kubectl:
+    defaultNamespace: apps
+    flags: {apply: [--server-side]}`,
+				"svc1/skaffold.yaml": `deploy:
+  kubectl:
+    defaultNamespace: apps
+    flags: {apply: [--server-side]}`,
+			},
+			expectedManifests: []string{filepath.Join("svc1", "k8s", "*.yaml")},
+		},
+		{
+			description: "different kubectl default namespaces",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+deploy:
+  kubectl:
+    defaultNamespace: apps`,
+				"svc1/skaffold.yaml": `deploy:
+  kubectl:
+    defaultNamespace: svc1`,
+			},
+			shouldErr: true,
+		},
+		{
+			description: "different helm flags",
+			files: map[string]string{
+				"skaffold.yaml": `requires:
+- path: svc1
+deploy:
+  helm:
+    flags: {global: [--debug]}`,
+				"svc1/skaffold.yaml": `deploy:
+  helm: {}`,
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			setupFakeKubeConfig(t, api.Config{CurrentContext: "cluster1"})
+			tmpDir := t.NewTempDir().Chdir()
+			for file, content := range test.files {
+				tmpDir.Write(file, addVersion(content))
+			}
+
+			parsed, err := ParseConfig("skaffold.yaml")
+			t.CheckNoError(err)
+			config := parsed.(*latest.SkaffoldConfig)
+
+			opts := cfg.SkaffoldOptions{ConfigurationFile: "skaffold.yaml", Modules: test.modules, Profiles: test.profiles}
+			t.CheckNoError(ApplyProfiles(config, opts))
+			err = ResolveDependencies(config, opts)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			var images, workspaces []string
+			for _, a := range config.Build.Artifacts {
+				images = append(images, a.ImageName)
+				workspaces = append(workspaces, a.Workspace)
+			}
+			t.CheckDeepEqual(test.expectedImages, images)
+			t.CheckDeepEqual(test.expectedWorkspace, workspaces)
+			if test.expectedManifests != nil {
+				t.CheckDeepEqual(test.expectedManifests, config.Deploy.KubectlDeploy.Manifests)
+			}
+			t.CheckDeepEqual(test.expectedRules, config.Deploy.HealthRules)
+			t.CheckDeepEqual(test.expectedAttestations, config.Build.Attestations)
+			t.CheckDeepEqual(test.expectedSign, config.Build.Sign)
+		})
+	}
+}
@@ -29,8 +29,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yamltags"
 	"github.com/GoogleContainerTools/skaffold/proto"
@@ -52,9 +54,14 @@ func Process(config *latest.SkaffoldConfig) error {
 	errs = append(errs, validateSyncRules(config.Build.Artifacts)...)
 	errs = append(errs, validatePortForwardResources(config.PortForward)...)
 	errs = append(errs, validateJibPluginTypes(config.Build.Artifacts)...)
+	errs = append(errs, validatePlatforms(config.Build)...)
 	errs = append(errs, validateLogPrefix(config.Deploy.Logs)...)
+	errs = append(errs, validateLogFilters(config.Deploy.Logs)...)
+	errs = append(errs, validateAttestations(config.Build.Attestations)...)
+	errs = append(errs, validateBuildKit(config.Build)...)
 	errs = append(errs, validateArtifactTypes(config.Build)...)
 	errs = append(errs, validateTaggingPolicy(config.Build)...)
+	errs = append(errs, validateHookCommands(config)...)
 
 	if len(errs) == 0 {
 		return nil
@@ -370,6 +377,20 @@ func validateSyncRules(artifacts []*latest.Artifact) []error {
 					errs = append(errs, err)
 				}
 			}
+			validTransports := []string{"", "auto", "tar", "helper"}
+			if !util.StrSliceContains(validTransports, a.Sync.Transport) {
+				errs = append(errs, fmt.Errorf("artifact %s: invalid sync transport '%s'. Valid values are 'auto', 'tar' or 'helper'", a.ImageName, a.Sync.Transport))
+			}
+			for _, r := range a.Sync.Reverse {
+				if !strings.HasPrefix(r.Src, "/") {
+					errs = append(errs, fmt.Errorf("artifact %s: reverse sync pattern '%s' must be an absolute path", a.ImageName, r.Src))
+				}
+			}
+			if post := a.Sync.PostSync; post != nil && post.Signal != "" {
+				if _, err := helper.ParseSignal(post.Signal); err != nil {
+					errs = append(errs, fmt.Errorf("artifact %s: invalid post-sync signal: %w", a.ImageName, err))
+				}
+			}
 		}
 	}
 	return errs
@@ -414,6 +435,84 @@ func validateJibPluginTypes(artifacts []*latest.Artifact) (errs []error) {
 	return
 }
 
+// validatePlatforms makes sure that the target platforms are well formed and supported by the artifact's builder.
+func validatePlatforms(bc latest.BuildConfig) (errs []error) {
+	for _, a := range bc.Artifacts {
+		if len(a.Platforms) == 0 {
+			continue
+		}
+		for _, p := range a.Platforms {
+			if _, err := docker.ParsePlatform(p); err != nil {
+				errs = append(errs, fmt.Errorf("artifact %s: %w", a.ImageName, err))
+			}
+		}
+
+		supported := a.DockerArtifact != nil || a.JibArtifact != nil || a.KanikoArtifact != nil
+		if bc.GoogleCloudBuild != nil {
+			supported = a.JibArtifact != nil
+		}
+		if !supported {
+			errs = append(errs, fmt.Errorf("artifact %s lists target platforms, which are not supported for '%s' artifacts with this builder", a.ImageName, misc.ArtifactType(a)))
+		}
+	}
+	return
+}
+
+// validateAttestations checks that the SBOM format is supported.
+func validateAttestations(a *latest.Attestations) []error {
+	if a == nil {
+		return nil
+	}
+
+	validFormats := []string{"", "spdx", "cyclonedx"}
+	if !util.StrSliceContains(validFormats, a.SBOM) {
+		return []error{fmt.Errorf("invalid sbom format '%s'. Valid values are 'spdx' or 'cyclonedx'", a.SBOM)}
+	}
+	return nil
+}
+
+// validateHookCommands checks that the lifecycle hooks have a command to run.
+// `yamltags:"required"` lets an empty list through.
+func validateHookCommands(config *latest.SkaffoldConfig) []error {
+	return visitStructs(config, func(s interface{}) error {
+		switch hook := s.(type) {
+		case latest.HostHook:
+			if len(hook.Command) == 0 {
+				return fmt.Errorf("host hook must have a non-empty command")
+			}
+		case latest.ContainerHook:
+			if len(hook.Command) == 0 {
+				return fmt.Errorf("container hook must have a non-empty command")
+			}
+		}
+		return nil
+	})
+}
+
+// validateBuildKit checks that the artifacts can be built with a standalone BuildKit daemon.
+func validateBuildKit(bc latest.BuildConfig) (errs []error) {
+	if bc.LocalBuild == nil || bc.LocalBuild.BuildKit == nil {
+		return nil
+	}
+
+	validModes := []string{"", "min", "max"}
+	if !util.StrSliceContains(validModes, bc.LocalBuild.BuildKit.CacheMode) {
+		errs = append(errs, fmt.Errorf("invalid buildkit cache mode '%s'. Valid values are 'min' or 'max'", bc.LocalBuild.BuildKit.CacheMode))
+	}
+
+	for _, a := range bc.Artifacts {
+		if a.DockerArtifact == nil {
+			continue
+		}
+		// BuildKit can only run build steps in a sandbox, on the host network or without network.
+		mode := strings.ToLower(a.DockerArtifact.NetworkMode)
+		if mode != "" && mode != "host" && mode != "none" && mode != "bridge" {
+			errs = append(errs, fmt.Errorf("artifact %s: network mode '%s' is not supported by buildkit. Valid values are 'bridge', 'host' or 'none'", a.ImageName, a.DockerArtifact.NetworkMode))
+		}
+	}
+	return
+}
+
 // validateArtifactTypes checks that the artifact types are compatible with the specified builder.
 func validateArtifactTypes(bc latest.BuildConfig) (errs []error) {
 	switch {
@@ -450,3 +549,12 @@ func validateLogPrefix(lc latest.LogsConfig) []error {
 
 	return nil
 }
+
+// validateLogFilters makes sure that the filters and highlight rules of the logs can be parsed.
+func validateLogFilters(lc latest.LogsConfig) []error {
+	if _, err := kubernetes.NewLogFilter(lc); err != nil {
+		return []error{err}
+	}
+
+	return nil
+}
@@ -641,6 +641,51 @@ func TestValidateSyncRules(t *testing.T) {
 				},
 			}},
 		},
+		{
+			description: "helper transport",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync:      &latest.Sync{Infer: []string{"**/*.js"}, Transport: "helper"},
+			}},
+		},
+		{
+			description: "unknown transport",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync:      &latest.Sync{Infer: []string{"**/*.js"}, Transport: "rsync"},
+			}},
+			shouldErr: true,
+		},
+		{
+			description: "reverse sync",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync:      &latest.Sync{Reverse: []*latest.ReverseSyncRule{{Src: "/app/gen/**/*.go", Dest: "gen"}}},
+			}},
+		},
+		{
+			description: "relative reverse sync pattern",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync:      &latest.Sync{Reverse: []*latest.ReverseSyncRule{{Src: "gen/**/*.go", Dest: "gen"}}},
+			}},
+			shouldErr: true,
+		},
+		{
+			description: "post-sync signal",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync:      &latest.Sync{Infer: []string{"**/*.js"}, PostSync: &latest.PostSyncAction{Signal: "SIGHUP"}},
+			}},
+		},
+		{
+			description: "unknown post-sync signal",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Sync:      &latest.Sync{Infer: []string{"**/*.js"}, PostSync: &latest.PostSyncAction{Signal: "RELOAD"}},
+			}},
+			shouldErr: true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -943,6 +988,42 @@ func TestValidateLogsConfig(t *testing.T) {
 	}
 }
 
+func TestValidateLogFilters(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.LogsConfig
+		shouldErr   bool
+	}{
+		{description: "no filter", shouldErr: false},
+		{description: "valid filters", cfg: latest.LogsConfig{
+			Include:   []latest.LogSelector{{Image: "gcr.io/*", LabelSelector: "app=web"}},
+			Filter:    "GET .*",
+			Level:     "warn",
+			Highlight: []latest.LogHighlight{{Pattern: "ERROR", Color: "red"}},
+		}, shouldErr: false},
+		{description: "invalid regexp", cfg: latest.LogsConfig{Filter: "("}, shouldErr: true},
+		{description: "invalid level", cfg: latest.LogsConfig{Level: "loud"}, shouldErr: true},
+		{description: "invalid color", cfg: latest.LogsConfig{Highlight: []latest.LogHighlight{{Pattern: "a", Color: "pink"}}}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				&latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							Logs: test.cfg,
+						},
+					},
+				})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateAcyclicDependencies(t *testing.T) {
 	tests := []struct {
 		description string
@@ -1179,3 +1260,194 @@ func TestValidateTaggingPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		build       latest.BuildConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no platforms",
+			build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{{ImageName: "image", ArtifactType: latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{}}}},
+			},
+		},
+		{
+			description: "docker artifact",
+			build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{{ImageName: "image", Platforms: []string{"linux/amd64", "linux/arm/v7"}, ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}}},
+			},
+		},
+		{
+			description: "invalid platform",
+			build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{{ImageName: "image", Platforms: []string{"amd64"}, ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "unsupported artifact type",
+			build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{{ImageName: "image", Platforms: []string{"linux/amd64"}, ArtifactType: latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{}}}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "jib artifact on Google Cloud Build",
+			build: latest.BuildConfig{
+				BuildType: latest.BuildType{GoogleCloudBuild: &latest.GoogleCloudBuild{}},
+				Artifacts: []*latest.Artifact{{ImageName: "image", Platforms: []string{"linux/amd64"}, ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}}}},
+			},
+		},
+		{
+			description: "docker artifact on Google Cloud Build",
+			build: latest.BuildConfig{
+				BuildType: latest.BuildType{GoogleCloudBuild: &latest.GoogleCloudBuild{}},
+				Artifacts: []*latest.Artifact{{ImageName: "image", Platforms: []string{"linux/amd64"}, ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validatePlatforms(test.build)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateAttestations(t *testing.T) {
+	tests := []struct {
+		description  string
+		attestations *latest.Attestations
+		shouldErr    bool
+	}{
+		{
+			description: "no attestations",
+		},
+		{
+			description:  "spdx",
+			attestations: &latest.Attestations{SBOM: "spdx"},
+		},
+		{
+			description:  "cyclonedx",
+			attestations: &latest.Attestations{SBOM: "cyclonedx", Provenance: true},
+		},
+		{
+			description:  "provenance only",
+			attestations: &latest.Attestations{Provenance: true},
+		},
+		{
+			description:  "unknown format",
+			attestations: &latest.Attestations{SBOM: "syft"},
+			shouldErr:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateAttestations(test.attestations)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateBuildKit(t *testing.T) {
+	tests := []struct {
+		description string
+		buildkit    *latest.BuildKitConfig
+		network     string
+		shouldErr   bool
+	}{
+		{
+			description: "no buildkit",
+			network:     "container:other",
+		},
+		{
+			description: "defaults",
+			buildkit:    &latest.BuildKitConfig{},
+		},
+		{
+			description: "max cache and host network",
+			buildkit:    &latest.BuildKitConfig{CacheRepo: "gcr.io/project/cache", CacheMode: "max"},
+			network:     "Host",
+		},
+		{
+			description: "unknown cache mode",
+			buildkit:    &latest.BuildKitConfig{CacheMode: "all"},
+			shouldErr:   true,
+		},
+		{
+			description: "container network",
+			buildkit:    &latest.BuildKitConfig{},
+			network:     "container:other",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateBuildKit(latest.BuildConfig{
+				BuildType: latest.BuildType{
+					LocalBuild: &latest.LocalBuild{BuildKit: test.buildkit},
+				},
+				Artifacts: []*latest.Artifact{{
+					ImageName: "image",
+					ArtifactType: latest.ArtifactType{
+						DockerArtifact: &latest.DockerArtifact{NetworkMode: test.network},
+					},
+				}},
+			})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateHookCommands(t *testing.T) {
+	tests := []struct {
+		description string
+		config      *latest.SkaffoldConfig
+		shouldErr   bool
+	}{
+		{
+			description: "host and container hooks",
+			config: &latest.SkaffoldConfig{Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{
+					ImageName:      "image",
+					LifecycleHooks: latest.BuildHooks{PreHooks: []latest.HostHook{{Command: []string{"make"}}}},
+					Sync: &latest.Sync{LifecycleHooks: latest.SyncHooks{PostHooks: []latest.SyncHookItem{
+						{ContainerHook: &latest.ContainerHook{Command: []string{"kill", "-HUP", "1"}}},
+					}}},
+				}}},
+			}},
+		},
+		{
+			description: "empty host hook command",
+			config: &latest.SkaffoldConfig{Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{
+					ImageName:      "image",
+					LifecycleHooks: latest.BuildHooks{PreHooks: []latest.HostHook{{Command: []string{}}}},
+				}}},
+			}},
+			shouldErr: true,
+		},
+		{
+			description: "empty container hook command",
+			config: &latest.SkaffoldConfig{Pipeline: latest.Pipeline{
+				Deploy: latest.DeployConfig{LifecycleHooks: latest.DeployHooks{PreHooks: []latest.DeployHookItem{
+					{ContainerHook: &latest.NamedContainerHook{ContainerHook: latest.ContainerHook{Command: []string{}}, PodName: "web-*"}},
+				}}},
+			}},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateHookCommands(test.config)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
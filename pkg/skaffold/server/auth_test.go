@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCheckUploadsAddress(t *testing.T) {
+	tests := []struct {
+		description string
+		address     string
+		token       string
+		shouldErr   bool
+	}{
+		{description: "default address", address: ""},
+		{description: "localhost", address: "localhost"},
+		{description: "loopback ipv4", address: "127.0.0.1"},
+		{description: "loopback ipv6", address: "::1"},
+		{description: "all interfaces without token", address: "0.0.0.0", shouldErr: true},
+		{description: "hostname without token", address: "workstation", shouldErr: true},
+		{description: "all interfaces with token", address: "0.0.0.0", token: "secret"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := checkUploadsAddress(test.address, test.token)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		description string
+		md          metadata.MD
+		shouldErr   bool
+	}{
+		{description: "valid token", md: metadata.Pairs("authorization", "Bearer secret")},
+		{description: "invalid token", md: metadata.Pairs("authorization", "Bearer other"), shouldErr: true},
+		{description: "not a bearer token", md: metadata.Pairs("authorization", "secret"), shouldErr: true},
+		{description: "no token", md: metadata.MD{}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), test.md)
+
+			err := authenticate(ctx, "secret")
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestTokenCredentials(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		md, err := tokenCredentials{token: "secret"}.GetRequestMetadata(context.Background())
+		t.CheckNoError(err)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+		t.CheckNoError(authenticate(ctx, "secret"))
+	})
+}
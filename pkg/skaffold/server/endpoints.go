@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/proto"
 )
 
@@ -45,6 +46,16 @@ func (s *server) Handle(ctx context.Context, e *proto.Event) (*empty.Empty, erro
 }
 
 func (s *server) Execute(ctx context.Context, intent *proto.UserIntentRequest) (*empty.Empty, error) {
+	// Invalid log filters are rejected before any other intent is executed.
+	if logs := intent.GetIntent().GetLogs(); logs != nil {
+		if s.logsIntentCallback == nil {
+			return nil, status.Error(codes.FailedPrecondition, "logs are not being streamed")
+		}
+		if err := s.logsIntentCallback(logsConfig(logs)); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	if intent.GetIntent().GetBuild() {
 		event.ResetStateOnBuild()
 		go func() {
@@ -107,3 +118,32 @@ func executeAutoTrigger(triggerName string, request *proto.TriggerRequest, updat
 	}()
 	return
 }
+
+// logsConfig converts a logs intent into a logs configuration.
+func logsConfig(intent *proto.LogsIntent) latest.LogsConfig {
+	config := latest.LogsConfig{
+		Include: logSelectors(intent.GetInclude()),
+		Exclude: logSelectors(intent.GetExclude()),
+		Filter:  intent.GetFilter(),
+		Level:   intent.GetLevel(),
+	}
+	for _, h := range intent.GetHighlight() {
+		config.Highlight = append(config.Highlight, latest.LogHighlight{
+			Pattern: h.GetPattern(),
+			Color:   h.GetColor(),
+		})
+	}
+	return config
+}
+
+func logSelectors(selectors []*proto.LogSelector) []latest.LogSelector {
+	var converted []latest.LogSelector
+	for _, s := range selectors {
+		converted = append(converted, latest.LogSelector{
+			Image:         s.GetImage(),
+			Container:     s.GetContainer(),
+			LabelSelector: s.GetLabelSelector(),
+		})
+	}
+	return converted
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExecuteLogsIntent(t *testing.T) {
+	intent := &proto.UserIntentRequest{
+		Intent: &proto.Intent{
+			Logs: &proto.LogsIntent{
+				Include:   []*proto.LogSelector{{Image: "gcr.io/*", LabelSelector: "app=web"}},
+				Exclude:   []*proto.LogSelector{{Container: "istio-proxy"}},
+				Filter:    "GET",
+				Level:     "info",
+				Highlight: []*proto.LogHighlight{{Pattern: "ERROR", Color: "red"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		description  string
+		callbackErr  error
+		noCallback   bool
+		expectedCode codes.Code
+	}{
+		{
+			description:  "filters are changed",
+			expectedCode: codes.OK,
+		},
+		{
+			description:  "invalid filters",
+			callbackErr:  errors.New("invalid log level"),
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			description:  "logs are not streamed",
+			noCallback:   true,
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var received latest.LogsConfig
+			s := &server{}
+			if !test.noCallback {
+				s.logsIntentCallback = func(config latest.LogsConfig) error {
+					received = config
+					return test.callbackErr
+				}
+			}
+
+			_, err := s.Execute(context.Background(), intent)
+
+			t.CheckDeepEqual(test.expectedCode, status.Code(err))
+			if !test.noCallback {
+				t.CheckDeepEqual(latest.LogsConfig{
+					Include:   []latest.LogSelector{{Image: "gcr.io/*", LabelSelector: "app=web"}},
+					Exclude:   []latest.LogSelector{{Container: "istio-proxy"}},
+					Filter:    "GET",
+					Level:     "info",
+					Highlight: []latest.LogHighlight{{Pattern: "ERROR", Color: "red"}},
+				}, received)
+			}
+		})
+	}
+}
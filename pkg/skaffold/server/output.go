@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"io"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// outputBuffer is the number of chunks of output buffered for each client.
+const outputBuffer = 1024
+
+// outputStreams fans out what Skaffold prints to the clients of the Output API.
+type outputStreams struct {
+	mu      sync.Mutex
+	streams map[chan []byte]bool
+}
+
+func (o *outputStreams) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for c := range o.streams {
+		select {
+		case c <- append([]byte{}, p...):
+		default:
+			// Never block Skaffold on a slow client.
+			logrus.Debugln("Dropping output for a slow client")
+		}
+	}
+
+	return len(p), nil
+}
+
+func (o *outputStreams) subscribe() (<-chan []byte, func()) {
+	c := make(chan []byte, outputBuffer)
+
+	o.mu.Lock()
+	if o.streams == nil {
+		o.streams = map[chan []byte]bool{}
+	}
+	o.streams[c] = true
+	o.mu.Unlock()
+
+	return c, func() {
+		o.mu.Lock()
+		delete(o.streams, c)
+		o.mu.Unlock()
+	}
+}
+
+// Output streams what Skaffold prints to its terminal.
+func (s *server) Output(_ *empty.Empty, stream proto.SkaffoldService_OutputServer) error {
+	c, unsubscribe := s.output.subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case content := <-c:
+			if err := stream.Send(&proto.OutputEntry{
+				Timestamp: ptypes.TimestampNow(),
+				Content:   content,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// terminal is an output that is also streamed to the clients of the Output API.
+// It keeps the file descriptor of the original output so that what's printed is
+// still formatted for a terminal.
+type terminal struct {
+	io.Writer
+	fd uintptr
+}
+
+func (t *terminal) Fd() uintptr {
+	return t.fd
+}
+
+// TeeOutput returns a writer that prints to out and streams to the clients of the Output API.
+// A colorable out stays colorable.
+func TeeOutput(out io.Writer) io.Writer {
+	if srv == nil {
+		return out
+	}
+
+	if color.IsColorable(out) {
+		return color.NewWriter(tee(color.GetWriter(out)))
+	}
+	return tee(out)
+}
+
+func tee(out io.Writer) io.Writer {
+	tee := io.MultiWriter(out, &srv.output)
+	if f, ok := out.(interface{ Fd() uintptr }); ok {
+		return &terminal{Writer: tee, fd: f.Fd()}
+	}
+	return tee
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestOutputStreams(t *testing.T) {
+	var output outputStreams
+
+	// Nobody is listening.
+	fmt.Fprint(&output, "lost")
+
+	first, unsubscribeFirst := output.subscribe()
+	second, unsubscribeSecond := output.subscribe()
+	fmt.Fprint(&output, "hello")
+
+	testutil.CheckDeepEqual(t, "hello", string(<-first))
+	testutil.CheckDeepEqual(t, "hello", string(<-second))
+
+	unsubscribeFirst()
+	fmt.Fprint(&output, "world")
+	unsubscribeSecond()
+
+	testutil.CheckDeepEqual(t, "world", string(<-second))
+	testutil.CheckDeepEqual(t, 0, len(first))
+}
+
+func TestOutputStreamsDropsForSlowClients(t *testing.T) {
+	var output outputStreams
+
+	c, unsubscribe := output.subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < outputBuffer+10; i++ {
+		fmt.Fprint(&output, "line")
+	}
+
+	testutil.CheckDeepEqual(t, outputBuffer, len(c))
+}
+
+func TestTeeOutputKeepsColors(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&srv, &server{})
+		ch, unsubscribe := srv.output.subscribe()
+		defer unsubscribe()
+		defer color.SetupColors(nil, color.DefaultColorCode, false)
+
+		var buf bytes.Buffer
+		out := TeeOutput(color.SetupColors(&buf, color.DefaultColorCode, true))
+		color.Green.Fprintf(out, "hello")
+
+		t.CheckTrue(color.IsColorable(out))
+		t.CheckDeepEqual("\x1b[32mhello\x1b[0m", buf.String())
+		t.CheckDeepEqual("\x1b[32mhello\x1b[0m", string(<-ch))
+	})
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trigger"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// maxBatchSize is the size above which file changes are split into multiple uploads.
+const maxBatchSize = 1024 * 1024
+
+// Dev watches the local workspace and uploads its changes to a remote Skaffold,
+// which owns the build, the deployment and the logs.
+// What the remote Skaffold prints is streamed back to out.
+func Dev(ctx context.Context, out io.Writer, opts config.SkaffoldOptions) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workspace, err := trigger.RealWorkDir()
+	if err != nil {
+		return err
+	}
+
+	dialOpts, err := server.DialOptions(opts.RPCTLSCert, opts.RPCToken)
+	if err != nil {
+		return err
+	}
+
+	dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(server.MaxUploadSize)))
+	conn, err := grpc.DialContext(ctx, opts.Remote, dialOpts...)
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", opts.Remote, err)
+	}
+	defer conn.Close()
+
+	client := proto.NewSkaffoldServiceClient(conn)
+
+	output, err := client.Output(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("streaming output of %s: %w", opts.Remote, err)
+	}
+	events, err := client.Events(ctx, &empty.Empty{})
+	if err != nil {
+		return fmt.Errorf("streaming events of %s: %w", opts.Remote, err)
+	}
+	uploads, err := client.Upload(ctx)
+	if err != nil {
+		return fmt.Errorf("uploading to %s: %w", opts.Remote, err)
+	}
+
+	done := make(chan error, 3)
+	go func() { done <- printOutput(out, output) }()
+	go func() { done <- waitForTermination(out, events) }()
+	go func() { done <- logUploads(uploads) }()
+
+	var changes []filemon.Events
+	monitor := filemon.NewMonitor()
+	if err := monitor.Register(
+		func() ([]string, error) { return workspaceFiles(workspace) },
+		func(e filemon.Events) { changes = append(changes, e) },
+	); err != nil {
+		return fmt.Errorf("watching files: %w", err)
+	}
+
+	t, err := trigger.NewTrigger(&triggerConfig{opts: opts}, func() bool { return true }, monitor)
+	if err != nil {
+		return err
+	}
+	triggered, err := trigger.StartTrigger(ctx, t)
+	if err != nil {
+		return fmt.Errorf("unable to start trigger: %w", err)
+	}
+
+	color.Default.Fprintf(out, "Uploading changes to %s...\n", opts.Remote)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-done:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		case <-triggered:
+			if err := monitor.Run(t.Debounce()); err != nil {
+				logrus.Warnf("Ignoring changes: %s", err.Error())
+				continue
+			}
+			for _, e := range changes {
+				if err := upload(uploads, workspace, e); err != nil {
+					return err
+				}
+			}
+			changes = nil
+			monitor.Reset()
+		}
+	}
+}
+
+// upload sends the changed files to the remote Skaffold, in batches.
+func upload(uploads proto.SkaffoldService_UploadClient, workspace string, e filemon.Events) error {
+	files, err := fileUploads(workspace, e)
+	if err != nil {
+		return err
+	}
+
+	var batch []*proto.FileUpload
+	size := 0
+	for _, f := range files {
+		if len(batch) > 0 && size+len(f.Content) > maxBatchSize {
+			if err := uploads.Send(&proto.UploadRequest{Files: batch}); err != nil {
+				return fmt.Errorf("uploading files: %w", err)
+			}
+			batch, size = nil, 0
+		}
+		batch = append(batch, f)
+		size += len(f.Content)
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := uploads.Send(&proto.UploadRequest{Files: batch}); err != nil {
+		return fmt.Errorf("uploading files: %w", err)
+	}
+	return nil
+}
+
+// fileUploads reads the files that changed in the workspace.
+func fileUploads(workspace string, e filemon.Events) ([]*proto.FileUpload, error) {
+	var files []*proto.FileUpload
+
+	for _, path := range append(append([]string{}, e.Added...), e.Modified...) {
+		rel, err := filepath.Rel(workspace, path)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			// Deleted since it was detected. It'll be reported as deleted next time.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if info.Size() > server.MaxUploadSize {
+			logrus.Warnf("Not uploading %s: files larger than %d bytes are not supported", rel, server.MaxUploadSize)
+			continue
+		}
+
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, &proto.FileUpload{
+			Path:    filepath.ToSlash(rel),
+			Content: content,
+			Mode:    uint32(info.Mode().Perm()),
+		})
+	}
+
+	for _, path := range e.Deleted {
+		rel, err := filepath.Rel(workspace, path)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, &proto.FileUpload{
+			Path:    filepath.ToSlash(rel),
+			Deleted: true,
+		})
+	}
+
+	return files, nil
+}
+
+// workspaceFiles lists the files of the workspace, except the git metadata.
+func workspaceFiles(workspace string) ([]string, error) {
+	return walk.From(workspace).When(func(path string, info walk.Dirent) (bool, error) {
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return false, filepath.SkipDir
+			}
+			return false, nil
+		}
+		return true, nil
+	}).CollectPaths()
+}
+
+func printOutput(out io.Writer, output proto.SkaffoldService_OutputClient) error {
+	for {
+		entry, err := output.Recv()
+		if err == io.EOF {
+			return errors.New("remote skaffold has stopped")
+		}
+		if err != nil {
+			return fmt.Errorf("streaming output: %w", err)
+		}
+
+		out.Write(entry.GetContent())
+	}
+}
+
+// waitForTermination returns once the remote Skaffold reports that it's terminating.
+func waitForTermination(out io.Writer, events proto.SkaffoldService_EventsClient) error {
+	for {
+		entry, err := events.Recv()
+		if err != nil {
+			return fmt.Errorf("streaming events: %w", err)
+		}
+
+		if termination := entry.GetEvent().GetTerminationEvent(); termination != nil {
+			color.Default.Fprintf(out, "Remote skaffold terminated: %s\n", termination.GetStatus())
+			if termination.GetErr() != nil {
+				return errors.New(termination.GetErr().GetMessage())
+			}
+			return nil
+		}
+	}
+}
+
+func logUploads(uploads proto.SkaffoldService_UploadClient) error {
+	for {
+		res, err := uploads.Recv()
+		if err != nil {
+			return fmt.Errorf("uploading files: %w", err)
+		}
+
+		logrus.Infof("Uploaded %d changed and %d deleted files", res.GetWritten(), res.GetDeleted())
+	}
+}
+
+// triggerConfig configures the trigger that watches the whole workspace.
+type triggerConfig struct {
+	opts config.SkaffoldOptions
+}
+
+func (c *triggerConfig) Pipeline() latest.Pipeline { return latest.Pipeline{} }
+func (c *triggerConfig) Trigger() string           { return c.opts.Trigger }
+func (c *triggerConfig) WatchPollInterval() int    { return c.opts.WatchPollInterval }
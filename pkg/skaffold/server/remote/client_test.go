@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeUploadClient struct {
+	proto.SkaffoldService_UploadClient
+	sent []*proto.UploadRequest
+}
+
+func (f *fakeUploadClient) Send(req *proto.UploadRequest) error {
+	f.sent = append(f.sent, req)
+	return nil
+}
+
+func TestFileUploads(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("added.txt", "added").
+			Write("pkg/modified.txt", "modified")
+
+		files, err := fileUploads(tmpDir.Root(), filemon.Events{
+			Added:    []string{tmpDir.Path("added.txt"), tmpDir.Path("gone.txt")},
+			Modified: []string{tmpDir.Path("pkg/modified.txt")},
+			Deleted:  []string{tmpDir.Path("deleted.txt")},
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(3, len(files))
+		t.CheckDeepEqual("added.txt", files[0].Path)
+		t.CheckDeepEqual("added", string(files[0].Content))
+		t.CheckDeepEqual("pkg/modified.txt", files[1].Path)
+		t.CheckDeepEqual("modified", string(files[1].Content))
+		t.CheckDeepEqual("deleted.txt", files[2].Path)
+		t.CheckTrue(files[2].Deleted)
+	})
+}
+
+func TestUploadInBatches(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		large := strings.Repeat("x", maxBatchSize/2+1)
+		tmpDir := t.NewTempDir().
+			Write("first.txt", large).
+			Write("second.txt", large).
+			Write("third.txt", "small")
+
+		client := &fakeUploadClient{}
+		err := upload(client, tmpDir.Root(), filemon.Events{
+			Modified: []string{tmpDir.Path("first.txt"), tmpDir.Path("second.txt"), tmpDir.Path("third.txt")},
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(client.sent))
+		t.CheckDeepEqual(1, len(client.sent[0].Files))
+		t.CheckDeepEqual(2, len(client.sent[1].Files))
+	})
+}
+
+func TestWorkspaceFiles(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("main.go", "").
+			Write("pkg/lib.go", "").
+			Write(".git/HEAD", "")
+
+		files, err := workspaceFiles(tmpDir.Root())
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{tmpDir.Path("main.go"), tmpDir.Path("pkg/lib.go")}, files)
+	})
+}
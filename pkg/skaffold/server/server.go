@@ -23,15 +23,19 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/proto"
 )
@@ -52,6 +56,13 @@ type server struct {
 	autoBuildCallback    func(bool)
 	autoSyncCallback     func(bool)
 	autoDeployCallback   func(bool)
+
+	// logsIntentCallback changes the filters of the logs. It's nil if the logs are not streamed.
+	logsIntentCallback func(latest.LogsConfig) error
+
+	// workspace is where uploaded files are written. Uploads are rejected if it's empty.
+	workspace string
+	output    outputStreams
 }
 
 func SetBuildCallback(callback func()) {
@@ -90,22 +101,44 @@ func SetAutoSyncCallback(callback func(bool)) {
 	}
 }
 
+func SetLogsCallback(callback func(latest.LogsConfig) error) {
+	if srv != nil {
+		srv.logsIntentCallback = callback
+	}
+}
+
 // Initialize creates the gRPC and HTTP servers for serving the state and event log.
 // It returns a shutdown callback for tearing down the grpc server,
 // which the runner is responsible for calling.
 func Initialize(opts config.SkaffoldOptions) (func() error, error) {
-	if !opts.EnableRPC || opts.RPCPort == -1 {
+	// A client of a remote Skaffold doesn't serve the API itself.
+	if !opts.EnableRPC || opts.RPCPort == -1 || opts.Remote != "" {
 		return func() error { return nil }, nil
 	}
 
 	var usedPorts util.PortSet
 
-	grpcCallback, rpcPort, err := newGRPCServer(opts.RPCPort, &usedPorts)
+	serverCreds, gatewayCreds, err := transportCredentials(opts.RPCTLSCert, opts.RPCTLSKey)
+	if err != nil {
+		return func() error { return nil }, err
+	}
+
+	var workspace string
+	if opts.AcceptUploads {
+		if err := checkUploadsAddress(opts.RPCAddress, opts.RPCToken); err != nil {
+			return func() error { return nil }, err
+		}
+		if workspace, err = os.Getwd(); err != nil {
+			return func() error { return nil }, fmt.Errorf("getting working directory: %w", err)
+		}
+	}
+
+	grpcCallback, rpcPort, err := newGRPCServer(opts.RPCAddress, opts.RPCPort, &usedPorts, serverCreds, opts.RPCToken, workspace)
 	if err != nil {
 		return grpcCallback, fmt.Errorf("starting gRPC server: %w", err)
 	}
 
-	httpCallback, err := newHTTPServer(opts.RPCHTTPPort, rpcPort, &usedPorts)
+	httpCallback, err := newHTTPServer(opts.RPCHTTPPort, gatewayAddress(opts.RPCAddress, rpcPort), gatewayCreds, &usedPorts)
 	callback := func() error {
 		httpErr := httpCallback()
 		grpcErr := grpcCallback()
@@ -131,8 +164,12 @@ func Initialize(opts config.SkaffoldOptions) (func() error, error) {
 	return callback, nil
 }
 
-func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, int, error) {
-	l, port, err := listenOnAvailablePort(preferredPort, usedPorts)
+func newGRPCServer(address string, preferredPort int, usedPorts *util.PortSet, creds credentials.TransportCredentials, token, workspace string) (func() error, int, error) {
+	if address == "" {
+		address = util.Loopback
+	}
+
+	l, port, err := listenOnAvailablePort(address, preferredPort, usedPorts)
 	if err != nil {
 		return func() error { return nil }, 0, fmt.Errorf("creating listener: %w", err)
 	}
@@ -143,7 +180,15 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 		logrus.Infof("starting gRPC server on port %d", port)
 	}
 
-	s := grpc.NewServer()
+	serverOpts := []grpc.ServerOption{grpc.MaxRecvMsgSize(MaxUploadSize)}
+	if creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	if token != "" {
+		serverOpts = append(serverOpts, authInterceptors(token)...)
+	}
+
+	s := grpc.NewServer(serverOpts...)
 	srv = &server{
 		buildIntentCallback:  func() {},
 		deployIntentCallback: func() {},
@@ -151,6 +196,7 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 		autoBuildCallback:    func(bool) {},
 		autoSyncCallback:     func(bool) {},
 		autoDeployCallback:   func(bool) {},
+		workspace:            workspace,
 	}
 	proto.RegisterSkaffoldServiceServer(s, srv)
 
@@ -178,15 +224,18 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 	}, port, nil
 }
 
-func newHTTPServer(preferredPort, proxyPort int, usedPorts *util.PortSet) (func() error, error) {
+func newHTTPServer(preferredPort int, proxyAddress string, creds credentials.TransportCredentials, usedPorts *util.PortSet) (func() error, error) {
 	mux := runtime.NewServeMux(runtime.WithProtoErrorHandler(errorHandler))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err := proto.RegisterSkaffoldServiceHandlerFromEndpoint(context.Background(), mux, fmt.Sprintf("%s:%d", util.Loopback, proxyPort), opts)
+	if creds != nil {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	}
+	err := proto.RegisterSkaffoldServiceHandlerFromEndpoint(context.Background(), mux, proxyAddress, opts)
 	if err != nil {
 		return func() error { return nil }, err
 	}
 
-	l, port, err := listenOnAvailablePort(preferredPort, usedPorts)
+	l, port, err := listenOnAvailablePort(util.Loopback, preferredPort, usedPorts)
 	if err != nil {
 		return func() error { return nil }, fmt.Errorf("creating listener: %w", err)
 	}
@@ -225,11 +274,19 @@ func errorHandler(ctx context.Context, _ *runtime.ServeMux, marshaler runtime.Ma
 	}
 }
 
-func listenOnAvailablePort(preferredPort int, usedPorts *util.PortSet) (net.Listener, int, error) {
+// gatewayAddress returns the address the HTTP gateway uses to reach the gRPC server.
+func gatewayAddress(rpcAddress string, rpcPort int) string {
+	if ip := net.ParseIP(rpcAddress); rpcAddress == "" || (ip != nil && ip.IsUnspecified()) {
+		rpcAddress = util.Loopback
+	}
+	return net.JoinHostPort(rpcAddress, strconv.Itoa(rpcPort))
+}
+
+func listenOnAvailablePort(address string, preferredPort int, usedPorts *util.PortSet) (net.Listener, int, error) {
 	for try := 1; ; try++ {
-		port := util.GetAvailablePort(util.Loopback, preferredPort, usedPorts)
+		port := util.GetAvailablePort(address, preferredPort, usedPorts)
 
-		l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", util.Loopback, port))
+		l, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(port)))
 		if err != nil {
 			if try >= maxTryListen {
 				return nil, 0, err
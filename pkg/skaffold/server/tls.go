@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// transportCredentials loads the credentials used to serve the API over TLS,
+// along with the ones the HTTP gateway uses to reach the gRPC server.
+// Both are nil if TLS isn't configured.
+func transportCredentials(certFile, keyFile string) (credentials.TransportCredentials, credentials.TransportCredentials, error) {
+	if certFile == "" && keyFile == "" {
+		return nil, nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, nil, errors.New("both --rpc-tls-cert and --rpc-tls-key are required to serve the API over TLS")
+	}
+
+	serverCreds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading TLS certificate: %w", err)
+	}
+
+	// The gateway only ever connects to the local gRPC server,
+	// whose certificate is usually not issued for the loopback address.
+	gatewayCreds := credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+
+	return serverCreds, gatewayCreds, nil
+}
+
+// DialOptions returns the options to connect to a Skaffold API.
+// The connection uses TLS if a certificate to trust is given,
+// and each call carries the token, if any.
+func DialOptions(certFile, token string) ([]grpc.DialOption, error) {
+	var opts []grpc.DialOption
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{token: token, secure: certFile != ""}))
+	}
+
+	if certFile == "" {
+		return append(opts, grpc.WithInsecure()), nil
+	}
+
+	creds, err := credentials.NewClientTLSFromFile(certFile, "")
+	if err != nil {
+		return nil, fmt.Errorf("loading TLS certificate: %w", err)
+	}
+	return append(opts, grpc.WithTransportCredentials(creds)), nil
+}
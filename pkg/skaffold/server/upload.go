@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// MaxUploadSize is the maximum size of a batch of uploaded files.
+const MaxUploadSize = 64 * 1024 * 1024
+
+// Upload writes the files uploaded by a remote client into the workspace.
+// The dev loop then picks the changes up as if they were made locally.
+func (s *server) Upload(stream proto.SkaffoldService_UploadServer) error {
+	if s.workspace == "" {
+		return status.Error(codes.PermissionDenied, "file uploads are disabled, run skaffold with --accept-uploads")
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		res, err := writeUploads(s.workspace, req.GetFiles())
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		logrus.Infof("Received %d changed and %d deleted files", res.Written, res.Deleted)
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+func writeUploads(workspace string, files []*proto.FileUpload) (*proto.UploadResponse, error) {
+	res := &proto.UploadResponse{}
+
+	for _, f := range files {
+		path, err := workspacePath(workspace, f.GetPath())
+		if err != nil {
+			return nil, err
+		}
+
+		if f.GetDeleted() {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("deleting %q: %w", f.GetPath(), err)
+			}
+			res.Deleted++
+			continue
+		}
+
+		mode := os.FileMode(f.GetMode()).Perm()
+		if mode == 0 {
+			mode = 0644
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("creating directory for %q: %w", f.GetPath(), err)
+		}
+		if err := ioutil.WriteFile(path, f.GetContent(), mode); err != nil {
+			return nil, fmt.Errorf("writing %q: %w", f.GetPath(), err)
+		}
+		// WriteFile doesn't change the mode of existing files.
+		if err := os.Chmod(path, mode); err != nil {
+			return nil, fmt.Errorf("writing %q: %w", f.GetPath(), err)
+		}
+		res.Written++
+	}
+
+	return res, nil
+}
+
+// workspacePath resolves the path of an uploaded file, making sure that it stays inside the workspace,
+// even through symlinks.
+func workspacePath(workspace, path string) (string, error) {
+	rel := filepath.Clean(filepath.FromSlash(path))
+	if path == "" || filepath.IsAbs(rel) || isOutside(rel) {
+		return "", fmt.Errorf("invalid path %q: uploaded files must be relative to the workspace", path)
+	}
+
+	root, err := filepath.EvalSymlinks(workspace)
+	if err != nil {
+		return "", fmt.Errorf("resolving workspace: %w", err)
+	}
+
+	dir, err := resolveSymlinks(filepath.Join(root, filepath.Dir(rel)))
+	if err != nil {
+		return "", fmt.Errorf("resolving %q: %w", path, err)
+	}
+	resolved := filepath.Join(dir, filepath.Base(rel))
+
+	// The file itself might be a symlink that writes would follow.
+	if info, err := os.Lstat(resolved); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		target, err := filepath.EvalSymlinks(resolved)
+		if err != nil {
+			return "", fmt.Errorf("resolving %q: %w", path, err)
+		}
+		if !isWithin(root, target) {
+			return "", fmt.Errorf("invalid path %q: it links outside of the workspace", path)
+		}
+	}
+	if !isWithin(root, resolved) {
+		return "", fmt.Errorf("invalid path %q: it links outside of the workspace", path)
+	}
+
+	return resolved, nil
+}
+
+// resolveSymlinks evaluates the symlinks of the longest part of a path that exists.
+func resolveSymlinks(path string) (string, error) {
+	var missing []string
+	for {
+		if _, err := os.Lstat(path); err == nil {
+			break
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+
+		parent := filepath.Dir(path)
+		if parent == path {
+			break
+		}
+		missing = append([]string{filepath.Base(path)}, missing...)
+		path = parent
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(append([]string{resolved}, missing...)...), nil
+}
+
+func isWithin(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && !isOutside(rel)
+}
+
+func isOutside(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestWorkspacePath(t *testing.T) {
+	tests := []struct {
+		description string
+		path        string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "file",
+			path:        "main.go",
+			expected:    filepath.Join("workspace", "main.go"),
+		},
+		{
+			description: "nested file",
+			path:        "pkg/app/main.go",
+			expected:    filepath.Join("workspace", "pkg", "app", "main.go"),
+		},
+		{
+			description: "cleaned up path",
+			path:        "pkg/../main.go",
+			expected:    filepath.Join("workspace", "main.go"),
+		},
+		{
+			description: "empty path",
+			path:        "",
+			shouldErr:   true,
+		},
+		{
+			description: "absolute path",
+			path:        "/etc/passwd",
+			shouldErr:   true,
+		},
+		{
+			description: "outside of the workspace",
+			path:        "../other/main.go",
+			shouldErr:   true,
+		},
+		{
+			description: "parent directory",
+			path:        "pkg/../..",
+			shouldErr:   true,
+		},
+		{
+			description: "symlink to a directory of the workspace",
+			path:        "linked/main.go",
+			expected:    filepath.Join("workspace", "pkg", "main.go"),
+		},
+		{
+			description: "symlink to a directory outside of the workspace",
+			path:        "escape/main.go",
+			shouldErr:   true,
+		},
+		{
+			description: "new directory in a symlink outside of the workspace",
+			path:        "escape/new/main.go",
+			shouldErr:   true,
+		},
+		{
+			description: "symlink to a file outside of the workspace",
+			path:        "passwd",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Touch("workspace/pkg/main.go", "outside/passwd")
+			root, err := filepath.EvalSymlinks(tmpDir.Root())
+			t.CheckNoError(err)
+			t.CheckNoError(os.Symlink(filepath.Join(root, "workspace", "pkg"), filepath.Join(root, "workspace", "linked")))
+			t.CheckNoError(os.Symlink(filepath.Join(root, "outside"), filepath.Join(root, "workspace", "escape")))
+			t.CheckNoError(os.Symlink(filepath.Join(root, "outside", "passwd"), filepath.Join(root, "workspace", "passwd")))
+
+			path, err := workspacePath(filepath.Join(root, "workspace"), test.path)
+
+			expected := ""
+			if test.expected != "" {
+				expected = filepath.Join(root, test.expected)
+			}
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, expected, path)
+		})
+	}
+}
+
+func TestWriteUploads(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("existing.txt", "old").
+			Write("deleted.txt", "deleted")
+
+		res, err := writeUploads(tmpDir.Root(), []*proto.FileUpload{
+			{Path: "existing.txt", Content: []byte("new"), Mode: 0600},
+			{Path: "pkg/added.txt", Content: []byte("added")},
+			{Path: "deleted.txt", Deleted: true},
+			{Path: "never-existed.txt", Deleted: true},
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(int32(2), res.Written)
+		t.CheckDeepEqual(int32(2), res.Deleted)
+
+		content, err := ioutil.ReadFile(tmpDir.Path("existing.txt"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual("new", string(content))
+		info, err := os.Stat(tmpDir.Path("existing.txt"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(os.FileMode(0600), info.Mode().Perm())
+
+		content, err = ioutil.ReadFile(tmpDir.Path("pkg/added.txt"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual("added", string(content))
+
+		_, err = os.Stat(tmpDir.Path("deleted.txt"))
+		t.CheckTrue(os.IsNotExist(err))
+	})
+}
+
+func TestWriteUploadsOutsideWorkspace(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+
+		_, err := writeUploads(tmpDir.Path("workspace"), []*proto.FileUpload{
+			{Path: "../escaped.txt", Content: []byte("content")},
+		})
+
+		t.CheckErrorContains("must be relative to the workspace", err)
+		_, err = os.Stat(tmpDir.Path("escaped.txt"))
+		t.CheckTrue(os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// containerSyncer syncs files into the containers run by the docker deployer.
+type containerSyncer struct {
+	client     docker.LocalDaemon
+	workingDir string
+}
+
+// NewContainerSyncer creates a Syncer for the containers run by the docker deployer
+// for the project in a working directory.
+func NewContainerSyncer(client docker.LocalDaemon, workingDir string) Syncer {
+	return &containerSyncer{
+		client:     client,
+		workingDir: workingDir,
+	}
+}
+
+func (s *containerSyncer) Sync(ctx context.Context, item *Item) error {
+	if len(item.Copy) == 0 && len(item.Delete) == 0 {
+		return nil
+	}
+
+	labels := docker.DeployerLabels(s.workingDir)
+	labels[docker.ImageLabel] = item.Image
+	ids, err := s.client.ContainerIDs(ctx, labels)
+	if err != nil {
+		return fmt.Errorf("syncing files: %w", err)
+	}
+
+	for name, id := range ids {
+		if err := s.syncContainer(ctx, id, item); err != nil {
+			return fmt.Errorf("syncing files to container %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (s *containerSyncer) syncContainer(ctx context.Context, id string, item *Item) error {
+	if len(item.Copy) > 0 {
+		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
+
+		reader, writer := io.Pipe()
+		go func() {
+			writer.CloseWithError(util.CreateMappedTar(writer, "/", item.Copy))
+		}()
+		if err := s.client.CopyToContainer(ctx, id, "/", reader); err != nil {
+			reader.CloseWithError(err)
+			return fmt.Errorf("copying files: %w", err)
+		}
+	}
+
+	if len(item.Delete) > 0 {
+		logrus.Infoln("Deleting files:", item.Delete, "from", item.Image)
+
+		command := []string{"rm", "-rf", "--"}
+		for _, dsts := range item.Delete {
+			command = append(command, dsts...)
+		}
+		if err := s.client.Exec(ctx, id, command); err != nil {
+			return fmt.Errorf("deleting files: %w", err)
+		}
+	}
+
+	command := containerPostSyncCommand(item)
+	if command == nil {
+		return nil
+	}
+	logrus.Infof("Running post-sync action (%s) in %s", PostSyncAction(item.Artifact), item.Image)
+	if err := s.client.Exec(ctx, id, command); err != nil {
+		return fmt.Errorf("running post-sync action: %w", err)
+	}
+	return nil
+}
+
+// containerPostSyncCommand returns the command that applies the post-sync action
+// without the sync helper, which isn't available in the containers run by the docker deployer.
+func containerPostSyncCommand(item *Item) []string {
+	if item.Artifact == nil || item.Artifact.Sync == nil || item.Artifact.Sync.PostSync == nil {
+		return nil
+	}
+
+	post := item.Artifact.Sync.PostSync
+	switch {
+	case post.Signal != "":
+		if _, err := helper.ParseSignal(post.Signal); err != nil {
+			return nil
+		}
+		return []string{"kill", "-s", strings.TrimPrefix(strings.ToUpper(post.Signal), "SIG"), "1"}
+	case len(post.Command) > 0:
+		return post.Command
+	case post.Restart:
+		logrus.Warnf("Restarting the process after sync isn't supported by the docker deployer: the container for %s is left as is", item.Image)
+		return nil
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestContainerSync(t *testing.T) {
+	tests := []struct {
+		description    string
+		item           *Item
+		expectedCopies []string
+		expectedExecs  []string
+	}{
+		{
+			description:    "copy",
+			item:           &Item{Image: "app:v1", Copy: map[string][]string{"testdata/file": {"/app/file"}}},
+			expectedCopies: []string{"app-id: /app/file"},
+		},
+		{
+			description:   "delete",
+			item:          &Item{Image: "app:v1", Delete: map[string][]string{"file": {"/app/file"}}},
+			expectedExecs: []string{"app-id: rm -rf -- /app/file"},
+		},
+		{
+			description: "signal after sync",
+			item: &Item{
+				Image:    "app:v1",
+				Artifact: &latest.Artifact{Sync: &latest.Sync{PostSync: &latest.PostSyncAction{Signal: "sighup"}}},
+				Delete:   map[string][]string{"file": {"/app/file"}},
+			},
+			expectedExecs: []string{"app-id: rm -rf -- /app/file", "app-id: kill -s HUP 1"},
+		},
+		{
+			description: "command after sync",
+			item: &Item{
+				Image:    "app:v1",
+				Artifact: &latest.Artifact{Sync: &latest.Sync{PostSync: &latest.PostSyncAction{Command: []string{"make", "reload"}}}},
+				Delete:   map[string][]string{"file": {"/app/file"}},
+			},
+			expectedExecs: []string{"app-id: rm -rf -- /app/file", "app-id: make reload"},
+		},
+		{
+			description: "other images are left as is",
+			item:        &Item{Image: "other:v1", Delete: map[string][]string{"file": {"/app/file"}}},
+		},
+		{
+			description: "nothing to sync",
+			item: &Item{
+				Image:    "app:v1",
+				Artifact: &latest.Artifact{Sync: &latest.Sync{PostSync: &latest.PostSyncAction{Command: []string{"make", "reload"}}}},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Touch("testdata/file").Chdir()
+			labels := docker.DeployerLabels("/project")
+			labels[docker.ImageLabel] = "app:v1"
+			// The same image run by another project is never synced.
+			otherLabels := docker.DeployerLabels("/other-project")
+			otherLabels[docker.ImageLabel] = "app:v1"
+			fakeClient := &testutil.FakeAPIClient{
+				Containers: []*testutil.FakeContainer{
+					{ID: "app-id", Name: "app", Config: container.Config{Labels: labels}},
+					{ID: "other-id", Name: "other", Config: container.Config{Labels: otherLabels}},
+				},
+			}
+			syncer := NewContainerSyncer(docker.NewLocalDaemon(fakeClient, nil, false, nil), "/project")
+
+			err := syncer.Sync(context.Background(), test.item)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedCopies, fakeClient.Copies)
+			t.CheckDeepEqual(test.expectedExecs, fakeClient.Execs)
+		})
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package helper implements the protocol used to sync files into containers
+// that don't have `tar`. Skaffold streams the files over `exec` to a small
+// helper binary that is injected into the pods.
+//
+// This package is compiled into the helper binary and must only depend on the standard library.
+package helper
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+const (
+	// Magic starts every stream, so that the helper can reject streams of an incompatible version.
+	Magic = "SKAFFOLD-SYNC/1\n"
+
+	// VolumeName is the name of the volume the helper is installed into.
+	VolumeName = "skaffold-sync-helper"
+	// MountPath is where the helper volume is mounted in the containers.
+	MountPath = "/skaffold-sync"
+	// BinaryPath is the path of the helper binary in the containers.
+	BinaryPath = MountPath + "/" + BinaryName
+	// BinaryName is the name of the helper binary.
+	BinaryName = "skaffold-sync-helper"
+	// ImageName is the name of the image that installs the helper, relative to the helpers registry.
+	ImageName = "skaffold-sync-helper"
+)
+
+// Frame types.
+const (
+	opFile    byte = 'F'
+	opDir     byte = 'D'
+	opSymlink byte = 'L'
+	opRemove  byte = 'R'
+	opEnd     byte = 'E'
+)
+
+// maxPathLength bounds the length of the paths read from a stream.
+const maxPathLength = 64 * 1024
+
+// Writer encodes files and deletions into a stream.
+type Writer struct {
+	w       *bufio.Writer
+	started bool
+}
+
+// NewWriter creates a Writer that writes to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w)}
+}
+
+// CopyFile sends the local file, directory or symlink at src, to be written at dst.
+// Absolute symlinks and sockets are skipped, like when syncing with `tar`.
+func (w *Writer) CopyFile(src, dst string) error {
+	fi, err := os.Lstat(src)
+	if err != nil {
+		return err
+	}
+
+	mode := fi.Mode()
+	switch {
+	case mode&os.ModeSymlink != 0:
+		target, err := os.Readlink(src)
+		if err != nil {
+			return err
+		}
+		if filepath.IsAbs(target) {
+			return nil
+		}
+		return w.frame(opSymlink, dst, func() error {
+			return w.writeString(filepath.ToSlash(target))
+		})
+
+	case mode.IsDir():
+		return w.frame(opDir, dst, func() error {
+			return binary.Write(w.w, binary.BigEndian, uint32(mode.Perm()))
+		})
+
+	case mode.IsRegular():
+		f, err := os.Open(src)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		return w.frame(opFile, dst, func() error {
+			if err := binary.Write(w.w, binary.BigEndian, uint32(mode.Perm())); err != nil {
+				return err
+			}
+			if err := binary.Write(w.w, binary.BigEndian, uint64(fi.Size())); err != nil {
+				return err
+			}
+			n, err := io.Copy(w.w, f)
+			if err != nil {
+				return err
+			}
+			if n != fi.Size() {
+				return fmt.Errorf("%s changed while being synced", src)
+			}
+			return nil
+		})
+
+	default:
+		return nil
+	}
+}
+
+// Remove asks for dst to be removed, recursively.
+func (w *Writer) Remove(dst string) error {
+	return w.frame(opRemove, dst, nil)
+}
+
+// Close ends the stream. It doesn't close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	if err := w.w.WriteByte(opEnd); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+func (w *Writer) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	_, err := w.w.WriteString(Magic)
+	return err
+}
+
+func (w *Writer) frame(op byte, dst string, body func() error) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	if err := w.w.WriteByte(op); err != nil {
+		return err
+	}
+	if err := w.writeString(path.Clean(filepath.ToSlash(dst))); err != nil {
+		return err
+	}
+	if body == nil {
+		return nil
+	}
+	return body()
+}
+
+func (w *Writer) writeString(s string) error {
+	if err := binary.Write(w.w, binary.BigEndian, uint32(len(s))); err != nil {
+		return err
+	}
+	_, err := w.w.WriteString(s)
+	return err
+}
+
+// Receive reads a stream and applies it to the file system, under root.
+// Files are written to a temporary file first and renamed, so that
+// running processes never see partially written files.
+func Receive(r io.Reader, root string) error {
+	br := bufio.NewReader(r)
+
+	magic := make([]byte, len(Magic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return fmt.Errorf("reading stream header: %w", err)
+	}
+	if string(magic) != Magic {
+		return errors.New("unsupported stream: skaffold and the sync helper have different versions")
+	}
+
+	for {
+		op, err := br.ReadByte()
+		if err != nil {
+			return fmt.Errorf("reading frame: %w", err)
+		}
+		if op == opEnd {
+			return nil
+		}
+
+		name, err := readString(br)
+		if err != nil {
+			return fmt.Errorf("reading path: %w", err)
+		}
+		dst := filepath.Join(root, filepath.FromSlash(path.Clean("/"+name)))
+
+		switch op {
+		case opFile:
+			var mode uint32
+			var size uint64
+			if err := binary.Read(br, binary.BigEndian, &mode); err != nil {
+				return fmt.Errorf("reading mode of %s: %w", name, err)
+			}
+			if err := binary.Read(br, binary.BigEndian, &size); err != nil {
+				return fmt.Errorf("reading size of %s: %w", name, err)
+			}
+			if err := writeFile(dst, os.FileMode(mode), io.LimitReader(br, int64(size)), int64(size)); err != nil {
+				return err
+			}
+
+		case opDir:
+			var mode uint32
+			if err := binary.Read(br, binary.BigEndian, &mode); err != nil {
+				return fmt.Errorf("reading mode of %s: %w", name, err)
+			}
+			if err := os.MkdirAll(dst, os.FileMode(mode)); err != nil {
+				return err
+			}
+
+		case opSymlink:
+			target, err := readString(br)
+			if err != nil {
+				return fmt.Errorf("reading target of %s: %w", name, err)
+			}
+			if err := writeSymlink(dst, filepath.FromSlash(target)); err != nil {
+				return err
+			}
+
+		case opRemove:
+			if err := os.RemoveAll(dst); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("unknown frame type %q", op)
+		}
+	}
+}
+
+func writeFile(dst string, mode os.FileMode, content io.Reader, size int64) error {
+	dir := filepath.Dir(dst)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(dst)+".sync")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, content)
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing %s: %w", dst, err)
+	}
+	if n != size {
+		tmp.Close()
+		return fmt.Errorf("writing %s: %w", dst, io.ErrUnexpectedEOF)
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
+
+func writeSymlink(dst, target string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dst); err != nil {
+		return err
+	}
+	return os.Symlink(target, dst)
+}
+
+func readString(r io.Reader) (string, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return "", err
+	}
+	if length > maxPathLength {
+		return "", fmt.Errorf("path too long: %d bytes", length)
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
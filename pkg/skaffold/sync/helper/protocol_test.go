@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRoundTrip(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("file modes and symlinks are not supported on windows")
+		}
+
+		src := t.NewTempDir().
+			Write("app.js", "console.log('hello')").
+			Write("run.sh", "#!/bin/sh").
+			Mkdir("static")
+		t.CheckNoError(os.Symlink("app.js", src.Path("link.js")))
+		t.CheckNoError(os.Chmod(src.Path("app.js"), 0644))
+		t.CheckNoError(os.Chmod(src.Path("run.sh"), 0755))
+		root := t.NewTempDir().Write("app/old.js", "old").Write("app/app.js", "previous")
+
+		var stream bytes.Buffer
+		w := NewWriter(&stream)
+		t.CheckNoError(w.CopyFile(src.Path("app.js"), "/app/app.js"))
+		t.CheckNoError(w.CopyFile(src.Path("run.sh"), "/app/bin/run.sh"))
+		t.CheckNoError(w.CopyFile(src.Path("static"), "/app/static"))
+		t.CheckNoError(w.CopyFile(src.Path("link.js"), "/app/link.js"))
+		t.CheckNoError(w.Remove("/app/old.js"))
+		t.CheckNoError(w.Close())
+
+		err := Receive(&stream, root.Root())
+		t.CheckNoError(err)
+
+		content, err := ioutil.ReadFile(root.Path("app/app.js"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual("console.log('hello')", string(content))
+
+		fi, err := os.Stat(root.Path("app/bin/run.sh"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(os.FileMode(0755), fi.Mode().Perm())
+
+		fi, err = os.Stat(root.Path("app/static"))
+		t.CheckNoError(err)
+		t.CheckTrue(fi.IsDir())
+
+		target, err := os.Readlink(root.Path("app/link.js"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual("app.js", target)
+
+		_, err = os.Stat(root.Path("app/old.js"))
+		t.CheckTrue(os.IsNotExist(err))
+
+		files, err := ioutil.ReadDir(root.Path("app"))
+		t.CheckNoError(err)
+		for _, f := range files {
+			t.CheckFalse(strings.HasPrefix(f.Name(), "."))
+		}
+	})
+}
+
+func TestReceive(t *testing.T) {
+	tests := []struct {
+		description string
+		stream      func(w *Writer) error
+		raw         string
+		shouldErr   bool
+	}{
+		{
+			description: "empty stream",
+			stream:      func(w *Writer) error { return nil },
+		},
+		{
+			description: "paths can't escape the root",
+			stream:      func(w *Writer) error { return w.Remove("../../outside") },
+		},
+		{
+			description: "wrong version",
+			raw:         "SKAFFOLD-SYNC/0\nE",
+			shouldErr:   true,
+		},
+		{
+			description: "truncated stream",
+			raw:         Magic + "F\x00\x00\x00\x04/app",
+			shouldErr:   true,
+		},
+		{
+			description: "unknown frame",
+			raw:         Magic + "X\x00\x00\x00\x04/app",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			parent := t.NewTempDir().Write("outside", "keep")
+			root := filepath.Join(parent.Root(), "root")
+
+			var stream bytes.Buffer
+			if test.stream != nil {
+				w := NewWriter(&stream)
+				t.CheckNoError(test.stream(w))
+				t.CheckNoError(w.Close())
+			} else {
+				stream.WriteString(test.raw)
+			}
+
+			err := Receive(&stream, root)
+
+			t.CheckError(test.shouldErr, err)
+			content, err := ioutil.ReadFile(parent.Path("outside"))
+			t.CheckNoError(err)
+			t.CheckDeepEqual("keep", string(content))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+)
+
+// signals maps the signal names accepted by a post-sync action to their numbers on Linux,
+// where the helper runs. The numbers are spelled out so that Skaffold can validate the
+// names on every platform.
+var signals = map[string]syscall.Signal{
+	"HUP":   1,
+	"INT":   2,
+	"QUIT":  3,
+	"KILL":  9,
+	"USR1":  10,
+	"USR2":  12,
+	"TERM":  15,
+	"WINCH": 28,
+}
+
+// restartSignal is the signal that makes the supervisor restart its command.
+var restartSignal = signals["HUP"]
+
+// ParseSignal parses a signal name such as `HUP` or `SIGHUP`.
+func ParseSignal(name string) (syscall.Signal, error) {
+	sig, found := signals[strings.TrimPrefix(strings.ToUpper(name), "SIG")]
+	if !found {
+		return 0, fmt.Errorf("unknown signal %q", name)
+	}
+	return sig, nil
+}
+
+// Signal sends the named signal to a process.
+func Signal(name string, pid int) error {
+	sig, err := ParseSignal(name)
+	if err != nil {
+		return err
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return process.Signal(sig)
+}
+
+// Restart asks the supervisor, running as PID 1, to restart its command.
+func Restart() error {
+	process, err := os.FindProcess(1)
+	if err != nil {
+		return err
+	}
+	return process.Signal(restartSignal)
+}
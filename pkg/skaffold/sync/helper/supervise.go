@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// restartGracePeriod is how long the supervisor waits for its command to stop before killing it.
+const restartGracePeriod = 10 * time.Second
+
+// Supervise runs a command and restarts it each time the supervisor receives SIGHUP.
+// Other signals are forwarded to the command. When the command exits on its own,
+// Supervise returns its exit code.
+func Supervise(args []string) (int, error) {
+	if len(args) == 0 {
+		return 0, errors.New("no command to supervise")
+	}
+
+	forwarded := []os.Signal{restartSignal}
+	for _, sig := range signals {
+		if sig != restartSignal && sig != signals["KILL"] {
+			forwarded = append(forwarded, sig)
+		}
+	}
+	received := make(chan os.Signal, 1)
+	signal.Notify(received, forwarded...)
+	defer signal.Stop(received)
+
+	return supervise(args, received, restartGracePeriod)
+}
+
+func supervise(args []string, received <-chan os.Signal, gracePeriod time.Duration) (int, error) {
+	for {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Start(); err != nil {
+			return 0, err
+		}
+
+		exited := make(chan error, 1)
+		go func() { exited <- cmd.Wait() }()
+
+		if code, done, err := waitOrRestart(cmd, exited, received, gracePeriod); done {
+			return code, err
+		}
+	}
+}
+
+// waitOrRestart waits for the command to exit, forwarding signals to it.
+// It returns with done set to false when the command was stopped to be restarted.
+func waitOrRestart(cmd *exec.Cmd, exited <-chan error, received <-chan os.Signal, gracePeriod time.Duration) (int, bool, error) {
+	for {
+		select {
+		case err := <-exited:
+			code, err := exitCode(err)
+			return code, true, err
+
+		case sig := <-received:
+			if sig != restartSignal {
+				cmd.Process.Signal(sig)
+				continue
+			}
+
+			cmd.Process.Signal(syscall.SIGTERM)
+			select {
+			case <-exited:
+			case <-time.After(gracePeriod):
+				cmd.Process.Kill()
+				<-exited
+			}
+			return 0, false, nil
+		}
+	}
+}
+
+// exitCode follows the shell conventions: a command killed by a signal exits with 128 + the signal number.
+func exitCode(err error) (int, error) {
+	if err == nil {
+		return 0, nil
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return 0, err
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return 128 + int(status.Signal()), nil
+	}
+	return exitErr.ExitCode(), nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSuperviseExitCode(t *testing.T) {
+	tests := []struct {
+		description string
+		script      string
+		expected    int
+	}{
+		{
+			description: "success",
+			script:      "exit 0",
+			expected:    0,
+		},
+		{
+			description: "failure",
+			script:      "exit 3",
+			expected:    3,
+		},
+		{
+			description: "killed by a signal",
+			script:      "kill -TERM $$",
+			expected:    143,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if runtime.GOOS == "windows" {
+				t.Skip("signals are not supported on windows")
+			}
+
+			code, err := supervise([]string{"sh", "-c", test.script}, nil, time.Second)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, code)
+		})
+	}
+}
+
+func TestSuperviseRestart(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("signals are not supported on windows")
+		}
+
+		runs := t.NewTempDir().Path("runs")
+		received := make(chan os.Signal)
+		go func() {
+			waitForRuns(runs, 1)
+			received <- syscall.SIGHUP
+			waitForRuns(runs, 2)
+			received <- syscall.SIGTERM
+		}()
+
+		code, err := supervise([]string{"sh", "-c", "echo run >> " + runs + "; exec sleep 30"}, received, time.Second)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(143, code)
+		t.CheckDeepEqual(2, countRuns(runs))
+	})
+}
+
+func TestParseSignal(t *testing.T) {
+	tests := []struct {
+		name      string
+		expected  syscall.Signal
+		shouldErr bool
+	}{
+		{name: "HUP", expected: 1},
+		{name: "SIGUSR1", expected: 10},
+		{name: "term", expected: 15},
+		{name: "SIGFOO", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.name, func(t *testutil.T) {
+			sig, err := ParseSignal(test.name)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, sig)
+		})
+	}
+}
+
+func waitForRuns(path string, count int) {
+	for countRuns(path) < count {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func countRuns(path string) int {
+	content, _ := ioutil.ReadFile(path)
+	return strings.Count(string(content), "run\n")
+}
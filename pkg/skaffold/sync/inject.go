@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
+)
+
+const helperInitContainer = "install-skaffold-sync-helper"
+
+// For testing
+var (
+	decodeFromYaml     = scheme.Codecs.UniversalDeserializer().Decode
+	retrieveEntrypoint = retrieveImageEntrypoint
+)
+
+// entrypointRetriever returns the entrypoint and the arguments configured in an image.
+type entrypointRetriever func(image string) ([]string, []string, error)
+
+// InjectHelper adds the sync helper to the pods running artifacts that may be synced without `tar`.
+// An init container copies the helper into a volume that is mounted in the artifacts' containers.
+// The entrypoint of the containers that are restarted after each sync is wrapped with the helper's supervisor.
+func InjectHelper(l manifest.ManifestList, builds []build.Artifact, artifacts []*latest.Artifact, registries manifest.Registries) (manifest.ManifestList, error) {
+	images := map[string]*latest.Artifact{}
+	for _, a := range artifacts {
+		if !needsHelper(a) {
+			continue
+		}
+		for _, b := range builds {
+			if b.ImageName == a.ImageName {
+				images[b.Tag] = a
+			}
+		}
+	}
+	if len(images) == 0 {
+		return l, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	retriever := func(image string) ([]string, []string, error) {
+		return retrieveEntrypoint(ctx, image, registries.InsecureRegistries)
+	}
+
+	image := fmt.Sprintf("%s/%s", registries.DebugHelpersRegistry, helper.ImageName)
+
+	var updated manifest.ManifestList
+	for _, m := range l {
+		obj, _, err := decodeFromYaml(m, nil, nil)
+		if err != nil {
+			logrus.Debugf("Unable to interpret manifest for the sync helper: %v\n", err)
+		} else if injectHelper(obj, images, image, retriever) {
+			m, err = yaml.Marshal(obj)
+			if err != nil {
+				return nil, fmt.Errorf("marshalling yaml: %w", err)
+			}
+		}
+		updated = append(updated, m)
+	}
+
+	return updated, nil
+}
+
+func injectHelper(obj runtime.Object, images map[string]*latest.Artifact, image string, retriever entrypointRetriever) bool {
+	switch o := obj.(type) {
+	case *v1.Pod:
+		return injectHelperInPodSpec(&o.Spec, images, image, retriever)
+	case *v1.PodList:
+		changed := false
+		for i := range o.Items {
+			if injectHelperInPodSpec(&o.Items[i].Spec, images, image, retriever) {
+				changed = true
+			}
+		}
+		return changed
+	case *v1.ReplicationController:
+		return injectHelperInPodSpec(&o.Spec.Template.Spec, images, image, retriever)
+	case *appsv1.Deployment:
+		return injectHelperInPodSpec(&o.Spec.Template.Spec, images, image, retriever)
+	case *appsv1.DaemonSet:
+		return injectHelperInPodSpec(&o.Spec.Template.Spec, images, image, retriever)
+	case *appsv1.ReplicaSet:
+		return injectHelperInPodSpec(&o.Spec.Template.Spec, images, image, retriever)
+	case *appsv1.StatefulSet:
+		return injectHelperInPodSpec(&o.Spec.Template.Spec, images, image, retriever)
+	case *batchv1.Job:
+		return injectHelperInPodSpec(&o.Spec.Template.Spec, images, image, retriever)
+	default:
+		return false
+	}
+}
+
+func injectHelperInPodSpec(podSpec *v1.PodSpec, images map[string]*latest.Artifact, image string, retriever entrypointRetriever) bool {
+	for _, volume := range podSpec.Volumes {
+		if volume.Name == helper.VolumeName {
+			return false
+		}
+	}
+
+	mount := v1.VolumeMount{Name: helper.VolumeName, MountPath: helper.MountPath}
+	changed := false
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		a, found := images[container.Image]
+		if !found {
+			continue
+		}
+
+		container.VolumeMounts = append(container.VolumeMounts, mount)
+		changed = true
+
+		if post := a.Sync.PostSync; post != nil && post.Restart {
+			if err := wrapWithSupervisor(container, retriever); err != nil {
+				logrus.Warnf("Container %q won't be restarted after sync: %v", container.Name, err)
+			}
+		}
+	}
+	if !changed {
+		return false
+	}
+
+	podSpec.Volumes = append(podSpec.Volumes, v1.Volume{
+		Name:         helper.VolumeName,
+		VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+	})
+	podSpec.InitContainers = append(podSpec.InitContainers, v1.Container{
+		Name:         helperInitContainer,
+		Image:        image,
+		VolumeMounts: []v1.VolumeMount{mount},
+	})
+	return true
+}
+
+// wrapWithSupervisor runs the container's entrypoint under the helper's supervisor,
+// so that it can be restarted after each sync.
+func wrapWithSupervisor(container *v1.Container, retriever entrypointRetriever) error {
+	if len(container.Command) == 0 {
+		entrypoint, cmd, err := retriever(container.Image)
+		if err != nil {
+			return err
+		}
+
+		args := container.Args
+		if len(args) == 0 {
+			args = cmd
+		}
+		if len(entrypoint) == 0 {
+			entrypoint, args = args, nil
+		}
+		container.Command, container.Args = entrypoint, args
+	}
+
+	if len(container.Command) == 0 {
+		return errors.New("no entrypoint or command")
+	}
+	container.Command = append([]string{helper.BinaryPath, "supervise", "--"}, container.Command...)
+	return nil
+}
+
+// retrieveImageEntrypoint retrieves the entrypoint and the arguments configured in an image.
+func retrieveImageEntrypoint(ctx context.Context, image string, insecureRegistries map[string]bool) ([]string, []string, error) {
+	apiClient, err := docker.NewAPIClient(&runcontext.RunContext{
+		InsecureRegistries: insecureRegistries,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not connect to local docker daemon: %w", err)
+	}
+
+	// the apiClient will go to the remote registry if local docker daemon is not available
+	config, err := apiClient.ConfigFile(ctx, image)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving image config for %q: %w", image, err)
+	}
+	return config.Config.Entrypoint, config.Config.Cmd, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestInjectHelper(t *testing.T) {
+	deployment := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - image: gcr.io/project/web:tag
+        name: web
+      - image: redis
+        name: redis
+`
+	injected := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  creationTimestamp: null
+  name: web
+spec:
+  selector:
+    matchLabels:
+      app: web
+  strategy: {}
+  template:
+    metadata:
+      creationTimestamp: null
+      labels:
+        app: web
+    spec:
+      containers:
+      - image: gcr.io/project/web:tag
+        name: web
+        resources: {}
+        volumeMounts:
+        - mountPath: /skaffold-sync
+          name: skaffold-sync-helper
+      - image: redis
+        name: redis
+        resources: {}
+      initContainers:
+      - image: gcr.io/k8s-skaffold/skaffold-debug-support/skaffold-sync-helper
+        name: install-skaffold-sync-helper
+        resources: {}
+        volumeMounts:
+        - mountPath: /skaffold-sync
+          name: skaffold-sync-helper
+      volumes:
+      - emptyDir: {}
+        name: skaffold-sync-helper
+status: {}
+`
+	supervised := strings.Replace(injected, "      - image: gcr.io/project/web:tag\n", `      - args:
+        - server.js
+        command:
+        - /skaffold-sync/skaffold-sync-helper
+        - supervise
+        - --
+        - node
+        image: gcr.io/project/web:tag
+`, 1)
+	builds := []build.Artifact{{ImageName: "gcr.io/project/web", Tag: "gcr.io/project/web:tag"}}
+
+	tests := []struct {
+		description string
+		sync        *latest.Sync
+		manifests   manifest.ManifestList
+		expected    manifest.ManifestList
+	}{
+		{
+			description: "helper transport",
+			sync:        &latest.Sync{Infer: []string{"**/*.js"}, Transport: "helper"},
+			manifests:   manifest.ManifestList{[]byte(deployment)},
+			expected:    manifest.ManifestList{[]byte(injected)},
+		},
+		{
+			description: "auto transport",
+			sync:        &latest.Sync{Infer: []string{"**/*.js"}, Transport: "auto"},
+			manifests:   manifest.ManifestList{[]byte(deployment)},
+			expected:    manifest.ManifestList{[]byte(injected)},
+		},
+		{
+			description: "auto by default",
+			sync:        &latest.Sync{Infer: []string{"**/*.js"}},
+			manifests:   manifest.ManifestList{[]byte(deployment)},
+			expected:    manifest.ManifestList{[]byte(injected)},
+		},
+		{
+			description: "tar transport",
+			sync:        &latest.Sync{Infer: []string{"**/*.js"}, Transport: "tar"},
+			manifests:   manifest.ManifestList{[]byte(deployment)},
+			expected:    manifest.ManifestList{[]byte(deployment)},
+		},
+		{
+			description: "already injected",
+			sync:        &latest.Sync{Infer: []string{"**/*.js"}, Transport: "helper"},
+			manifests:   manifest.ManifestList{[]byte(injected)},
+			expected:    manifest.ManifestList{[]byte(injected)},
+		},
+		{
+			description: "tar transport",
+			sync:        &latest.Sync{Infer: []string{"**/*.js"}, Transport: "tar"},
+			manifests:   manifest.ManifestList{[]byte(deployment)},
+			expected:    manifest.ManifestList{[]byte(deployment)},
+		},
+		{
+			description: "restart",
+			sync:        &latest.Sync{Infer: []string{"**/*.js"}, PostSync: &latest.PostSyncAction{Restart: true}},
+			manifests:   manifest.ManifestList{[]byte(deployment)},
+			expected:    manifest.ManifestList{[]byte(supervised)},
+		},
+		{
+			description: "no sync",
+			manifests:   manifest.ManifestList{[]byte(deployment)},
+			expected:    manifest.ManifestList{[]byte(deployment)},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&retrieveEntrypoint, func(context.Context, string, map[string]bool) ([]string, []string, error) {
+				return []string{"node"}, []string{"server.js"}, nil
+			})
+			artifacts := []*latest.Artifact{{ImageName: "gcr.io/project/web", Sync: test.sync}}
+
+			actual, err := InjectHelper(test.manifests, builds, artifacts, manifest.Registries{DebugHelpersRegistry: "gcr.io/k8s-skaffold/skaffold-debug-support"})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected.String(), actual.String())
+		})
+	}
+}
+
+func TestWrapWithSupervisor(t *testing.T) {
+	tests := []struct {
+		description string
+		container   v1.Container
+		entrypoint  []string
+		cmd         []string
+		retrieveErr error
+		expected    v1.Container
+		shouldErr   bool
+	}{
+		{
+			description: "command in manifest",
+			container:   v1.Container{Command: []string{"node"}, Args: []string{"server.js"}},
+			expected:    v1.Container{Command: []string{"/skaffold-sync/skaffold-sync-helper", "supervise", "--", "node"}, Args: []string{"server.js"}},
+		},
+		{
+			description: "image entrypoint and cmd",
+			entrypoint:  []string{"node"},
+			cmd:         []string{"server.js"},
+			expected:    v1.Container{Command: []string{"/skaffold-sync/skaffold-sync-helper", "supervise", "--", "node"}, Args: []string{"server.js"}},
+		},
+		{
+			description: "image entrypoint and args in manifest",
+			container:   v1.Container{Args: []string{"other.js"}},
+			entrypoint:  []string{"node"},
+			cmd:         []string{"server.js"},
+			expected:    v1.Container{Command: []string{"/skaffold-sync/skaffold-sync-helper", "supervise", "--", "node"}, Args: []string{"other.js"}},
+		},
+		{
+			description: "image cmd only",
+			cmd:         []string{"python", "app.py"},
+			expected:    v1.Container{Command: []string{"/skaffold-sync/skaffold-sync-helper", "supervise", "--", "python", "app.py"}},
+		},
+		{
+			description: "no entrypoint",
+			shouldErr:   true,
+		},
+		{
+			description: "image not found",
+			retrieveErr: errors.New("not found"),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			container := test.container
+
+			err := wrapWithSupervisor(&container, func(string) ([]string, []string, error) {
+				return test.entrypoint, test.cmd, test.retrieveErr
+			})
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, container)
+			}
+		})
+	}
+}
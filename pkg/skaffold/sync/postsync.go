@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
+)
+
+// PostSyncAction describes the action applied to an artifact's containers after each sync.
+// It returns an empty string when there's none.
+func PostSyncAction(a *latest.Artifact) string {
+	if a == nil || a.Sync == nil || a.Sync.PostSync == nil {
+		return ""
+	}
+
+	post := a.Sync.PostSync
+	switch {
+	case post.Signal != "":
+		return "signal " + post.Signal
+	case len(post.Command) > 0:
+		return "command " + strings.Join(post.Command, " ")
+	case post.Restart:
+		return "restart"
+	default:
+		return ""
+	}
+}
+
+// postSyncCommand returns the command that applies an artifact's post-sync action in a container.
+func postSyncCommand(a *latest.Artifact) []string {
+	if a == nil || a.Sync == nil || a.Sync.PostSync == nil {
+		return nil
+	}
+
+	post := a.Sync.PostSync
+	switch {
+	case post.Signal != "":
+		return []string{helper.BinaryPath, "signal", post.Signal, "1"}
+	case len(post.Command) > 0:
+		return post.Command
+	case post.Restart:
+		return []string{helper.BinaryPath, "restart"}
+	default:
+		return nil
+	}
+}
+
+func (s *podSyncer) postSync(ctx context.Context, item *Item) error {
+	command := postSyncCommand(item.Artifact)
+	if command == nil {
+		return nil
+	}
+
+	logrus.Infof("Running post-sync action (%s) in %s", PostSyncAction(item.Artifact), item.Image)
+	err := forEachContainer(ctx, item.Image, s.namespaces, func(ctx context.Context, pod v1.Pod, container v1.Container) error {
+		var stderr bytes.Buffer
+		if err := execInContainer(ctx, pod, container.Name, command, nil, &stderr); err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return fmt.Errorf("%s/%s: %w: %s", pod.Name, container.Name, err, msg)
+			}
+			return fmt.Errorf("%s/%s: %w", pod.Name, container.Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("running post-sync action: %w", err)
+	}
+	return nil
+}
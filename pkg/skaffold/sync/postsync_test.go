@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPostSync(t *testing.T) {
+	tests := []struct {
+		description    string
+		postSync       *latest.PostSyncAction
+		delete         syncMap
+		postSyncErr    error
+		expectedAction string
+		expectedExecs  []string
+		shouldErr      bool
+	}{
+		{
+			description:   "none",
+			expectedExecs: []string{"container_name: /skaffold-sync/skaffold-sync-helper receive"},
+		},
+		{
+			description:    "signal",
+			postSync:       &latest.PostSyncAction{Signal: "HUP"},
+			expectedAction: "signal HUP",
+			expectedExecs:  []string{"container_name: /skaffold-sync/skaffold-sync-helper receive", "container_name: /skaffold-sync/skaffold-sync-helper signal HUP 1"},
+		},
+		{
+			description:    "command",
+			postSync:       &latest.PostSyncAction{Command: []string{"nginx", "-s", "reload"}},
+			expectedAction: "command nginx -s reload",
+			expectedExecs:  []string{"container_name: /skaffold-sync/skaffold-sync-helper receive", "container_name: nginx -s reload"},
+		},
+		{
+			description:    "restart",
+			postSync:       &latest.PostSyncAction{Restart: true},
+			expectedAction: "restart",
+			expectedExecs:  []string{"container_name: /skaffold-sync/skaffold-sync-helper receive", "container_name: /skaffold-sync/skaffold-sync-helper restart"},
+		},
+		{
+			description:    "deleted files only",
+			postSync:       &latest.PostSyncAction{Restart: true},
+			delete:         syncMap{"old.js": {"/app/old.js"}},
+			expectedAction: "restart",
+			expectedExecs:  []string{"container_name: /skaffold-sync/skaffold-sync-helper receive", "container_name: /skaffold-sync/skaffold-sync-helper restart"},
+		},
+		{
+			description:    "failure",
+			postSync:       &latest.PostSyncAction{Command: []string{"nginx", "-s", "reload"}},
+			postSyncErr:    errors.New("command terminated with exit code 1"),
+			expectedAction: "command nginx -s reload",
+			expectedExecs:  []string{"container_name: /skaffold-sync/skaffold-sync-helper receive", "container_name: nginx -s reload"},
+			shouldErr:      true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&client.Client, func() (kubernetes.Interface, error) {
+				return fake.NewSimpleClientset(pod), nil
+			})
+			var execs []string
+			t.Override(&execInContainer, func(_ context.Context, _ v1.Pod, container string, command []string, stdin io.Reader, _ io.Writer) error {
+				execs = append(execs, container+": "+strings.Join(command, " "))
+				if stdin != nil {
+					ioutil.ReadAll(stdin)
+					return nil
+				}
+				return test.postSyncErr
+			})
+			artifact := &latest.Artifact{Sync: &latest.Sync{Transport: "helper", PostSync: test.postSync}}
+			item := &Item{Image: "gcr.io/k8s-skaffold:123", Artifact: artifact, Delete: test.delete}
+			if test.delete == nil {
+				item.Copy = syncMap{t.NewTempDir().Write("app.js", "").Path("app.js"): {"/app/app.js"}}
+			}
+
+			syncer := &podSyncer{namespaces: []string{""}, hasTarCache: map[string]bool{}}
+			err := syncer.Sync(context.Background(), item)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedExecs, execs)
+			t.CheckDeepEqual(test.expectedAction, PostSyncAction(artifact))
+		})
+	}
+}
@@ -0,0 +1,345 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bmatcuk/doublestar"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// reversePollInterval is how often the containers are checked for changed files.
+const reversePollInterval = 2 * time.Second
+
+// ReverseSyncer copies the files generated in the containers back to the local workspace.
+// It periodically lists the checksums of the files matching the `sync.reverse` rules
+// and copies the files that changed with `kubectl cp`.
+type ReverseSyncer struct {
+	kubectl    *pkgkubectl.CLI
+	namespaces []string
+	artifacts  []*latest.Artifact
+
+	// labelSelector restricts the copies to the pods deployed by this run
+	labelSelector string
+
+	buildsMutex sync.Mutex
+	builds      []build.Artifact
+
+	// checksums records the last checksum seen for each file of each container.
+	checksums map[string]string
+	stop      chan struct{}
+}
+
+// NewReverseSyncer creates a ReverseSyncer for the artifacts that have reverse sync rules.
+// It returns nil if there are none.
+func NewReverseSyncer(cli *pkgkubectl.CLI, namespaces []string, labelSelector string, artifacts []*latest.Artifact) *ReverseSyncer {
+	var reversed []*latest.Artifact
+	for _, a := range artifacts {
+		if a.Sync != nil && len(a.Sync.Reverse) > 0 {
+			reversed = append(reversed, a)
+		}
+	}
+	if len(reversed) == 0 {
+		return nil
+	}
+
+	return &ReverseSyncer{
+		kubectl:       cli,
+		namespaces:    namespaces,
+		artifacts:     reversed,
+		labelSelector: labelSelector,
+		checksums:     map[string]string{},
+		stop:          make(chan struct{}),
+	}
+}
+
+// Start polls the containers until the context is cancelled or Stop is called.
+func (r *ReverseSyncer) Start(ctx context.Context, builds []build.Artifact) {
+	if r == nil {
+		return
+	}
+
+	r.UpdateBuilds(builds)
+	go func() {
+		ticker := time.NewTicker(reversePollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-r.stop:
+				return
+			case <-ticker.C:
+				r.poll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops polling the containers.
+func (r *ReverseSyncer) Stop() {
+	if r != nil {
+		close(r.stop)
+	}
+}
+
+// UpdateBuilds sets the images to look for after each build.
+func (r *ReverseSyncer) UpdateBuilds(builds []build.Artifact) {
+	if r == nil {
+		return
+	}
+
+	r.buildsMutex.Lock()
+	r.builds = builds
+	r.buildsMutex.Unlock()
+}
+
+func (r *ReverseSyncer) poll(ctx context.Context) {
+	r.buildsMutex.Lock()
+	builds := r.builds
+	r.buildsMutex.Unlock()
+
+	for _, a := range r.artifacts {
+		tag := latestTag(a.ImageName, builds)
+		if tag == "" {
+			continue
+		}
+		if err := r.reverseSync(ctx, a, tag); err != nil {
+			logrus.Warnf("Copying files back from %s: %v", a.ImageName, err)
+		}
+	}
+}
+
+// reverseSync copies the changed files from the first running container of an image.
+// Replicas are expected to generate the same files.
+func (r *ReverseSyncer) reverseSync(ctx context.Context, a *latest.Artifact, image string) error {
+	pod, container, found, err := firstContainer(ctx, image, r.namespaces, r.labelSelector)
+	if err != nil || !found {
+		return err
+	}
+
+	for _, rule := range a.Sync.Reverse {
+		base := staticPrefix(rule.Src)
+		checksums, err := r.listChecksums(ctx, pod, container, base)
+		if err != nil {
+			logrus.Debugf("Listing %s in %s/%s: %v", base, pod.Name, container, err)
+			continue
+		}
+
+		for _, file := range sortedFiles(checksums) {
+			if matches, err := doublestar.Match(rule.Src, file); err != nil || !matches {
+				continue
+			}
+
+			key := string(pod.UID) + "/" + container + ":" + file
+			if r.checksums[key] == checksums[file] {
+				continue
+			}
+
+			rel := strings.TrimPrefix(strings.TrimPrefix(file, base), "/")
+			local := filepath.Join(a.Workspace, rule.Dest, filepath.FromSlash(rel))
+			if fileChecksum(local) != checksums[file] {
+				if err := r.copyBack(ctx, pod, container, file, local); err != nil {
+					return err
+				}
+			}
+			r.checksums[key] = checksums[file]
+		}
+	}
+
+	return nil
+}
+
+// listChecksums lists the sha256 checksums of the files under a folder of a container.
+func (r *ReverseSyncer) listChecksums(ctx context.Context, pod v1.Pod, container, dir string) (map[string]string, error) {
+	cmd := r.kubectl.Command(ctx, "exec", pod.Name, "--namespace", pod.Namespace, "-c", container, "--", "find", dir, "-type", "f", "-exec", "sha256sum", "{}", "+")
+	out, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		// Lines look like `<checksum>  <path>`
+		parts := strings.SplitN(scanner.Text(), "  ", 2)
+		if len(parts) == 2 {
+			checksums[parts[1]] = parts[0]
+		}
+	}
+	return checksums, scanner.Err()
+}
+
+// copyBack copies a file from a container with `kubectl cp` and records it,
+// so that it isn't synced back to the container. The file is first copied outside
+// of the workspace and only moved into place once its modification time is recorded,
+// so the file monitor never sees a partial copy.
+func (r *ReverseSyncer) copyBack(ctx context.Context, pod v1.Pod, container, file, local string) error {
+	if err := os.MkdirAll(filepath.Dir(local), 0755); err != nil {
+		return fmt.Errorf("creating folder for %q: %w", local, err)
+	}
+
+	tmpDir, err := ioutil.TempDir("", "skaffold-reverse-sync")
+	if err != nil {
+		return fmt.Errorf("creating temporary folder: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	tmp := filepath.Join(tmpDir, filepath.Base(local))
+
+	logrus.Infof("Copying %s from %s/%s to %s", file, pod.Name, container, local)
+	cmd := r.kubectl.Command(ctx, "cp", pod.Namespace+"/"+pod.Name+":"+file, tmp, "-c", container)
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("copying %s: %w", file, err)
+	}
+
+	stat, err := os.Stat(tmp)
+	if err != nil {
+		return fmt.Errorf("copying %s: %w", file, err)
+	}
+	filemon.Written(local, stat.ModTime())
+	if err := moveFile(tmp, local, stat); err != nil {
+		return fmt.Errorf("moving %s to %q: %w", file, local, err)
+	}
+	return nil
+}
+
+// moveFile renames a file, or copies it with its modification time when
+// both paths are on different devices.
+func moveFile(src, dst string, stat os.FileInfo) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, stat.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Chtimes(dst, stat.ModTime(), stat.ModTime())
+}
+
+// firstContainer finds the first running container, sorted by pod name, that runs an image
+// in the pods matching a label selector.
+func firstContainer(ctx context.Context, image string, namespaces []string, labelSelector string) (v1.Pod, string, bool, error) {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return v1.Pod{}, "", false, fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	var running []v1.Pod
+	for _, ns := range namespaces {
+		pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: labelSelector,
+		})
+		if err != nil {
+			return v1.Pod{}, "", false, fmt.Errorf("getting pods for namespace %q: %w", ns, err)
+		}
+		for _, p := range pods.Items {
+			if p.Status.Phase == v1.PodRunning {
+				running = append(running, p)
+			}
+		}
+	}
+
+	sort.Slice(running, func(i, j int) bool { return running[i].Name < running[j].Name })
+	for _, p := range running {
+		for _, c := range p.Spec.Containers {
+			if c.Image == image {
+				return p, c.Name, true, nil
+			}
+		}
+	}
+	return v1.Pod{}, "", false, nil
+}
+
+// staticPrefix returns the longest folder of a glob pattern that has no wildcards.
+func staticPrefix(pattern string) string {
+	var dirs []string
+	for _, dir := range strings.Split(path.Dir(pattern), "/") {
+		if strings.ContainsAny(dir, "*?[{\\") {
+			break
+		}
+		dirs = append(dirs, dir)
+	}
+
+	prefix := strings.Join(dirs, "/")
+	if prefix == "" {
+		return "/"
+	}
+	return prefix
+}
+
+func sortedFiles(checksums map[string]string) []string {
+	files := make([]string, 0, len(checksums))
+	for file := range checksums {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// fileChecksum returns the sha256 checksum of a local file, or an empty string if it can't be read.
+func fileChecksum(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
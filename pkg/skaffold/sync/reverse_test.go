@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestReverseSync(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		workspace := t.NewTempDir().
+			Write("gen/a.go", "old").
+			Write("gen/b.go", "package gen")
+		// A pod from another deployment that runs the same image
+		other := pod.DeepCopy()
+		other.Name = "another-pod"
+		other.Labels = map[string]string{"app.kubernetes.io/managed-by": "other"}
+		t.Override(&client.Client, func() (kubernetes.Interface, error) {
+			return fake.NewSimpleClientset(pod, other), nil
+		})
+		cmd := &fakeKubectlCp{
+			find: "kubectl --context  exec podname --namespace  -c container_name -- find /app/gen -type f -exec sha256sum {} +",
+			checksums: "1111  /app/gen/a.go\n" +
+				fileChecksum(workspace.Path("gen/b.go")) + "  /app/gen/b.go\n" +
+				"3333  /app/gen/c.txt\n",
+			content: "generated",
+		}
+		t.Override(&util.DefaultExecCommand, cmd)
+
+		artifacts := []*latest.Artifact{
+			{
+				ImageName: "gcr.io/k8s-skaffold",
+				Workspace: workspace.Root(),
+				Sync:      &latest.Sync{Reverse: []*latest.ReverseSyncRule{{Src: "/app/gen/**/*.go", Dest: "gen"}}},
+			},
+			{
+				ImageName: "gcr.io/other",
+				Sync:      &latest.Sync{Infer: []string{"**/*.js"}},
+			},
+		}
+		r := NewReverseSyncer(&pkgkubectl.CLI{}, []string{""}, "app.kubernetes.io/managed-by=skaffold", artifacts)
+		r.UpdateBuilds([]build.Artifact{{ImageName: "gcr.io/k8s-skaffold", Tag: "gcr.io/k8s-skaffold:123"}})
+		r.poll(context.Background())
+
+		t.CheckDeepEqual(1, len(r.artifacts))
+		t.CheckDeepEqual(map[string]string{
+			"/container_name:/app/gen/a.go": "1111",
+			"/container_name:/app/gen/b.go": fileChecksum(workspace.Path("gen/b.go")),
+		}, r.checksums)
+		t.CheckDeepEqual([]string{"/podname:/app/gen/a.go"}, cmd.copied)
+		// The file is copied outside of the workspace and then moved into place.
+		t.CheckFalse(strings.HasPrefix(cmd.dests[0], workspace.Root()))
+		content, err := ioutil.ReadFile(workspace.Path("gen/a.go"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual("generated", string(content))
+	})
+}
+
+// fakeKubectlCp lists checksums and fakes `kubectl cp` by writing the copied file.
+type fakeKubectlCp struct {
+	find      string
+	checksums string
+	content   string
+	copied    []string
+	dests     []string
+}
+
+func (f *fakeKubectlCp) RunCmdOut(cmd *exec.Cmd) ([]byte, error) {
+	if command := strings.Join(cmd.Args, " "); command != f.find {
+		return nil, fmt.Errorf("unexpected command: %s", command)
+	}
+	return []byte(f.checksums), nil
+}
+
+func (f *fakeKubectlCp) RunCmd(cmd *exec.Cmd) error {
+	// kubectl --context <context> cp <src> <dst> -c <container>
+	if len(cmd.Args) < 6 || cmd.Args[3] != "cp" {
+		return fmt.Errorf("unexpected command: %s", strings.Join(cmd.Args, " "))
+	}
+	f.copied = append(f.copied, cmd.Args[4])
+	f.dests = append(f.dests, cmd.Args[5])
+	return ioutil.WriteFile(cmd.Args[5], []byte(f.content), 0644)
+}
+
+func TestNoReverseSyncer(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		r := NewReverseSyncer(&pkgkubectl.CLI{}, nil, "", []*latest.Artifact{{ImageName: "img", Sync: &latest.Sync{Infer: []string{"**/*.js"}}}})
+
+		t.CheckTrue(r == nil)
+		r.Start(context.Background(), nil)
+		r.UpdateBuilds(nil)
+		r.Stop()
+	})
+}
+
+func TestStaticPrefix(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{pattern: "/app/gen/**/*.go", expected: "/app/gen"},
+		{pattern: "/app/*.lock", expected: "/app"},
+		{pattern: "/app/migrations/001.sql", expected: "/app/migrations"},
+		{pattern: "/app/{gen,out}/*", expected: "/app"},
+		{pattern: "/*.lock", expected: "/"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.pattern, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, staticPrefix(test.pattern))
+		})
+	}
+}
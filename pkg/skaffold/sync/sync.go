@@ -99,7 +99,7 @@ func syncItem(a *latest.Artifact, tag string, e filemon.Events, syncRules []*lat
 		return nil, nil
 	}
 
-	return &Item{Image: tag, Copy: toCopy, Delete: toDelete}, nil
+	return &Item{Image: tag, Artifact: a, Copy: toCopy, Delete: toDelete}, nil
 }
 
 func inferredSyncItem(a *latest.Artifact, tag string, e filemon.Events, cfg docker.Config) (*Item, error) {
@@ -143,7 +143,7 @@ func inferredSyncItem(a *latest.Artifact, tag string, e filemon.Events, cfg dock
 		}
 	}
 
-	return &Item{Image: tag, Copy: toCopy}, nil
+	return &Item{Image: tag, Artifact: a, Copy: toCopy}, nil
 }
 
 func syncMapForArtifact(a *latest.Artifact, cfg docker.Config) (map[string][]string, error) {
@@ -186,7 +186,7 @@ func autoSyncItem(ctx context.Context, a *latest.Artifact, tag string, e filemon
 			// do a rebuild
 			return nil, nil
 		}
-		return &Item{Image: tag, Copy: toCopy, Delete: toDelete}, nil
+		return &Item{Image: tag, Artifact: a, Copy: toCopy, Delete: toDelete}, nil
 
 	default:
 		// TODO: this error does appear a little late in the build, perhaps it could surface at first run, rather than first sync?
@@ -252,6 +252,44 @@ func matchSyncRules(syncRules []*latest.SyncRule, relPath, containerWd string) (
 }
 
 func (s *podSyncer) Sync(ctx context.Context, item *Item) error {
+	if err := s.syncFiles(ctx, item); err != nil {
+		return err
+	}
+	if len(item.Copy) == 0 && len(item.Delete) == 0 {
+		return nil
+	}
+	return s.postSync(ctx, item)
+}
+
+func (s *podSyncer) syncFiles(ctx context.Context, item *Item) error {
+	transport := syncTransport(item.Artifact)
+	if transport == tarTransport {
+		return s.syncWithTar(ctx, item)
+	}
+
+	if len(item.Copy) > 0 {
+		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
+	}
+	if len(item.Delete) > 0 {
+		logrus.Infoln("Deleting files:", item.Delete, "from", item.Image)
+	}
+	if len(item.Copy) == 0 && len(item.Delete) == 0 {
+		return nil
+	}
+
+	err := forEachContainer(ctx, item.Image, s.namespaces, func(ctx context.Context, pod v1.Pod, container v1.Container) error {
+		if transport == autoTransport && s.hasTar(ctx, pod, container) {
+			return s.syncContainerWithTar(ctx, pod, container, item)
+		}
+		return s.syncContainerWithHelper(ctx, pod, container, item)
+	})
+	if err != nil {
+		return fmt.Errorf("syncing files: %w", err)
+	}
+	return nil
+}
+
+func (s *podSyncer) syncWithTar(ctx context.Context, item *Item) error {
 	if len(item.Copy) > 0 {
 		logrus.Infoln("Copying files:", item.Copy, "to", item.Image)
 
@@ -276,6 +314,14 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 		return nil
 	}
 
+	return forEachContainer(ctx, image, namespaces, func(ctx context.Context, pod v1.Pod, container v1.Container) error {
+		_, err := util.RunCmdOut(cmdFn(ctx, pod, container, files))
+		return err
+	})
+}
+
+// forEachContainer concurrently runs fn on each running container of the given image.
+func forEachContainer(ctx context.Context, image string, namespaces []string, fn func(context.Context, v1.Pod, v1.Container) error) error {
 	errs, ctx := errgroup.WithContext(ctx)
 
 	client, err := kubernetesclient.Client()
@@ -300,10 +346,9 @@ func Perform(ctx context.Context, image string, files syncMap, cmdFn func(contex
 					continue
 				}
 
-				cmd := cmdFn(ctx, p, c, files)
+				p, c := p, c
 				errs.Go(func() error {
-					_, err := util.RunCmdOut(cmd)
-					return err
+					return fn(ctx, p, c)
 				})
 				numSynced++
 			}
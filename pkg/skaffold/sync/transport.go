@@ -0,0 +1,198 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	autoTransport   = "auto"
+	tarTransport    = "tar"
+	helperTransport = "helper"
+)
+
+// For testing
+var execInContainer = execWithClient
+
+// syncTransport returns how files are synced into the containers of an artifact.
+func syncTransport(a *latest.Artifact) string {
+	if a == nil || a.Sync == nil || a.Sync.Transport == "" {
+		return autoTransport
+	}
+	return a.Sync.Transport
+}
+
+// needsHelper tells whether the sync helper should be added to the pods running an artifact.
+// It's added unless the artifact is explicitly synced with `tar` and has no post-sync action.
+func needsHelper(a *latest.Artifact) bool {
+	if a.Sync == nil {
+		return false
+	}
+	if post := a.Sync.PostSync; post != nil && (post.Signal != "" || post.Restart) {
+		return true
+	}
+	return syncTransport(a) != tarTransport
+}
+
+// hasTar checks, once per container, whether `tar` can be run.
+func (s *podSyncer) hasTar(ctx context.Context, pod v1.Pod, container v1.Container) bool {
+	key := string(pod.UID) + "/" + container.Name
+
+	s.hasTarMutex.Lock()
+	found, checked := s.hasTarCache[key]
+	s.hasTarMutex.Unlock()
+	if checked {
+		return found
+	}
+
+	err := execInContainer(ctx, pod, container.Name, []string{"tar", "--version"}, nil, ioutil.Discard)
+	found = err == nil
+	if !found {
+		logrus.Debugf("Using the sync helper for %s/%s: tar not available (%s)", pod.Name, container.Name, err)
+	}
+
+	s.hasTarMutex.Lock()
+	s.hasTarCache[key] = found
+	s.hasTarMutex.Unlock()
+	return found
+}
+
+func (s *podSyncer) syncContainerWithTar(ctx context.Context, pod v1.Pod, container v1.Container, item *Item) error {
+	if len(item.Copy) > 0 {
+		if _, err := util.RunCmdOut(s.copyFileFn(ctx, pod, container, item.Copy)); err != nil {
+			return fmt.Errorf("copying files: %w", err)
+		}
+	}
+	if len(item.Delete) > 0 {
+		if _, err := util.RunCmdOut(s.deleteFileFn(ctx, pod, container, item.Delete)); err != nil {
+			return fmt.Errorf("deleting files: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *podSyncer) syncContainerWithHelper(ctx context.Context, pod v1.Pod, container v1.Container, item *Item) error {
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeHelperStream(writer, item.Copy, item.Delete))
+	}()
+
+	var stderr bytes.Buffer
+	if err := execInContainer(ctx, pod, container.Name, []string{helper.BinaryPath, "receive"}, reader, &stderr); err != nil {
+		reader.CloseWithError(err)
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("syncing files to %s/%s with the sync helper: %w: %s", pod.Name, container.Name, err, msg)
+		}
+		return fmt.Errorf("syncing files to %s/%s with the sync helper: %w. The helper is added to the pods by the kubectl, kustomize and kpt deployers", pod.Name, container.Name, err)
+	}
+	return nil
+}
+
+// writeHelperStream encodes the files to copy and delete for the sync helper.
+func writeHelperStream(w io.Writer, copy, delete syncMap) error {
+	hw := helper.NewWriter(w)
+
+	for _, src := range sortedKeys(copy) {
+		for _, dst := range copy[src] {
+			if err := hw.CopyFile(src, dst); err != nil {
+				return err
+			}
+		}
+	}
+	for _, src := range sortedKeys(delete) {
+		for _, dst := range delete[src] {
+			if err := hw.Remove(dst); err != nil {
+				return err
+			}
+		}
+	}
+
+	return hw.Close()
+}
+
+func sortedKeys(m syncMap) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// execWithClient runs a command in a container with the Kubernetes exec API.
+func execWithClient(ctx context.Context, pod v1.Pod, container string, command []string, stdin io.Reader, stderr io.Writer) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	config, err := kubectx.GetRestClientConfig()
+	if err != nil {
+		return fmt.Errorf("getting client config for Kubernetes client: %w", err)
+	}
+
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	// The executor doesn't take a context, so the stream is closed when the context is cancelled.
+	done := make(chan error, 1)
+	go func() {
+		done <- executor.Stream(remotecommand.StreamOptions{
+			Stdin:  stdin,
+			Stdout: ioutil.Discard,
+			Stderr: stderr,
+		})
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
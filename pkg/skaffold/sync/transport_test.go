@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync/helper"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncTransports(t *testing.T) {
+	tests := []struct {
+		description   string
+		transport     string
+		hasTar        bool
+		helperErr     error
+		expectedExecs []string
+		expectedCmds  []string
+		expectSynced  bool
+		shouldErr     bool
+	}{
+		{
+			description:  "tar transport",
+			transport:    "tar",
+			expectedCmds: []string{"kubectl --context  exec podname --namespace  -c container_name -i -- tar xmf - -C / --no-same-owner", "kubectl --context  exec podname --namespace  -c container_name -- rm -rf -- /app/old.js"},
+		},
+		{
+			description:   "auto by default",
+			hasTar:        true,
+			expectedExecs: []string{"container_name: tar --version"},
+			expectedCmds:  []string{"kubectl --context  exec podname --namespace  -c container_name -i -- tar xmf - -C / --no-same-owner", "kubectl --context  exec podname --namespace  -c container_name -- rm -rf -- /app/old.js"},
+		},
+		{
+			description:   "auto with tar",
+			transport:     "auto",
+			hasTar:        true,
+			expectedExecs: []string{"container_name: tar --version"},
+			expectedCmds:  []string{"kubectl --context  exec podname --namespace  -c container_name -i -- tar xmf - -C / --no-same-owner", "kubectl --context  exec podname --namespace  -c container_name -- rm -rf -- /app/old.js"},
+		},
+		{
+			description:   "auto without tar",
+			transport:     "auto",
+			expectedExecs: []string{"container_name: tar --version", "container_name: /skaffold-sync/skaffold-sync-helper receive"},
+			expectSynced:  true,
+		},
+		{
+			description:   "helper transport",
+			transport:     "helper",
+			hasTar:        true,
+			expectedExecs: []string{"container_name: /skaffold-sync/skaffold-sync-helper receive"},
+			expectSynced:  true,
+		},
+		{
+			description:   "helper error",
+			transport:     "helper",
+			helperErr:     errors.New("command terminated with exit code 1"),
+			expectedExecs: []string{"container_name: /skaffold-sync/skaffold-sync-helper receive"},
+			shouldErr:     true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			src := t.NewTempDir().Write("app.js", "console.log('hello')")
+			root := t.NewTempDir().Write("app/old.js", "old")
+
+			cmdRecord := &TestCmdRecorder{}
+			t.Override(&util.DefaultExecCommand, cmdRecord)
+			t.Override(&client.Client, func() (kubernetes.Interface, error) {
+				return fake.NewSimpleClientset(pod), nil
+			})
+			var execs []string
+			t.Override(&execInContainer, func(_ context.Context, _ v1.Pod, container string, command []string, stdin io.Reader, _ io.Writer) error {
+				execs = append(execs, container+": "+strings.Join(command, " "))
+				switch {
+				case command[0] == "tar" && !test.hasTar:
+					return errors.New("executable file not found in $PATH")
+				case command[0] == helper.BinaryPath && test.helperErr != nil:
+					ioutil.ReadAll(stdin)
+					return test.helperErr
+				case command[0] == helper.BinaryPath:
+					return helper.Receive(stdin, root.Root())
+				}
+				return nil
+			})
+
+			syncer := &podSyncer{kubectl: &pkgkubectl.CLI{}, namespaces: []string{""}, hasTarCache: map[string]bool{}}
+			err := syncer.Sync(context.Background(), &Item{
+				Image:    "gcr.io/k8s-skaffold:123",
+				Artifact: &latest.Artifact{Sync: &latest.Sync{Transport: test.transport}},
+				Copy:     syncMap{src.Path("app.js"): {"/app/app.js"}},
+				Delete:   syncMap{"old.js": {"/app/old.js"}},
+			})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedExecs, execs)
+			t.CheckDeepEqual(test.expectedCmds, cmdRecord.cmds)
+			if test.expectSynced {
+				content, err := ioutil.ReadFile(root.Path("app/app.js"))
+				t.CheckNoError(err)
+				t.CheckDeepEqual("console.log('hello')", string(content))
+				t.CheckFalse(util.IsFile(root.Path("app/old.js")))
+			}
+		})
+	}
+}
+
+func TestHasTarIsCached(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		calls := 0
+		t.Override(&execInContainer, func(context.Context, v1.Pod, string, []string, io.Reader, io.Writer) error {
+			calls++
+			return nil
+		})
+
+		syncer := &podSyncer{hasTarCache: map[string]bool{}}
+		t.CheckTrue(syncer.hasTar(context.Background(), *pod, pod.Spec.Containers[0]))
+		t.CheckTrue(syncer.hasTar(context.Background(), *pod, pod.Spec.Containers[0]))
+		t.CheckDeepEqual(1, calls)
+	})
+}
@@ -18,17 +18,20 @@ package sync
 
 import (
 	"context"
+	"sync"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 type syncMap map[string][]string
 
 type Item struct {
-	Image  string
-	Copy   map[string][]string
-	Delete map[string][]string
+	Image    string
+	Artifact *latest.Artifact
+	Copy     map[string][]string
+	Delete   map[string][]string
 }
 
 type Syncer interface {
@@ -38,6 +41,10 @@ type Syncer interface {
 type podSyncer struct {
 	kubectl    *pkgkubectl.CLI
 	namespaces []string
+
+	// hasTarCache records which containers have `tar`.
+	hasTarCache map[string]bool
+	hasTarMutex sync.Mutex
 }
 
 type Config interface {
@@ -48,7 +55,8 @@ type Config interface {
 
 func NewSyncer(cfg Config) Syncer {
 	return &podSyncer{
-		kubectl:    pkgkubectl.NewCLI(cfg, ""),
-		namespaces: cfg.GetNamespaces(),
+		kubectl:     pkgkubectl.NewCLI(cfg, ""),
+		namespaces:  cfg.GetNamespaces(),
+		hasTarCache: map[string]bool{},
 	}
 }
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// ignoreFunc tells if changes to a file or a directory can be ignored.
+// For a directory, it means that nothing it contains needs to be watched.
+type ignoreFunc func(path string, isDir bool) bool
+
+// workspaceRules are the ignore rules of an artifact, relative to its workspace.
+type workspaceRules struct {
+	workspace string
+	ignored   ignoreFunc
+}
+
+// ignoreRules builds the rules that tell which files don't need to be watched:
+//   - files ignored by git, according to the `.gitignore` files of the working
+//     directory and of the workspaces.
+//   - files that every artifact built from their workspace ignores, with a
+//     `.dockerignore` file or an `ignore` list.
+//   - the container logs that Skaffold writes with `--log-files`.
+func ignoreRules(wd string, artifacts []*latest.Artifact) ignoreFunc {
+	logsDir := filepath.Join(wd, constants.DefaultSkaffoldDir, constants.DefaultLogsDir)
+
+	patterns := []gitignore.Pattern{gitignore.ParsePattern(".git", nil)}
+	patterns = append(patterns, readGitignore(wd, wd)...)
+
+	var rules []workspaceRules
+	seen := map[string]bool{wd: true}
+	for _, a := range artifacts {
+		workspace := a.Workspace
+		if !filepath.IsAbs(workspace) {
+			workspace = filepath.Join(wd, workspace)
+		}
+
+		if !seen[workspace] {
+			seen[workspace] = true
+			patterns = append(patterns, readGitignore(wd, workspace)...)
+		}
+
+		rules = append(rules, workspaceRules{
+			workspace: workspace,
+			ignored:   artifactIgnoreRules(workspace, a),
+		})
+	}
+
+	git := gitignore.NewMatcher(patterns)
+
+	return func(path string, isDir bool) bool {
+		if util.IsSubPath(logsDir, path) {
+			return true
+		}
+		if rel, err := filepath.Rel(wd, path); err == nil && rel != "." {
+			if git.Match(strings.Split(rel, string(filepath.Separator)), isDir) {
+				return true
+			}
+		}
+
+		found := false
+		for _, r := range rules {
+			rel, err := filepath.Rel(r.workspace, path)
+			if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				continue
+			}
+			if !r.ignored(path, isDir) {
+				return false
+			}
+			found = true
+		}
+		return found
+	}
+}
+
+// artifactIgnoreRules reads the ignore rules of an artifact.
+func artifactIgnoreRules(workspace string, a *latest.Artifact) ignoreFunc {
+	var excludes []string
+	switch {
+	case a.DockerArtifact != nil:
+		absDockerfilePath, err := docker.NormalizeDockerfilePath(workspace, a.DockerArtifact.DockerfilePath)
+		if err != nil {
+			logrus.Debugf("Not ignoring files of %s: %s", a.ImageName, err)
+			return neverIgnored
+		}
+		patterns, err := docker.ReadDockerignore(workspace, absDockerfilePath)
+		if err != nil {
+			logrus.Warnf("Not ignoring files of %s: reading .dockerignore: %s", a.ImageName, err)
+			return neverIgnored
+		}
+		dockerIgnored, err := docker.NewDockerIgnorePredicate(workspace, patterns)
+		if err != nil {
+			logrus.Warnf("Not ignoring files of %s: %s", a.ImageName, err)
+			return neverIgnored
+		}
+
+		return func(path string, isDir bool) bool {
+			ignored, err := dockerIgnored(path, dirent{name: filepath.Base(path), isDir: isDir})
+			if isDir {
+				// A directory can only be skipped if none of the files it contains is an exception.
+				return err == filepath.SkipDir
+			}
+			return ignored && err == nil
+		}
+
+	case a.CustomArtifact != nil && a.CustomArtifact.Dependencies != nil:
+		excludes = a.CustomArtifact.Dependencies.Ignore
+	case a.BuildpackArtifact != nil && a.BuildpackArtifact.Dependencies != nil:
+		excludes = a.BuildpackArtifact.Dependencies.Ignore
+	}
+
+	return func(path string, _ bool) bool {
+		rel, err := filepath.Rel(workspace, path)
+		if err != nil {
+			return false
+		}
+		for _, exclude := range excludes {
+			if matches, err := filepath.Match(exclude, rel); err == nil && matches {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func neverIgnored(string, bool) bool { return false }
+
+// readGitignore reads the patterns of the `.gitignore` file found in a directory.
+func readGitignore(wd, dir string) []gitignore.Pattern {
+	f, err := os.Open(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var domain []string
+	if rel, err := filepath.Rel(wd, dir); err == nil && rel != "." {
+		domain = strings.Split(rel, string(filepath.Separator))
+	}
+
+	var patterns []gitignore.Pattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	return patterns
+}
+
+// dirent describes a file system entry that might not exist anymore.
+type dirent struct {
+	name  string
+	isDir bool
+}
+
+func (d dirent) IsDir() bool  { return d.isDir }
+func (d dirent) Name() string { return d.name }
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestIgnoreRules(t *testing.T) {
+	tests := []struct {
+		description string
+		path        string
+		isDir       bool
+		expected    bool
+	}{
+		{
+			description: "source file",
+			path:        "app/main.go",
+		},
+		{
+			description: "git metadata",
+			path:        ".git",
+			isDir:       true,
+			expected:    true,
+		},
+		{
+			description: "ignored by .gitignore",
+			path:        "app/node_modules",
+			isDir:       true,
+			expected:    true,
+		},
+		{
+			description: "ignored by the .gitignore of a workspace",
+			path:        "app/dist/bundle.js",
+			expected:    true,
+		},
+		{
+			description: "ignored by .dockerignore",
+			path:        "app/docs",
+			isDir:       true,
+			expected:    true,
+		},
+		{
+			description: "directory with exceptions in .dockerignore",
+			path:        "app/tmp",
+			isDir:       true,
+		},
+		{
+			description: "exception in .dockerignore",
+			path:        "app/tmp/keep",
+		},
+		{
+			description: "ignored by .dockerignore",
+			path:        "app/tmp/other",
+			expected:    true,
+		},
+		{
+			description: "ignored by the ignore list of a custom artifact",
+			path:        "custom/generated.go",
+			expected:    true,
+		},
+		{
+			description: "not ignored by all the artifacts of a workspace",
+			path:        "custom/docs",
+			isDir:       true,
+		},
+		{
+			description: ".dockerignore doesn't apply outside of the workspace",
+			path:        "docs",
+			isDir:       true,
+		},
+		{
+			description: "container logs",
+			path:        ".skaffold/logs",
+			isDir:       true,
+			expected:    true,
+		},
+		{
+			description: "container log file",
+			path:        ".skaffold/logs/pod/container.log",
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().
+				Write(".gitignore", "# dependencies\nnode_modules/\n").
+				Write("app/.gitignore", "dist\n").
+				Write("app/.dockerignore", "docs\ntmp\n!tmp/keep\n").
+				Write("custom/.dockerignore", "docs\n*.go\n")
+
+			ignored := ignoreRules(tmpDir.Root(), []*latest.Artifact{
+				{
+					Workspace: "app",
+					ArtifactType: latest.ArtifactType{
+						DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+					},
+				},
+				{
+					Workspace: "custom",
+					ArtifactType: latest.ArtifactType{
+						DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+					},
+				},
+				{
+					Workspace: "custom",
+					ArtifactType: latest.ArtifactType{
+						CustomArtifact: &latest.CustomArtifact{
+							Dependencies: &latest.CustomDependencies{
+								Paths:  []string{"."},
+								Ignore: []string{"*.go"},
+							},
+						},
+					},
+				},
+			})
+
+			t.CheckDeepEqual(test.expected, ignored(tmpDir.Path(test.path), test.isDir))
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
@@ -48,7 +49,8 @@ type Config interface {
 }
 
 // NewTrigger creates a new trigger.
-func NewTrigger(cfg Config, isActive func() bool) (Trigger, error) {
+// The notify trigger reports the files that changed to the monitor.
+func NewTrigger(cfg Config, isActive func() bool, monitor filemon.Monitor) (Trigger, error) {
 	switch strings.ToLower(cfg.Trigger()) {
 	case "polling":
 		return &pollTrigger{
@@ -56,7 +58,7 @@ func NewTrigger(cfg Config, isActive func() bool) (Trigger, error) {
 			isActive: isActive,
 		}, nil
 	case "notify":
-		return newFSNotifyTrigger(cfg, isActive), nil
+		return newFSNotifyTrigger(cfg, isActive, monitor), nil
 	case "manual":
 		return &manualTrigger{
 			isActive: isActive,
@@ -66,16 +68,21 @@ func NewTrigger(cfg Config, isActive func() bool) (Trigger, error) {
 	}
 }
 
-func newFSNotifyTrigger(cfg Config, isActive func() bool) *fsNotifyTrigger {
+func newFSNotifyTrigger(cfg Config, isActive func() bool, monitor filemon.Monitor) *fsNotifyTrigger {
+	artifacts := cfg.Pipeline().Build.Artifacts
 	workspaces := map[string]struct{}{}
-	for _, a := range cfg.Pipeline().Build.Artifacts {
+	for _, a := range artifacts {
 		workspaces[a.Workspace] = struct{}{}
 	}
 	return &fsNotifyTrigger{
 		Interval:   time.Duration(cfg.WatchPollInterval()) * time.Millisecond,
 		workspaces: workspaces,
+		artifacts:  artifacts,
 		isActive:   isActive,
+		monitor:    monitor,
+		recursive:  nativeRecursiveWatch,
 		watchFunc:  notify.Watch,
+		stopFunc:   notify.Stop,
 	}
 }
 
@@ -179,8 +186,12 @@ func (t *manualTrigger) Start(ctx context.Context) (<-chan bool, error) {
 type fsNotifyTrigger struct {
 	Interval   time.Duration
 	workspaces map[string]struct{}
+	artifacts  []*latest.Artifact
 	isActive   func() bool
+	monitor    filemon.Monitor
+	recursive  bool
 	watchFunc  func(path string, c chan<- notify.EventInfo, events ...notify.Event) error
+	stopFunc   func(c chan<- notify.EventInfo)
 }
 
 // Debounce tells the watcher to not debounce rapid sequence of changes.
@@ -207,18 +218,27 @@ func (t *fsNotifyTrigger) Start(ctx context.Context) (<-chan bool, error) {
 		return nil, err
 	}
 
-	// Watch current directory recursively
-	if err := t.watchFunc(filepath.Join(wd, "..."), c, notify.All); err != nil {
+	w := &watcher{
+		c:         c,
+		watchFunc: t.watchFunc,
+		recursive: t.recursive,
+		ignored:   ignoreRules(wd, t.artifacts),
+		monitor:   t.monitor,
+		watched:   map[string]bool{},
+	}
+
+	// Watch current directory
+	if err := w.watch(wd); err != nil {
 		return nil, err
 	}
 
-	// Watch all workspaces recursively
-	for w := range t.workspaces {
-		if w == "." {
+	// Watch all workspaces
+	for ws := range t.workspaces {
+		if ws == "." {
 			continue
 		}
 
-		if err := t.watchFunc(filepath.Join(wd, w, "..."), c, notify.All); err != nil {
+		if err := w.watch(filepath.Join(wd, ws)); err != nil {
 			return nil, err
 		}
 	}
@@ -235,6 +255,9 @@ func (t *fsNotifyTrigger) Start(ctx context.Context) (<-chan bool, error) {
 		for {
 			select {
 			case e := <-c:
+				if e != nil && !w.changed(e.Path()) {
+					continue
+				}
 
 				// Ignore detected changes if not active
 				if !t.isActive() {
@@ -249,6 +272,9 @@ func (t *fsNotifyTrigger) Start(ctx context.Context) (<-chan bool, error) {
 				trigger <- true
 			case <-ctx.Done():
 				timer.Stop()
+				if t.stopFunc != nil {
+					t.stopFunc(c)
+				}
 				return
 			}
 		}
@@ -56,7 +56,14 @@ func TestNewTrigger(t *testing.T) {
 					"../workspace":            {},
 					"../some/other/workspace": {},
 				},
+				artifacts: []*latest.Artifact{
+					{Workspace: "../workspace"},
+					{Workspace: "../workspace"},
+					{Workspace: "../some/other/workspace"},
+				},
+				recursive: nativeRecursiveWatch,
 				watchFunc: notify.Watch,
+				stopFunc:  notify.Stop,
 			},
 		},
 		{
@@ -82,11 +89,11 @@ func TestNewTrigger(t *testing.T) {
 				},
 			}
 
-			got, err := NewTrigger(cfg, nil)
+			got, err := NewTrigger(cfg, nil, nil)
 
 			t.CheckError(test.shouldErr, err)
 			if !test.shouldErr {
-				t.CheckDeepEqual(test.expected, got, cmp.AllowUnexported(fsNotifyTrigger{}), cmp.Comparer(ignoreFuncComparer), cmp.AllowUnexported(manualTrigger{}), cmp.AllowUnexported(pollTrigger{}))
+				t.CheckDeepEqual(test.expected, got, cmp.AllowUnexported(fsNotifyTrigger{}), cmp.Comparer(ignoreFuncComparer), cmp.Comparer(stopFuncComparer), cmp.AllowUnexported(manualTrigger{}), cmp.AllowUnexported(pollTrigger{}))
 			}
 		})
 	}
@@ -102,6 +109,10 @@ func ignoreFuncComparer(x, y func(path string, c chan<- notify.EventInfo, events
 	return true // cannot assert function equality, so skip
 }
 
+func stopFuncComparer(x, y func(c chan<- notify.EventInfo)) bool {
+	return (x == nil) == (y == nil)
+}
+
 func TestPollTrigger_Debounce(t *testing.T) {
 	trigger := &pollTrigger{}
 	got, want := trigger.Debounce(), true
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/rjeczalik/notify"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
+)
+
+// On macOS and Windows, the file system natively supports watching a whole tree.
+// Elsewhere, each directory has to be watched and it's best to skip the ignored ones.
+var nativeRecursiveWatch = runtime.GOOS == "darwin" || runtime.GOOS == "windows"
+
+// watcher registers the watches of the notify trigger and filters the events.
+// Ignored files and directories are not watched, unless a component depends on them.
+type watcher struct {
+	c         chan notify.EventInfo
+	watchFunc func(path string, c chan<- notify.EventInfo, events ...notify.Event) error
+	recursive bool
+	ignored   ignoreFunc
+	monitor   filemon.Monitor
+	watched   map[string]bool
+}
+
+// watch watches a directory and everything it contains.
+func (w *watcher) watch(root string) error {
+	if w.recursive {
+		if w.watched[root] {
+			return nil
+		}
+		w.watched[root] = true
+		return w.watchFunc(filepath.Join(root, "..."), w.c, notify.All)
+	}
+
+	return walk.From(root).Unsorted().When(func(path string, info walk.Dirent) (bool, error) {
+		if !info.IsDir() {
+			return false, nil
+		}
+		if path != root && w.skip(path, true) {
+			return false, filepath.SkipDir
+		}
+		return true, nil
+	}).Do(func(path string, _ walk.Dirent) error {
+		if w.watched[path] {
+			return nil
+		}
+		w.watched[path] = true
+		return w.watchFunc(path, w.c, notify.All)
+	})
+}
+
+// changed handles a change to a file or a directory. It returns false if the change is ignored.
+func (w *watcher) changed(path string) bool {
+	info, err := os.Lstat(path)
+	exists := err == nil
+	isDir := (exists && info.IsDir()) || w.watched[path]
+
+	// When a file is gone, there's no telling if it was a file or a directory.
+	if w.skip(path, isDir) && (exists || w.skip(path, !isDir)) {
+		return false
+	}
+
+	if w.monitor != nil {
+		w.monitor.Changed(path)
+	}
+
+	switch {
+	case !exists && w.watched[path]:
+		w.forget(path)
+	case exists && isDir && !w.recursive && !w.watched[path]:
+		if err := w.watch(path); err != nil {
+			logrus.Warnf("Unable to watch %s: %s", path, err)
+		}
+	}
+
+	return true
+}
+
+// forget forgets about a directory that was removed, and its sub-directories,
+// so that they are watched again if they are recreated.
+func (w *watcher) forget(dir string) {
+	prefix := dir + string(filepath.Separator)
+	for path := range w.watched {
+		if path == dir || strings.HasPrefix(path, prefix) {
+			delete(w.watched, path)
+		}
+	}
+}
+
+func (w *watcher) skip(path string, isDir bool) bool {
+	if !w.ignored(path, isDir) {
+		return false
+	}
+	return w.monitor == nil || !w.monitor.DependsOn(path)
+}
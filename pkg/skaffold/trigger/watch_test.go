@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/rjeczalik/notify"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeMonitor struct {
+	filemon.Monitor
+	dependencies map[string]bool
+	changed      []string
+}
+
+func (f *fakeMonitor) Changed(paths ...string) {
+	f.changed = append(f.changed, paths...)
+}
+
+func (f *fakeMonitor) DependsOn(path string) bool {
+	return f.dependencies[path]
+}
+
+func TestWatcher(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write(".gitignore", "node_modules\nk8s\n").
+			Touch("src/main.go", "node_modules/lib/index.js", "k8s/pod.yaml")
+
+		var watched []string
+		monitor := &fakeMonitor{dependencies: map[string]bool{tmpDir.Path("k8s"): true}}
+		w := &watcher{
+			watchFunc: func(path string, _ chan<- notify.EventInfo, _ ...notify.Event) error {
+				watched = append(watched, path)
+				return nil
+			},
+			ignored: ignoreRules(tmpDir.Root(), nil),
+			monitor: monitor,
+			watched: map[string]bool{},
+		}
+
+		err := w.watch(tmpDir.Root())
+		t.CheckNoError(err)
+
+		// Ignored directories are not watched, unless something depends on them.
+		sort.Strings(watched)
+		t.CheckDeepEqual(tmpDir.Paths("", "k8s", "src"), watched)
+
+		// Changes to ignored files are not reported.
+		t.CheckFalse(w.changed(tmpDir.Path("node_modules/lib/index.js")))
+		t.CheckTrue(w.changed(tmpDir.Path("src/main.go")))
+		t.CheckDeepEqual([]string{tmpDir.Path("src/main.go")}, monitor.changed)
+
+		// New directories are watched.
+		tmpDir.Touch("src/pkg/lib.go")
+		t.CheckTrue(w.changed(tmpDir.Path("src/pkg")))
+		t.CheckDeepEqual(tmpDir.Path("src/pkg"), watched[len(watched)-1])
+
+		// Removed directories are forgotten.
+		tmpDir.Remove("src/pkg/lib.go").Remove("src/pkg")
+		t.CheckTrue(w.changed(tmpDir.Path("src/pkg")))
+		t.CheckFalse(w.watched[tmpDir.Path("src/pkg")])
+	})
+}
+
+func TestWatcherRecursive(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("src/main.go")
+
+		var watched []string
+		w := &watcher{
+			watchFunc: func(path string, _ chan<- notify.EventInfo, _ ...notify.Event) error {
+				watched = append(watched, path)
+				return nil
+			},
+			recursive: true,
+			ignored:   ignoreRules(tmpDir.Root(), nil),
+			watched:   map[string]bool{},
+		}
+
+		t.CheckNoError(w.watch(tmpDir.Root()))
+		t.CheckNoError(w.watch(tmpDir.Root()))
+
+		t.CheckDeepEqual([]string{tmpDir.Path("...")}, watched)
+	})
+}
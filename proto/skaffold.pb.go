@@ -123,6 +123,10 @@ const (
 	DeployerType_KUSTOMIZE DeployerType = 2
 	// Kubectl Deployer
 	DeployerType_KUBECTL DeployerType = 3
+	// Docker Deployer
+	DeployerType_DOCKER DeployerType = 4
+	// Docker Compose Deployer
+	DeployerType_COMPOSE DeployerType = 5
 )
 
 var DeployerType_name = map[int32]string{
@@ -130,6 +134,8 @@ var DeployerType_name = map[int32]string{
 	1: "HELM",
 	2: "KUSTOMIZE",
 	3: "KUBECTL",
+	4: "DOCKER",
+	5: "COMPOSE",
 }
 
 var DeployerType_value = map[string]int32{
@@ -137,6 +143,8 @@ var DeployerType_value = map[string]int32{
 	"HELM":                  1,
 	"KUSTOMIZE":             2,
 	"KUBECTL":               3,
+	"DOCKER":                4,
+	"COMPOSE":               5,
 }
 
 func (x DeployerType) String() string {
@@ -249,6 +257,10 @@ const (
 	StatusCode_INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME StatusCode = 123
 	// Docker build error indicating the container referenced does not exists in the docker context used.
 	StatusCode_INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST StatusCode = 124
+	// Build error due to a lifecycle hook failure
+	StatusCode_BUILD_HOOK_ERR StatusCode = 125
+	// Build error due to unsupported or misconfigured target platforms
+	StatusCode_BUILD_PLATFORM_ERR StatusCode = 126
 	// Container image pull error
 	StatusCode_STATUSCHECK_IMAGE_PULL_ERR StatusCode = 300
 	// Container creating error
@@ -259,6 +271,16 @@ const (
 	StatusCode_STATUSCHECK_CONTAINER_TERMINATED StatusCode = 303
 	// Deployment waiting for rollout
 	StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING StatusCode = 304
+	// Standalone pods pending to stabilize
+	StatusCode_STATUSCHECK_STANDALONE_PODS_PENDING StatusCode = 305
+	// Job waiting for completion
+	StatusCode_STATUSCHECK_JOB_PENDING StatusCode = 306
+	// Job failed to complete
+	StatusCode_STATUSCHECK_JOB_FAILED StatusCode = 307
+	// Custom resource waiting for its ready rule
+	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_PENDING StatusCode = 308
+	// Custom resource matched its failed rule
+	StatusCode_STATUSCHECK_CUSTOM_RESOURCE_FAILED StatusCode = 309
 	// Container restarting error
 	StatusCode_STATUSCHECK_CONTAINER_RESTARTING StatusCode = 356
 	// Readiness probe failed
@@ -314,6 +336,8 @@ const (
 	StatusCode_BUILD_DOCKER_UNKNOWN StatusCode = 511
 	// File Sync Initialize failure
 	StatusCode_SYNC_INIT_ERROR StatusCode = 601
+	// File Sync error due to a lifecycle hook failure
+	StatusCode_SYNC_HOOK_ERR StatusCode = 602
 	// Failed to configure watcher for build dependencies in dev loop
 	StatusCode_DEVINIT_REGISTER_BUILD_DEPS StatusCode = 701
 	// Failed to configure watcher for test dependencies in dev loop
@@ -398,6 +422,8 @@ const (
 	StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR StatusCode = 1021
 	// Helm config `createNamespace` not available
 	StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE StatusCode = 1022
+	// Deploy error due to a lifecycle hook failure
+	StatusCode_DEPLOY_HOOK_ERR StatusCode = 1023
 )
 
 var StatusCode_name = map[int32]string{
@@ -429,11 +455,18 @@ var StatusCode_name = map[int32]string{
 	122:  "INIT_DOCKER_NETWORK_LISTING_CONTAINERS",
 	123:  "INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME",
 	124:  "INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST",
+	125:  "BUILD_HOOK_ERR",
+	126:  "BUILD_PLATFORM_ERR",
 	300:  "STATUSCHECK_IMAGE_PULL_ERR",
 	301:  "STATUSCHECK_CONTAINER_CREATING",
 	302:  "STATUSCHECK_RUN_CONTAINER_ERR",
 	303:  "STATUSCHECK_CONTAINER_TERMINATED",
 	304:  "STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING",
+	305:  "STATUSCHECK_STANDALONE_PODS_PENDING",
+	306:  "STATUSCHECK_JOB_PENDING",
+	307:  "STATUSCHECK_JOB_FAILED",
+	308:  "STATUSCHECK_CUSTOM_RESOURCE_PENDING",
+	309:  "STATUSCHECK_CUSTOM_RESOURCE_FAILED",
 	356:  "STATUSCHECK_CONTAINER_RESTARTING",
 	357:  "STATUSCHECK_UNHEALTHY",
 	400:  "STATUSCHECK_NODE_MEMORY_PRESSURE",
@@ -462,6 +495,7 @@ var StatusCode_name = map[int32]string{
 	510:  "INIT_UNKNOWN",
 	511:  "BUILD_DOCKER_UNKNOWN",
 	601:  "SYNC_INIT_ERROR",
+	602:  "SYNC_HOOK_ERR",
 	701:  "DEVINIT_REGISTER_BUILD_DEPS",
 	702:  "DEVINIT_REGISTER_TEST_DEPS",
 	703:  "DEVINIT_REGISTER_DEPLOY_DEPS",
@@ -504,6 +538,7 @@ var StatusCode_name = map[int32]string{
 	1020: "DEPLOY_MANIFEST_WRITE_ERR",
 	1021: "DEPLOY_PARSE_MANIFEST_IMAGES_ERR",
 	1022: "DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE",
+	1023: "DEPLOY_HOOK_ERR",
 }
 
 var StatusCode_value = map[string]int32{
@@ -535,11 +570,18 @@ var StatusCode_value = map[string]int32{
 	"INIT_DOCKER_NETWORK_LISTING_CONTAINERS":       122,
 	"INIT_DOCKER_NETWORK_INVALID_CONTAINER_NAME":   123,
 	"INIT_DOCKER_NETWORK_CONTAINER_DOES_NOT_EXIST": 124,
+	"BUILD_HOOK_ERR":                               125,
+	"BUILD_PLATFORM_ERR":                           126,
 	"STATUSCHECK_IMAGE_PULL_ERR":                   300,
 	"STATUSCHECK_CONTAINER_CREATING":               301,
 	"STATUSCHECK_RUN_CONTAINER_ERR":                302,
 	"STATUSCHECK_CONTAINER_TERMINATED":             303,
 	"STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING":       304,
+	"STATUSCHECK_STANDALONE_PODS_PENDING":          305,
+	"STATUSCHECK_JOB_PENDING":                      306,
+	"STATUSCHECK_JOB_FAILED":                       307,
+	"STATUSCHECK_CUSTOM_RESOURCE_PENDING":          308,
+	"STATUSCHECK_CUSTOM_RESOURCE_FAILED":           309,
 	"STATUSCHECK_CONTAINER_RESTARTING":             356,
 	"STATUSCHECK_UNHEALTHY":                        357,
 	"STATUSCHECK_NODE_MEMORY_PRESSURE":             400,
@@ -568,6 +610,7 @@ var StatusCode_value = map[string]int32{
 	"INIT_UNKNOWN":                                 510,
 	"BUILD_DOCKER_UNKNOWN":                         511,
 	"SYNC_INIT_ERROR":                              601,
+	"SYNC_HOOK_ERR":                                602,
 	"DEVINIT_REGISTER_BUILD_DEPS":                  701,
 	"DEVINIT_REGISTER_TEST_DEPS":                   702,
 	"DEVINIT_REGISTER_DEPLOY_DEPS":                 703,
@@ -610,6 +653,7 @@ var StatusCode_value = map[string]int32{
 	"DEPLOY_MANIFEST_WRITE_ERR":                    1020,
 	"DEPLOY_PARSE_MANIFEST_IMAGES_ERR":             1021,
 	"DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE":          1022,
+	"DEPLOY_HOOK_ERR":                              1023,
 }
 
 func (x StatusCode) String() string {
@@ -655,6 +699,8 @@ const (
 	SuggestionCode_FIX_DOCKER_NETWORK_CONTAINER_NAME SuggestionCode = 112
 	// Docker build network container not existing in the current context.
 	SuggestionCode_CHECK_DOCKER_NETWORK_CONTAINER_RUNNING SuggestionCode = 113
+	// Fix the target platforms of the artifact or push the images to a registry
+	SuggestionCode_FIX_PLATFORMS_CONFIG SuggestionCode = 114
 	// Check cluster connection
 	SuggestionCode_CHECK_CLUSTER_CONNECTION SuggestionCode = 201
 	// Check minikube status
@@ -723,6 +769,7 @@ var SuggestionCode_name = map[int32]string{
 	111: "FIX_JIB_PLUGIN_CONFIGURATION",
 	112: "FIX_DOCKER_NETWORK_CONTAINER_NAME",
 	113: "CHECK_DOCKER_NETWORK_CONTAINER_RUNNING",
+	114: "FIX_PLATFORMS_CONFIG",
 	201: "CHECK_CLUSTER_CONNECTION",
 	202: "CHECK_MINIKUBE_STATUS",
 	203: "INSTALL_HELM",
@@ -766,6 +813,7 @@ var SuggestionCode_value = map[string]int32{
 	"FIX_JIB_PLUGIN_CONFIGURATION":                111,
 	"FIX_DOCKER_NETWORK_CONTAINER_NAME":           112,
 	"CHECK_DOCKER_NETWORK_CONTAINER_RUNNING":      113,
+	"FIX_PLATFORMS_CONFIG":                        114,
 	"CHECK_CLUSTER_CONNECTION":                    201,
 	"CHECK_MINIKUBE_STATUS":                       202,
 	"INSTALL_HELM":                                203,
@@ -1511,6 +1559,7 @@ type Event struct {
 	//	*Event_DebuggingContainerEvent
 	//	*Event_DevLoopEvent
 	//	*Event_TerminationEvent
+	//	*Event_ApplicationLogEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1586,6 +1635,10 @@ type Event_TerminationEvent struct {
 	TerminationEvent *TerminationEvent `protobuf:"bytes,10,opt,name=terminationEvent,proto3,oneof"`
 }
 
+type Event_ApplicationLogEvent struct {
+	ApplicationLogEvent *ApplicationLogEvent `protobuf:"bytes,11,opt,name=applicationLogEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_BuildEvent) isEvent_EventType() {}
@@ -1606,6 +1659,8 @@ func (*Event_DevLoopEvent) isEvent_EventType() {}
 
 func (*Event_TerminationEvent) isEvent_EventType() {}
 
+func (*Event_ApplicationLogEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -1683,6 +1738,13 @@ func (m *Event) GetTerminationEvent() *TerminationEvent {
 	return nil
 }
 
+func (m *Event) GetApplicationLogEvent() *ApplicationLogEvent {
+	if x, ok := m.GetEventType().(*Event_ApplicationLogEvent); ok {
+		return x.ApplicationLogEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1696,6 +1758,7 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_DebuggingContainerEvent)(nil),
 		(*Event_DevLoopEvent)(nil),
 		(*Event_TerminationEvent)(nil),
+		(*Event_ApplicationLogEvent)(nil),
 	}
 }
 
@@ -2323,6 +2386,7 @@ type FileSyncEvent struct {
 	Err                  string         `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
 	ErrCode              StatusCode     `protobuf:"varint,5,opt,name=errCode,proto3,enum=proto.StatusCode" json:"errCode,omitempty"`
 	ActionableErr        *ActionableErr `protobuf:"bytes,6,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	PostSyncAction       string         `protobuf:"bytes,7,opt,name=postSyncAction,proto3" json:"postSyncAction,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2395,6 +2459,13 @@ func (m *FileSyncEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+func (m *FileSyncEvent) GetPostSyncAction() string {
+	if m != nil {
+		return m.PostSyncAction
+	}
+	return ""
+}
+
 // DebuggingContainerEvent is raised when a debugging container is started or terminated
 type DebuggingContainerEvent struct {
 	Status               string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -2693,12 +2764,13 @@ func (*TriggerState) XXX_OneofWrappers() []interface{} {
 
 // Intent represents user intents for a given phase.
 type Intent struct {
-	Build                bool     `protobuf:"varint,1,opt,name=build,proto3" json:"build,omitempty"`
-	Sync                 bool     `protobuf:"varint,2,opt,name=sync,proto3" json:"sync,omitempty"`
-	Deploy               bool     `protobuf:"varint,3,opt,name=deploy,proto3" json:"deploy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Build                bool        `protobuf:"varint,1,opt,name=build,proto3" json:"build,omitempty"`
+	Sync                 bool        `protobuf:"varint,2,opt,name=sync,proto3" json:"sync,omitempty"`
+	Deploy               bool        `protobuf:"varint,3,opt,name=deploy,proto3" json:"deploy,omitempty"`
+	Logs                 *LogsIntent `protobuf:"bytes,4,opt,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *Intent) Reset()         { *m = Intent{} }
@@ -2747,6 +2819,13 @@ func (m *Intent) GetDeploy() bool {
 	return false
 }
 
+func (m *Intent) GetLogs() *LogsIntent {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 // Suggestion defines the action a user needs to recover from an error.
 type Suggestion struct {
 	SuggestionCode       SuggestionCode `protobuf:"varint,1,opt,name=suggestionCode,proto3,enum=proto.SuggestionCode" json:"suggestionCode,omitempty"`
@@ -2851,6 +2930,454 @@ func (m *IntOrString) GetStrVal() string {
 	return ""
 }
 
+// `FileUpload` describes a change to a file of a local workspace, uploaded to a remote Skaffold.
+type FileUpload struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Mode                 uint32   `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Deleted              bool     `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileUpload) Reset()         { *m = FileUpload{} }
+func (m *FileUpload) String() string { return proto.CompactTextString(m) }
+func (*FileUpload) ProtoMessage()    {}
+func (*FileUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{30}
+}
+
+func (m *FileUpload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileUpload.Unmarshal(m, b)
+}
+func (m *FileUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileUpload.Marshal(b, m, deterministic)
+}
+func (m *FileUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileUpload.Merge(m, src)
+}
+func (m *FileUpload) XXX_Size() int {
+	return xxx_messageInfo_FileUpload.Size(m)
+}
+func (m *FileUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileUpload proto.InternalMessageInfo
+
+func (m *FileUpload) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FileUpload) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *FileUpload) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *FileUpload) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+// `UploadRequest` is a batch of file changes.
+type UploadRequest struct {
+	Files                []*FileUpload `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UploadRequest) Reset()         { *m = UploadRequest{} }
+func (m *UploadRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadRequest) ProtoMessage()    {}
+func (*UploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{31}
+}
+
+func (m *UploadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadRequest.Unmarshal(m, b)
+}
+func (m *UploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadRequest.Marshal(b, m, deterministic)
+}
+func (m *UploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadRequest.Merge(m, src)
+}
+func (m *UploadRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadRequest.Size(m)
+}
+func (m *UploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadRequest proto.InternalMessageInfo
+
+func (m *UploadRequest) GetFiles() []*FileUpload {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+// `UploadResponse` acknowledges a batch of file changes, once written to the workspace.
+type UploadResponse struct {
+	Written              int32    `protobuf:"varint,1,opt,name=written,proto3" json:"written,omitempty"`
+	Deleted              int32    `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadResponse) Reset()         { *m = UploadResponse{} }
+func (m *UploadResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadResponse) ProtoMessage()    {}
+func (*UploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{32}
+}
+
+func (m *UploadResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadResponse.Unmarshal(m, b)
+}
+func (m *UploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadResponse.Marshal(b, m, deterministic)
+}
+func (m *UploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadResponse.Merge(m, src)
+}
+func (m *UploadResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadResponse.Size(m)
+}
+func (m *UploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadResponse proto.InternalMessageInfo
+
+func (m *UploadResponse) GetWritten() int32 {
+	if m != nil {
+		return m.Written
+	}
+	return 0
+}
+
+func (m *UploadResponse) GetDeleted() int32 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+// `OutputEntry` is a chunk of what Skaffold prints to its terminal.
+type OutputEntry struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Content              []byte               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OutputEntry) Reset()         { *m = OutputEntry{} }
+func (m *OutputEntry) String() string { return proto.CompactTextString(m) }
+func (*OutputEntry) ProtoMessage()    {}
+func (*OutputEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{33}
+}
+
+func (m *OutputEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutputEntry.Unmarshal(m, b)
+}
+func (m *OutputEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutputEntry.Marshal(b, m, deterministic)
+}
+func (m *OutputEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputEntry.Merge(m, src)
+}
+func (m *OutputEntry) XXX_Size() int {
+	return xxx_messageInfo_OutputEntry.Size(m)
+}
+func (m *OutputEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputEntry proto.InternalMessageInfo
+
+func (m *OutputEntry) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *OutputEntry) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+// `ApplicationLogEvent` describes a line of log printed by a deployed container.
+type ApplicationLogEvent struct {
+	ContainerName        string   `protobuf:"bytes,1,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	PodName              string   `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Image                string   `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationLogEvent) Reset()         { *m = ApplicationLogEvent{} }
+func (m *ApplicationLogEvent) String() string { return proto.CompactTextString(m) }
+func (*ApplicationLogEvent) ProtoMessage()    {}
+func (*ApplicationLogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{34}
+}
+
+func (m *ApplicationLogEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationLogEvent.Unmarshal(m, b)
+}
+func (m *ApplicationLogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationLogEvent.Marshal(b, m, deterministic)
+}
+func (m *ApplicationLogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationLogEvent.Merge(m, src)
+}
+func (m *ApplicationLogEvent) XXX_Size() int {
+	return xxx_messageInfo_ApplicationLogEvent.Size(m)
+}
+func (m *ApplicationLogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationLogEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationLogEvent proto.InternalMessageInfo
+
+func (m *ApplicationLogEvent) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// LogsIntent changes the filters and highlight rules of the application logs
+type LogsIntent struct {
+	Include              []*LogSelector  `protobuf:"bytes,1,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude              []*LogSelector  `protobuf:"bytes,2,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	Filter               string          `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Level                string          `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	Highlight            []*LogHighlight `protobuf:"bytes,5,rep,name=highlight,proto3" json:"highlight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LogsIntent) Reset()         { *m = LogsIntent{} }
+func (m *LogsIntent) String() string { return proto.CompactTextString(m) }
+func (*LogsIntent) ProtoMessage()    {}
+func (*LogsIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{35}
+}
+
+func (m *LogsIntent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsIntent.Unmarshal(m, b)
+}
+func (m *LogsIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsIntent.Marshal(b, m, deterministic)
+}
+func (m *LogsIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsIntent.Merge(m, src)
+}
+func (m *LogsIntent) XXX_Size() int {
+	return xxx_messageInfo_LogsIntent.Size(m)
+}
+func (m *LogsIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsIntent proto.InternalMessageInfo
+
+func (m *LogsIntent) GetInclude() []*LogSelector {
+	if m != nil {
+		return m.Include
+	}
+	return nil
+}
+
+func (m *LogsIntent) GetExclude() []*LogSelector {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
+
+func (m *LogsIntent) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *LogsIntent) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *LogsIntent) GetHighlight() []*LogHighlight {
+	if m != nil {
+		return m.Highlight
+	}
+	return nil
+}
+
+// LogSelector selects containers by image, container name or labels of their pod
+type LogSelector struct {
+	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Container            string   `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	LabelSelector        string   `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogSelector) Reset()         { *m = LogSelector{} }
+func (m *LogSelector) String() string { return proto.CompactTextString(m) }
+func (*LogSelector) ProtoMessage()    {}
+func (*LogSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{36}
+}
+
+func (m *LogSelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogSelector.Unmarshal(m, b)
+}
+func (m *LogSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogSelector.Marshal(b, m, deterministic)
+}
+func (m *LogSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSelector.Merge(m, src)
+}
+func (m *LogSelector) XXX_Size() int {
+	return xxx_messageInfo_LogSelector.Size(m)
+}
+func (m *LogSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSelector proto.InternalMessageInfo
+
+func (m *LogSelector) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *LogSelector) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *LogSelector) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+// LogHighlight colors the parts of log lines that match a pattern
+type LogHighlight struct {
+	Pattern              string   `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Color                string   `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogHighlight) Reset()         { *m = LogHighlight{} }
+func (m *LogHighlight) String() string { return proto.CompactTextString(m) }
+func (*LogHighlight) ProtoMessage()    {}
+func (*LogHighlight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{37}
+}
+
+func (m *LogHighlight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogHighlight.Unmarshal(m, b)
+}
+func (m *LogHighlight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogHighlight.Marshal(b, m, deterministic)
+}
+func (m *LogHighlight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogHighlight.Merge(m, src)
+}
+func (m *LogHighlight) XXX_Size() int {
+	return xxx_messageInfo_LogHighlight.Size(m)
+}
+func (m *LogHighlight) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogHighlight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogHighlight proto.InternalMessageInfo
+
+func (m *LogHighlight) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *LogHighlight) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("proto.BuilderType", BuilderType_name, BuilderType_value)
 	proto.RegisterEnum("proto.BuildType", BuildType_name, BuildType_value)
@@ -2896,260 +3423,300 @@ func init() {
 	proto.RegisterType((*Intent)(nil), "proto.Intent")
 	proto.RegisterType((*Suggestion)(nil), "proto.Suggestion")
 	proto.RegisterType((*IntOrString)(nil), "proto.IntOrString")
+	proto.RegisterType((*FileUpload)(nil), "proto.FileUpload")
+	proto.RegisterType((*UploadRequest)(nil), "proto.UploadRequest")
+	proto.RegisterType((*UploadResponse)(nil), "proto.UploadResponse")
+	proto.RegisterType((*OutputEntry)(nil), "proto.OutputEntry")
+	proto.RegisterType((*ApplicationLogEvent)(nil), "proto.ApplicationLogEvent")
+	proto.RegisterType((*LogsIntent)(nil), "proto.LogsIntent")
+	proto.RegisterType((*LogSelector)(nil), "proto.LogSelector")
+	proto.RegisterType((*LogHighlight)(nil), "proto.LogHighlight")
 }
 
 func init() { proto.RegisterFile("skaffold.proto", fileDescriptor_4f2d38e344f9dbf5) }
 
 var fileDescriptor_4f2d38e344f9dbf5 = []byte{
-	// 3965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x5b, 0x8c, 0x1b, 0x59,
-	0x5a, 0x8e, 0xed, 0xf6, 0xed, 0xef, 0x4b, 0x2a, 0x27, 0xe9, 0xc4, 0x71, 0x6e, 0x1d, 0x4f, 0x92,
-	0xcd, 0xf4, 0x0c, 0x9d, 0x4c, 0x82, 0xd0, 0x12, 0x66, 0x40, 0xd5, 0xae, 0x63, 0xbb, 0xd2, 0xe5,
-	0x2a, 0x73, 0xaa, 0xdc, 0x99, 0x8e, 0x84, 0x4a, 0x4e, 0x77, 0xc5, 0xe3, 0x8d, 0xdb, 0xee, 0xb1,
-	0xab, 0x33, 0xdb, 0x0b, 0xe2, 0x01, 0x71, 0x5f, 0x90, 0x80, 0x65, 0xb9, 0x3f, 0x2c, 0x2c, 0x68,
-	0x79, 0xe0, 0xf6, 0x8a, 0x10, 0x2c, 0x88, 0x07, 0xee, 0xf0, 0x80, 0x40, 0xac, 0x84, 0x84, 0x90,
-	0x76, 0x1f, 0x96, 0xbb, 0x60, 0x66, 0xf6, 0xbe, 0xa0, 0x73, 0xab, 0x3a, 0xe5, 0x4b, 0x7a, 0x32,
-	0x08, 0xf1, 0x14, 0xd7, 0xf9, 0xbf, 0xff, 0x7a, 0xfe, 0xf3, 0xff, 0xff, 0x39, 0x69, 0x58, 0x19,
-	0x3f, 0xe9, 0x3c, 0x7e, 0x3c, 0xec, 0xef, 0x6d, 0x1c, 0x8c, 0x86, 0xe1, 0x10, 0x65, 0xd9, 0x3f,
-	0xe5, 0x8b, 0xdd, 0xe1, 0xb0, 0xdb, 0x0f, 0x6e, 0x75, 0x0e, 0x7a, 0xb7, 0x3a, 0x83, 0xc1, 0x30,
-	0xec, 0x84, 0xbd, 0xe1, 0x60, 0xcc, 0x41, 0xe5, 0x2b, 0x82, 0xca, 0xbe, 0x1e, 0x1d, 0x3e, 0xbe,
-	0x15, 0xf6, 0xf6, 0x83, 0x71, 0xd8, 0xd9, 0x3f, 0x10, 0x80, 0x0b, 0x93, 0x80, 0x60, 0xff, 0x20,
-	0x3c, 0xe2, 0xc4, 0xca, 0x5d, 0x58, 0x76, 0xc3, 0x4e, 0x18, 0x90, 0x60, 0x7c, 0x30, 0x1c, 0x8c,
-	0x03, 0x54, 0x81, 0xec, 0x98, 0x2e, 0x94, 0x52, 0x6b, 0xa9, 0x9b, 0x8b, 0x77, 0x96, 0x38, 0x6e,
-	0x83, 0x83, 0x38, 0xa9, 0x72, 0x11, 0x0a, 0x11, 0x5e, 0x83, 0xcc, 0xfe, 0xb8, 0xcb, 0xd0, 0x45,
-	0x42, 0x7f, 0x56, 0x2e, 0x41, 0x9e, 0x04, 0x6f, 0x1e, 0x06, 0xe3, 0x10, 0x21, 0x58, 0x18, 0x74,
-	0xf6, 0x03, 0x41, 0x65, 0xbf, 0x2b, 0x1f, 0x5f, 0x80, 0x2c, 0x93, 0x86, 0x5e, 0x01, 0x78, 0x74,
-	0xd8, 0xeb, 0xef, 0xb9, 0x8a, 0xbe, 0x53, 0x42, 0xdf, 0x66, 0x44, 0x20, 0x0a, 0x08, 0x7d, 0x23,
-	0x2c, 0xee, 0x05, 0x07, 0xfd, 0xe1, 0x11, 0xe7, 0x49, 0x33, 0x1e, 0x24, 0x78, 0x8c, 0x98, 0x42,
-	0x54, 0x18, 0x6a, 0xc0, 0xca, 0xe3, 0xe1, 0xe8, 0xad, 0xce, 0x68, 0x2f, 0xd8, 0x6b, 0x0d, 0x47,
-	0xe1, 0xb8, 0xb4, 0xb0, 0x96, 0xb9, 0xb9, 0x78, 0x67, 0x4d, 0x75, 0x6e, 0xa3, 0x96, 0x80, 0xe0,
-	0x41, 0x38, 0x3a, 0x22, 0x13, 0x7c, 0xa8, 0x0a, 0x1a, 0x0d, 0xc1, 0xe1, 0xb8, 0xfa, 0x46, 0xb0,
-	0xfb, 0x84, 0x1b, 0x91, 0x65, 0x46, 0x9c, 0x53, 0x64, 0xa9, 0x64, 0x32, 0xc5, 0x80, 0xee, 0xc1,
-	0xf2, 0xe3, 0x5e, 0x3f, 0x70, 0x8f, 0x06, 0xbb, 0x5c, 0x42, 0x8e, 0x49, 0x38, 0x23, 0x24, 0xd4,
-	0x54, 0x1a, 0x49, 0x42, 0x51, 0x0b, 0x4e, 0xef, 0x05, 0x8f, 0x0e, 0xbb, 0xdd, 0xde, 0xa0, 0x5b,
-	0x1d, 0x0e, 0xc2, 0x4e, 0x6f, 0x10, 0x8c, 0xc6, 0xa5, 0x3c, 0xf3, 0xe7, 0x72, 0x14, 0x88, 0x49,
-	0x04, 0x7e, 0x1a, 0x0c, 0x42, 0x32, 0x8b, 0x15, 0xbd, 0x04, 0x85, 0xfd, 0x20, 0xec, 0xec, 0x75,
-	0xc2, 0x4e, 0xa9, 0xc0, 0x0c, 0x39, 0x29, 0xc4, 0x34, 0xc5, 0x32, 0x89, 0x00, 0x65, 0x17, 0x4e,
-	0xcf, 0x08, 0x13, 0x4d, 0x82, 0x27, 0xc1, 0x11, 0xdb, 0xc2, 0x2c, 0xa1, 0x3f, 0xd1, 0x0d, 0xc8,
-	0x3e, 0xed, 0xf4, 0x0f, 0xe5, 0x16, 0x69, 0x42, 0x24, 0xe5, 0xe1, 0xb6, 0x70, 0xf2, 0xbd, 0xf4,
-	0x07, 0x53, 0xf7, 0x17, 0x0a, 0x19, 0x6d, 0xa1, 0xf2, 0xb9, 0x14, 0x14, 0xa4, 0x46, 0xb4, 0x0e,
-	0x59, 0xb6, 0xeb, 0x22, 0x2b, 0xce, 0xa8, 0x59, 0x11, 0x99, 0xc5, 0x21, 0xe8, 0x1b, 0x20, 0xc7,
-	0x37, 0x5b, 0xe8, 0x5a, 0x4d, 0xa4, 0x43, 0x84, 0x16, 0x20, 0xf4, 0x6d, 0x00, 0x9d, 0xbd, 0xbd,
-	0x1e, 0x3d, 0x42, 0x9d, 0x7e, 0x69, 0x97, 0x05, 0xee, 0xca, 0x84, 0xc7, 0x1b, 0x7a, 0x84, 0xe0,
-	0x79, 0xa0, 0xb0, 0x94, 0x5f, 0x83, 0x93, 0x13, 0x64, 0xd5, 0xff, 0x22, 0xf7, 0xff, 0x8c, 0xea,
-	0x7f, 0x51, 0xf1, 0xb6, 0xf2, 0x4e, 0x1a, 0x96, 0x13, 0x7e, 0xa0, 0x97, 0xe1, 0xd4, 0xe0, 0x70,
-	0xff, 0x51, 0x30, 0x72, 0x1e, 0xeb, 0xa3, 0xb0, 0xf7, 0xb8, 0xb3, 0x1b, 0x8e, 0x45, 0x2c, 0xa7,
-	0x09, 0xe8, 0x35, 0x28, 0x30, 0xbf, 0xe9, 0xb6, 0xa7, 0x99, 0xf5, 0x57, 0x67, 0x45, 0x67, 0xc3,
-	0xdc, 0xef, 0x74, 0x83, 0x4d, 0x8e, 0x24, 0x11, 0x0b, 0xba, 0x06, 0x0b, 0xe1, 0xd1, 0x41, 0x50,
-	0xca, 0xac, 0xa5, 0x6e, 0xae, 0x44, 0xfb, 0xc2, 0x70, 0xde, 0xd1, 0x41, 0x40, 0x18, 0x15, 0x19,
-	0x33, 0x82, 0x74, 0x6d, 0xa6, 0x9a, 0x67, 0x45, 0xca, 0x82, 0x25, 0xd5, 0x0a, 0x74, 0x43, 0xe8,
-	0x4e, 0x31, 0xdd, 0x48, 0x95, 0x17, 0x8c, 0x14, 0xed, 0x67, 0x20, 0xbb, 0x3b, 0x3c, 0x1c, 0x84,
-	0x2c, 0x78, 0x59, 0xc2, 0x3f, 0xfe, 0xb7, 0x71, 0xff, 0xa3, 0x14, 0xac, 0x24, 0x53, 0x02, 0xbd,
-	0x0a, 0x45, 0x9e, 0x14, 0x34, 0x96, 0xa9, 0x89, 0x23, 0xa4, 0x22, 0xc5, 0x67, 0x30, 0x22, 0x31,
-	0x03, 0x7a, 0x19, 0xf2, 0xbb, 0xfd, 0xc3, 0x71, 0x18, 0x8c, 0x98, 0xb2, 0xd8, 0xa1, 0x2a, 0x5f,
-	0x65, 0x0e, 0x49, 0x48, 0xd9, 0x84, 0x82, 0x14, 0x82, 0x3e, 0x90, 0x88, 0xc3, 0xe9, 0x84, 0xca,
-	0xe3, 0x03, 0x51, 0xf9, 0x87, 0x14, 0x40, 0x5c, 0x1f, 0xd1, 0xb7, 0x42, 0xb1, 0xa3, 0xa4, 0x8d,
-	0x5a, 0xd8, 0x62, 0xd4, 0x46, 0x94, 0x40, 0x7c, 0x9b, 0x62, 0x16, 0xb4, 0x06, 0x8b, 0x9d, 0xc3,
-	0x70, 0xe8, 0x8d, 0x7a, 0xdd, 0xae, 0xf0, 0xa5, 0x40, 0xd4, 0x25, 0x5a, 0xa8, 0x45, 0x11, 0x1b,
-	0xee, 0xc9, 0xcc, 0x39, 0x95, 0xac, 0x77, 0xc3, 0xbd, 0x80, 0x28, 0xa0, 0xf2, 0xab, 0xb0, 0x92,
-	0xd4, 0xf8, 0x5c, 0x7b, 0xf5, 0x11, 0x58, 0x54, 0x8a, 0x39, 0x3a, 0x0b, 0x39, 0x2e, 0x5a, 0x70,
-	0x8b, 0xaf, 0xff, 0x13, 0xcb, 0x2b, 0xff, 0x98, 0x02, 0x6d, 0xb2, 0x88, 0xcf, 0xb5, 0xc0, 0x80,
-	0xe2, 0x28, 0x18, 0x0f, 0x0f, 0x47, 0xbb, 0x81, 0x3c, 0x8d, 0x37, 0xe6, 0x34, 0x82, 0x0d, 0x22,
-	0x81, 0x62, 0x07, 0x22, 0xc6, 0xf7, 0x19, 0xdf, 0xa4, 0xbc, 0xe7, 0x8a, 0xaf, 0x09, 0xcb, 0x89,
-	0x2e, 0xf3, 0xfe, 0x23, 0x5c, 0xf9, 0xd5, 0x2c, 0x64, 0x59, 0x45, 0x47, 0xb7, 0xa1, 0x48, 0xfb,
-	0x04, 0xfb, 0x10, 0x75, 0x5b, 0x53, 0xea, 0x2a, 0x5b, 0x6f, 0x9c, 0x20, 0x31, 0x08, 0xdd, 0x15,
-	0x03, 0x00, 0x67, 0x49, 0x4f, 0x0f, 0x00, 0x92, 0x47, 0x81, 0xa1, 0x6f, 0x92, 0x23, 0x00, 0xe7,
-	0xca, 0xcc, 0x18, 0x01, 0x24, 0x9b, 0x0a, 0xa4, 0xe6, 0x1d, 0xc8, 0xee, 0x53, 0x5a, 0x98, 0xdd,
-	0x95, 0xa8, 0x79, 0x11, 0x08, 0xe1, 0x44, 0xb3, 0xe7, 0x8c, 0x73, 0x9b, 0xbd, 0xe4, 0x9f, 0x62,
-	0x41, 0xdf, 0x01, 0x25, 0xb9, 0xd5, 0x93, 0x78, 0xd1, 0xf9, 0x65, 0xfb, 0x21, 0x73, 0x60, 0x8d,
-	0x13, 0x64, 0xae, 0x08, 0xf4, 0x6a, 0x3c, 0x4d, 0x70, 0x99, 0xf9, 0x99, 0xd3, 0x84, 0x14, 0x94,
-	0x04, 0xa3, 0x87, 0x70, 0x6e, 0x6f, 0xf6, 0xb4, 0x20, 0x86, 0x81, 0x63, 0x66, 0x8a, 0xc6, 0x09,
-	0x32, 0x4f, 0x00, 0xfa, 0x66, 0x58, 0xda, 0x0b, 0x9e, 0x5a, 0xc3, 0xe1, 0x01, 0x17, 0x58, 0x64,
-	0x02, 0xe3, 0x72, 0x17, 0x93, 0x1a, 0x27, 0x48, 0x02, 0x4a, 0x43, 0x1f, 0x06, 0xa3, 0xfd, 0xde,
-	0x80, 0x8d, 0xba, 0x9c, 0x1d, 0x12, 0xa1, 0xf7, 0x26, 0xc8, 0x34, 0xf4, 0x93, 0x2c, 0x9b, 0x4b,
-	0x00, 0x01, 0xfd, 0xe1, 0xd3, 0x6a, 0x5a, 0x21, 0xa0, 0x4d, 0x72, 0xcd, 0x4d, 0xfc, 0x1b, 0x90,
-	0x09, 0x46, 0x23, 0x91, 0x93, 0x32, 0x96, 0xfa, 0x2e, 0x6b, 0x3e, 0x8f, 0xfa, 0x01, 0x1e, 0x8d,
-	0x08, 0x05, 0x54, 0xfa, 0xb0, 0xa4, 0x3a, 0x82, 0x2e, 0x42, 0xb1, 0x17, 0x06, 0x23, 0xa6, 0x41,
-	0xf4, 0xf0, 0x78, 0x41, 0xd1, 0x96, 0x9e, 0xa5, 0x2d, 0x73, 0x9c, 0xb6, 0x8f, 0xa6, 0x60, 0x39,
-	0xb1, 0x8c, 0x5e, 0x82, 0x7c, 0x30, 0x1a, 0xb1, 0xba, 0x91, 0x9a, 0x57, 0x37, 0x24, 0x02, 0x95,
-	0x20, 0xbf, 0x1f, 0x8c, 0xc7, 0x9d, 0xae, 0x2c, 0x09, 0xf2, 0x13, 0xdd, 0x85, 0xc5, 0xf1, 0x61,
-	0xb7, 0x1b, 0x8c, 0xd9, 0xcd, 0xa2, 0x94, 0x61, 0x95, 0x2c, 0x12, 0x15, 0x51, 0x88, 0x8a, 0xaa,
-	0xd8, 0x50, 0x8c, 0x0e, 0x36, 0x2d, 0x36, 0x01, 0xad, 0x43, 0x22, 0x8e, 0xfc, 0x23, 0x31, 0x5c,
-	0xa6, 0x8f, 0x19, 0x2e, 0x2b, 0xbf, 0x23, 0xfb, 0x1a, 0x97, 0x58, 0x86, 0x82, 0x6c, 0x52, 0x42,
-	0x68, 0xf4, 0x3d, 0x37, 0x90, 0x5a, 0x1c, 0xc8, 0x22, 0x0b, 0x99, 0x1a, 0xa0, 0x85, 0x63, 0x03,
-	0x74, 0x0f, 0x96, 0x3b, 0x6a, 0x78, 0xc5, 0x71, 0x9f, 0xbd, 0x23, 0x49, 0x68, 0xe5, 0x13, 0x29,
-	0xd9, 0xb4, 0x9e, 0x9d, 0x59, 0x5a, 0x9c, 0x59, 0xd3, 0x26, 0x66, 0x9e, 0xdf, 0xc4, 0x85, 0xf7,
-	0x6e, 0xe2, 0xa7, 0x93, 0xad, 0xed, 0xd9, 0x76, 0xce, 0x4f, 0x96, 0xff, 0xc7, 0x20, 0x7f, 0x3e,
-	0x05, 0xa5, 0x79, 0x55, 0x92, 0x26, 0x8c, 0xac, 0x92, 0x32, 0x61, 0xe4, 0xf7, 0xdc, 0x84, 0x51,
-	0xbc, 0xcc, 0xcc, 0xf4, 0x72, 0x21, 0xf6, 0x32, 0xd9, 0xa6, 0xb3, 0xef, 0xa1, 0x4d, 0x4f, 0xfb,
-	0x9a, 0x7b, 0xef, 0xbe, 0x7e, 0x26, 0x0d, 0xc5, 0xa8, 0x33, 0xd1, 0xc2, 0xd2, 0x1f, 0xee, 0x76,
-	0xfa, 0x74, 0x45, 0x16, 0x96, 0x68, 0x01, 0x5d, 0x06, 0x18, 0x05, 0xfb, 0xc3, 0x30, 0x60, 0x64,
-	0x3e, 0x2d, 0x2a, 0x2b, 0xd4, 0xcd, 0x83, 0xe1, 0x9e, 0x4d, 0xef, 0xe2, 0xc2, 0x4d, 0xf1, 0x89,
-	0xae, 0xc1, 0xf2, 0xae, 0x2c, 0xdb, 0x8c, 0xce, 0x1d, 0x4e, 0x2e, 0x52, 0xed, 0xf4, 0xf2, 0x3e,
-	0x3e, 0xe8, 0xec, 0x72, 0xcf, 0x8b, 0x24, 0x5e, 0xa0, 0x81, 0xa7, 0x5d, 0x93, 0xb1, 0xe7, 0x78,
-	0xe0, 0xe5, 0x37, 0xaa, 0xc0, 0x92, 0xdc, 0x04, 0x3a, 0xd8, 0xb2, 0xee, 0x54, 0x24, 0x89, 0x35,
-	0x15, 0xc3, 0x64, 0x14, 0x92, 0x18, 0x26, 0xa7, 0x04, 0xf9, 0xce, 0xde, 0xde, 0x28, 0x18, 0x8f,
-	0x59, 0x1f, 0x29, 0x12, 0xf9, 0x89, 0xee, 0x00, 0x84, 0x9d, 0x51, 0x37, 0x08, 0x99, 0xef, 0x90,
-	0x98, 0x07, 0xcc, 0x41, 0xe8, 0x8c, 0xdc, 0x70, 0xd4, 0x1b, 0x74, 0x89, 0x82, 0xaa, 0xfc, 0x6d,
-	0x2a, 0x9e, 0x80, 0xa2, 0xf8, 0xd2, 0xce, 0x58, 0x65, 0xe3, 0xb6, 0x88, 0x6f, 0xb4, 0x40, 0xab,
-	0x5b, 0x6f, 0x3f, 0x3e, 0x0a, 0xfc, 0x43, 0x49, 0xaa, 0xcc, 0xac, 0x23, 0xbe, 0x30, 0xf3, 0x80,
-	0x64, 0x9f, 0xff, 0x80, 0x3c, 0x47, 0xd2, 0xbc, 0x9d, 0x86, 0x73, 0x73, 0x5a, 0xf5, 0xb3, 0x4e,
-	0xba, 0x4c, 0x8e, 0xf4, 0x31, 0xc9, 0x91, 0x39, 0x36, 0x39, 0x16, 0x66, 0x24, 0x47, 0x54, 0xc6,
-	0xb3, 0x13, 0x65, 0xbc, 0x04, 0xf9, 0xd1, 0xe1, 0x20, 0xec, 0x45, 0x79, 0x23, 0x3f, 0x69, 0x42,
-	0xbf, 0x35, 0x1c, 0x3d, 0xe9, 0x0d, 0xba, 0x46, 0x6f, 0x24, 0x92, 0x46, 0x59, 0x41, 0x36, 0x00,
-	0x1b, 0x3b, 0xf8, 0x73, 0x4e, 0x81, 0xf5, 0xab, 0x8d, 0x67, 0x8f, 0x2a, 0x7c, 0x5d, 0x79, 0xdc,
-	0x51, 0x24, 0xd0, 0xcb, 0xe5, 0x04, 0xf9, 0xb8, 0x81, 0x7a, 0x59, 0x1d, 0xa8, 0xbf, 0x1b, 0x0a,
-	0xd6, 0xb0, 0xcb, 0xf9, 0x3e, 0x08, 0xc5, 0xe8, 0x09, 0x4e, 0xcc, 0xc1, 0xe5, 0x0d, 0xfe, 0x06,
-	0xb7, 0x21, 0xdf, 0xe0, 0x36, 0x3c, 0x89, 0x20, 0x31, 0x18, 0x55, 0x20, 0x1b, 0x28, 0xa3, 0xb0,
-	0x7c, 0x7b, 0x13, 0x0f, 0x26, 0x41, 0xb2, 0xcf, 0x66, 0x94, 0x3e, 0x5b, 0xb9, 0x07, 0xa7, 0xda,
-	0xe3, 0x60, 0x64, 0x0e, 0x42, 0x0a, 0x15, 0xaf, 0x6f, 0xd7, 0x21, 0xd7, 0x63, 0x0b, 0xc2, 0x8a,
-	0xe5, 0xf8, 0x50, 0x50, 0x94, 0x20, 0x56, 0xbe, 0x05, 0x56, 0xc4, 0x30, 0x2f, 0x19, 0x5f, 0x4c,
-	0xbe, 0x01, 0xca, 0x89, 0x4d, 0xa0, 0x12, 0x4f, 0x81, 0xaf, 0xc0, 0x92, 0xba, 0x8c, 0xca, 0x90,
-	0x0f, 0x58, 0x32, 0xf2, 0xa7, 0x9b, 0x42, 0xe3, 0x04, 0x91, 0x0b, 0x9b, 0x59, 0xc8, 0x3c, 0xed,
-	0xf4, 0x2b, 0xf7, 0x21, 0xc7, 0x2d, 0xa0, 0xbe, 0xc4, 0xaf, 0x3c, 0x05, 0xf9, 0x9e, 0x83, 0x60,
-	0x61, 0x7c, 0x34, 0xd8, 0x15, 0x97, 0x0d, 0xf6, 0x9b, 0xa6, 0xae, 0x78, 0xe3, 0xc9, 0xb0, 0x55,
-	0xf1, 0x55, 0xd9, 0x05, 0x88, 0xa7, 0x13, 0xf4, 0x1a, 0xac, 0xc4, 0xf3, 0x89, 0x32, 0x13, 0xad,
-	0x4e, 0x0d, 0x32, 0xec, 0xc0, 0x4d, 0x80, 0xa9, 0x12, 0x7e, 0x98, 0x64, 0x8f, 0xe0, 0x5f, 0x95,
-	0x6f, 0x87, 0x45, 0xa5, 0x8e, 0x50, 0xfb, 0xa2, 0xdb, 0x7b, 0x56, 0x5c, 0xd4, 0xcf, 0xb2, 0x50,
-	0x6f, 0x77, 0xfa, 0xa2, 0xf6, 0x8a, 0x2f, 0x7e, 0xe4, 0x46, 0x74, 0x3d, 0xaa, 0x10, 0xf4, 0x6b,
-	0x7d, 0x08, 0x8b, 0xca, 0xb3, 0x07, 0x2a, 0xc1, 0x99, 0xb6, 0xbd, 0x65, 0x3b, 0x0f, 0x6c, 0x7f,
-	0xb3, 0x6d, 0x5a, 0x06, 0x26, 0xbe, 0xb7, 0xd3, 0xc2, 0xda, 0x09, 0x94, 0x87, 0xcc, 0x7d, 0x73,
-	0x53, 0x4b, 0xa1, 0x22, 0x64, 0x37, 0xf5, 0x87, 0xd8, 0xd2, 0xd2, 0x68, 0x05, 0x80, 0xa1, 0x5a,
-	0x7a, 0x75, 0xcb, 0xd5, 0x32, 0x08, 0x20, 0x57, 0x6d, 0xbb, 0x9e, 0xd3, 0xd4, 0x16, 0xe8, 0xef,
-	0x2d, 0xdd, 0x36, 0xb7, 0x1c, 0x2d, 0x4b, 0x7f, 0x1b, 0x4e, 0x75, 0x0b, 0x13, 0x2d, 0xb7, 0x6e,
-	0x40, 0x31, 0x7a, 0xe3, 0x41, 0x67, 0x01, 0x25, 0xd4, 0x49, 0x65, 0x8b, 0x90, 0xaf, 0x5a, 0x6d,
-	0xd7, 0xc3, 0x44, 0x4b, 0x51, 0xcd, 0xf5, 0xea, 0xa6, 0x96, 0xa6, 0x9a, 0x2d, 0xa7, 0xaa, 0x5b,
-	0x5a, 0x66, 0xdd, 0xa1, 0xd3, 0x6e, 0xfc, 0x4a, 0x81, 0xce, 0xc3, 0xaa, 0x14, 0x64, 0xe0, 0x96,
-	0xe5, 0xec, 0xc4, 0x86, 0x17, 0x60, 0xa1, 0x81, 0xad, 0xa6, 0x96, 0x42, 0xcb, 0x50, 0xdc, 0x62,
-	0xe6, 0x99, 0x0f, 0xb1, 0x96, 0xa6, 0x4a, 0xb6, 0xda, 0x9b, 0xb8, 0xea, 0x51, 0x81, 0x26, 0x2c,
-	0x2a, 0xaf, 0x25, 0x6a, 0x1c, 0x84, 0x21, 0x52, 0xdc, 0x12, 0x14, 0x9a, 0xa6, 0x6d, 0x52, 0x4e,
-	0x61, 0xdb, 0x16, 0xe6, 0xb6, 0x39, 0x5e, 0x03, 0x13, 0x2d, 0xb3, 0xfe, 0xc9, 0x32, 0x40, 0x5c,
-	0x4d, 0x51, 0x0e, 0xd2, 0xce, 0x96, 0x76, 0x02, 0x95, 0xe0, 0xb4, 0xeb, 0xe9, 0x5e, 0xdb, 0xad,
-	0x36, 0x70, 0x75, 0xcb, 0x77, 0xdb, 0xd5, 0x2a, 0x76, 0x5d, 0xed, 0x8f, 0x53, 0x08, 0xc1, 0x32,
-	0xf7, 0x5e, 0xae, 0xfd, 0x49, 0x0a, 0x9d, 0x86, 0x15, 0xee, 0x48, 0xb4, 0xf8, 0xa7, 0x29, 0x74,
-	0x11, 0x4a, 0x1c, 0xd8, 0x6a, 0xbb, 0x0d, 0x5f, 0x67, 0xeb, 0xbe, 0x81, 0x6d, 0x13, 0x1b, 0x5a,
-	0x80, 0x2e, 0xc0, 0x39, 0x41, 0x25, 0xce, 0x7d, 0x5c, 0xf5, 0x7c, 0xdb, 0xf1, 0xfc, 0x9a, 0xd3,
-	0xb6, 0x0d, 0xed, 0x31, 0x7a, 0x01, 0xae, 0x70, 0x22, 0xdf, 0x08, 0xdf, 0xd0, 0x71, 0xd3, 0xb1,
-	0x19, 0x84, 0xb4, 0x6d, 0xdb, 0xb4, 0xeb, 0x5a, 0x17, 0x9d, 0x01, 0x8d, 0x83, 0xda, 0x2e, 0x26,
-	0x3e, 0x26, 0xc4, 0x21, 0xda, 0x1b, 0xb1, 0x56, 0xc1, 0xda, 0xb6, 0xf5, 0x6d, 0xdd, 0xb4, 0xf4,
-	0x4d, 0x0b, 0x6b, 0x3d, 0x74, 0x09, 0xce, 0x4f, 0x52, 0xdb, 0x5e, 0xc3, 0x21, 0xe6, 0x43, 0x6c,
-	0x68, 0x1f, 0x8a, 0x8d, 0x12, 0x64, 0x77, 0xc7, 0xf5, 0x70, 0x93, 0xca, 0xd6, 0x9e, 0xa0, 0xab,
-	0x70, 0x29, 0x41, 0xa4, 0xd6, 0x34, 0x1d, 0xc3, 0xac, 0x99, 0xd8, 0x60, 0x90, 0x3e, 0xba, 0x06,
-	0x6b, 0x53, 0x10, 0xb3, 0xd9, 0xb2, 0x70, 0x13, 0xdb, 0x9e, 0x40, 0xed, 0xa3, 0xcb, 0x50, 0x9e,
-	0xf0, 0xce, 0xd3, 0x7d, 0xcb, 0x71, 0x5d, 0x46, 0x1f, 0x4c, 0xd1, 0x6b, 0x0e, 0xd9, 0x34, 0x0d,
-	0x03, 0xdb, 0x8c, 0x3e, 0x9c, 0x72, 0xa2, 0xea, 0xd8, 0x35, 0xcb, 0xac, 0x7a, 0x8c, 0x7c, 0x80,
-	0xd6, 0xe0, 0x62, 0x82, 0xcc, 0x22, 0xa3, 0x84, 0xf7, 0x4d, 0x54, 0x81, 0xcb, 0x09, 0x84, 0x69,
-	0x6f, 0xeb, 0x96, 0x69, 0xf8, 0x2d, 0x9d, 0xe8, 0xdc, 0xdb, 0xd1, 0xa4, 0x11, 0x35, 0xd3, 0xc2,
-	0x8a, 0x8c, 0xf1, 0x94, 0xab, 0x55, 0xbd, 0xda, 0xc0, 0x7e, 0x8d, 0x38, 0x4d, 0xbf, 0xd5, 0xb6,
-	0x2c, 0x26, 0x25, 0x44, 0x57, 0xe0, 0x42, 0x02, 0x55, 0xc7, 0x9e, 0x6f, 0x98, 0x75, 0xec, 0x72,
-	0x63, 0x0f, 0xe3, 0xa0, 0x12, 0x5c, 0x37, 0x5d, 0x8f, 0xec, 0x4c, 0x42, 0x9e, 0xc6, 0x10, 0x99,
-	0xe3, 0xf7, 0xcd, 0x4d, 0xbf, 0x65, 0xb5, 0xeb, 0xa6, 0xcd, 0xd3, 0xfc, 0xad, 0x78, 0xd3, 0x29,
-	0xa9, 0x4e, 0x74, 0xc3, 0xc2, 0xf4, 0x64, 0x31, 0x01, 0x1f, 0x8e, 0x77, 0x95, 0x52, 0x9b, 0xfa,
-	0x36, 0xb6, 0x23, 0xe2, 0x11, 0x5a, 0x87, 0x1b, 0xa6, 0x6d, 0x7a, 0xd1, 0x8e, 0x61, 0xef, 0x81,
-	0x43, 0xb6, 0x7c, 0xcb, 0x74, 0x3d, 0xd3, 0xae, 0xd3, 0xd8, 0x7a, 0xba, 0x69, 0x63, 0xe2, 0x6a,
-	0x1f, 0x41, 0x1b, 0xb0, 0x3e, 0x0b, 0x2b, 0xc3, 0x17, 0x61, 0x7d, 0x5b, 0x6f, 0x62, 0xed, 0x3b,
-	0xd1, 0x6d, 0x78, 0x79, 0x16, 0x3e, 0xc6, 0x19, 0x0e, 0x76, 0x59, 0x54, 0xf1, 0xeb, 0xa6, 0xeb,
-	0x69, 0xdf, 0x85, 0xae, 0x40, 0x59, 0x3d, 0x76, 0x66, 0x53, 0xaf, 0xe3, 0x38, 0x9e, 0xbf, 0x96,
-	0x46, 0x2f, 0xc0, 0x65, 0x15, 0x10, 0x8b, 0xaa, 0x12, 0xac, 0x53, 0x8b, 0xb5, 0x5f, 0x4f, 0xa3,
-	0x0a, 0x5c, 0x52, 0x41, 0xa4, 0x6d, 0x2b, 0x40, 0x2a, 0xe8, 0x37, 0xd2, 0xe8, 0x3a, 0xac, 0xcd,
-	0x16, 0xe4, 0x61, 0xd2, 0x34, 0x6d, 0xdd, 0xc3, 0x86, 0xf6, 0x9b, 0x69, 0xf4, 0x12, 0xdc, 0x50,
-	0x61, 0xfc, 0x94, 0xd3, 0x6c, 0xf6, 0x89, 0x63, 0x59, 0x4e, 0xdb, 0xf3, 0x5b, 0xd8, 0x36, 0xa8,
-	0xde, 0xdf, 0x7a, 0x86, 0x4c, 0x82, 0x5d, 0x4f, 0x27, 0xcc, 0xbc, 0xcf, 0xa6, 0x51, 0x19, 0x56,
-	0x55, 0x58, 0xdb, 0x6e, 0x60, 0xdd, 0xf2, 0x1a, 0x3b, 0xda, 0xe7, 0xa6, 0x44, 0xd8, 0x8e, 0x81,
-	0xfd, 0x26, 0x6e, 0x3a, 0x64, 0xc7, 0x6f, 0x11, 0xec, 0xba, 0x6d, 0x82, 0xb5, 0x1f, 0xcb, 0x4c,
-	0x86, 0x81, 0xc1, 0x0c, 0xd3, 0xdd, 0x8a, 0x41, 0x3f, 0x9e, 0x41, 0x2f, 0xc2, 0xb5, 0x29, 0x90,
-	0xdc, 0x03, 0xb5, 0x2c, 0xfc, 0x44, 0x66, 0x32, 0x62, 0x0c, 0xda, 0xa2, 0x27, 0x42, 0x8a, 0xfb,
-	0xd8, 0x6c, 0x9d, 0x6d, 0x9b, 0x7e, 0x19, 0x6d, 0x2e, 0xe8, 0x27, 0x33, 0xe8, 0x2a, 0x5c, 0x9c,
-	0x01, 0x22, 0x58, 0xaf, 0x36, 0x18, 0xe4, 0xe3, 0x99, 0xc9, 0x3d, 0xe6, 0x66, 0xd1, 0xca, 0x86,
-	0x75, 0x63, 0x47, 0xfb, 0xa9, 0x29, 0x63, 0x6a, 0xba, 0x69, 0x61, 0xc3, 0x17, 0x8a, 0x68, 0x0c,
-	0x7f, 0x3a, 0x83, 0x3e, 0x00, 0x15, 0x15, 0x23, 0x5a, 0x03, 0x0d, 0xb9, 0x8d, 0xab, 0x9e, 0xe9,
-	0xf0, 0x5a, 0xf1, 0xb3, 0x53, 0x56, 0x4b, 0x20, 0x75, 0x6e, 0xcb, 0xb4, 0x2c, 0x6c, 0x68, 0x3f,
-	0x37, 0x15, 0xa9, 0x48, 0x9a, 0x65, 0xd2, 0x9d, 0xae, 0x61, 0xaf, 0xda, 0x60, 0xf2, 0x7e, 0x3e,
-	0x33, 0xb9, 0x41, 0x4a, 0x42, 0xc4, 0xb0, 0x5f, 0x98, 0x8a, 0x43, 0xcb, 0x31, 0x7c, 0x7a, 0x14,
-	0x4c, 0xdd, 0x32, 0x1f, 0x52, 0x17, 0xfe, 0x30, 0x43, 0x1b, 0x89, 0x3c, 0xd1, 0xbc, 0x78, 0xbf,
-	0x9d, 0x99, 0x6c, 0x3b, 0x82, 0xae, 0xbd, 0x93, 0x41, 0x37, 0xe0, 0xea, 0x0c, 0xca, 0xc4, 0x06,
-	0xbc, 0x9b, 0x41, 0xeb, 0x70, 0x7d, 0x76, 0x0e, 0x3e, 0xd0, 0x4d, 0x76, 0xa2, 0xa5, 0xcc, 0x2f,
-	0x64, 0xd0, 0x65, 0x38, 0x3f, 0x4b, 0x26, 0xde, 0xc6, 0xb6, 0xa7, 0x7d, 0x2d, 0xa3, 0xb4, 0x35,
-	0xc9, 0xf4, 0xc5, 0x0c, 0x3a, 0x05, 0x4b, 0xee, 0x8e, 0x5d, 0x8d, 0x96, 0xbe, 0x94, 0x89, 0x5b,
-	0xa2, 0x5c, 0xfb, 0x72, 0x06, 0x9d, 0x81, 0x93, 0x06, 0xde, 0x66, 0xc7, 0x5f, 0xae, 0x7e, 0x85,
-	0xad, 0x56, 0x2d, 0xac, 0xdb, 0xed, 0x56, 0xb4, 0xfa, 0x55, 0x26, 0x32, 0x01, 0xfc, 0x7a, 0x06,
-	0x9d, 0x87, 0x33, 0x13, 0x8d, 0x8a, 0x93, 0xfe, 0x9b, 0xc9, 0x60, 0x06, 0x30, 0x16, 0x1e, 0xb9,
-	0xcf, 0x2c, 0xa0, 0x35, 0xb8, 0x20, 0xf5, 0xf1, 0x52, 0x8a, 0x89, 0x98, 0x52, 0x0c, 0xdc, 0x72,
-	0xb5, 0xdf, 0xcd, 0xd2, 0xbc, 0x9b, 0x42, 0x78, 0xb4, 0xcc, 0x32, 0xc0, 0xef, 0x65, 0xe9, 0x9e,
-	0x4d, 0x01, 0x84, 0xff, 0x0c, 0xf2, 0xe9, 0xec, 0x4c, 0x2d, 0xb4, 0xfd, 0x98, 0x75, 0x0a, 0xd1,
-	0x7e, 0x3f, 0x8b, 0xae, 0xc1, 0x95, 0xd8, 0x6f, 0xb7, 0xdd, 0x6a, 0x39, 0x84, 0x76, 0xbe, 0xed,
-	0x57, 0xfc, 0xa6, 0x6e, 0x9b, 0x35, 0xec, 0x7a, 0xda, 0x1f, 0x64, 0x27, 0xcf, 0x00, 0xeb, 0xe0,
-	0x55, 0xdd, 0xae, 0x62, 0x96, 0x91, 0x9f, 0xc8, 0x4d, 0x9e, 0x01, 0x03, 0xeb, 0x86, 0x65, 0xda,
-	0xd8, 0xc7, 0xaf, 0x57, 0x31, 0x36, 0xb0, 0xa1, 0xfd, 0x62, 0x8e, 0x06, 0x82, 0x7b, 0x18, 0x73,
-	0xfe, 0x52, 0x0e, 0xad, 0x82, 0x26, 0x8c, 0x8e, 0x97, 0x3f, 0x99, 0x43, 0x17, 0xe0, 0xec, 0x44,
-	0xbf, 0x92, 0xc4, 0x5f, 0xce, 0xd1, 0x8a, 0x94, 0xec, 0xc8, 0x42, 0x9d, 0xf6, 0x2b, 0x39, 0x74,
-	0x09, 0x4a, 0xcc, 0x1b, 0x56, 0x60, 0xb1, 0xef, 0xe9, 0xf5, 0x7a, 0x34, 0x6e, 0x7c, 0x5f, 0x9e,
-	0x7a, 0xc2, 0xc8, 0x72, 0xcc, 0xf2, 0x5b, 0x7a, 0xdb, 0xe5, 0xad, 0xde, 0x21, 0xda, 0xf7, 0xe7,
-	0x69, 0x40, 0x92, 0x00, 0x65, 0x8a, 0x11, 0xa8, 0x1f, 0xc8, 0xd3, 0x54, 0x54, 0xb5, 0xc8, 0x71,
-	0x96, 0xd3, 0x7f, 0x30, 0x56, 0x23, 0xe8, 0xd1, 0xd8, 0xc8, 0x01, 0x3f, 0x34, 0x05, 0x90, 0x1b,
-	0x2b, 0x00, 0x3f, 0x9c, 0xa7, 0x71, 0xe1, 0x00, 0xd6, 0xa8, 0xf9, 0xf2, 0x47, 0x63, 0xf3, 0x04,
-	0xdf, 0x03, 0x9d, 0x1e, 0x62, 0x8f, 0x98, 0x8a, 0x97, 0x3f, 0x92, 0xa7, 0x55, 0x44, 0x45, 0xd1,
-	0x5a, 0x5e, 0xd3, 0xab, 0xaa, 0x86, 0x1f, 0xcd, 0xd3, 0x3d, 0x93, 0x91, 0x17, 0x53, 0xe8, 0x44,
-	0x39, 0xfa, 0x7c, 0x9e, 0x96, 0x8f, 0x28, 0xa5, 0x36, 0xdb, 0x75, 0xbf, 0x81, 0xad, 0x16, 0x6b,
-	0x10, 0x1e, 0x31, 0xf1, 0x36, 0xb3, 0x4b, 0xfb, 0xa7, 0x3c, 0x3a, 0x07, 0x28, 0x12, 0xc5, 0x8f,
-	0x0b, 0x25, 0xfc, 0x73, 0x9e, 0xee, 0x86, 0x20, 0xd0, 0x31, 0xd9, 0xd7, 0x5b, 0x2d, 0x6b, 0xc7,
-	0xb7, 0xf4, 0x4d, 0x6c, 0xb9, 0xda, 0xbf, 0xe4, 0xe9, 0xb1, 0x51, 0xc9, 0x72, 0x32, 0xd4, 0xfe,
-	0x55, 0xe5, 0xb4, 0x1d, 0xbf, 0x49, 0xdd, 0xa4, 0x1b, 0xc0, 0x02, 0xad, 0xfd, 0x5b, 0x1e, 0x5d,
-	0x84, 0x73, 0x2a, 0xe7, 0x36, 0x26, 0xae, 0x34, 0xfb, 0xdf, 0xf3, 0x3c, 0xef, 0x63, 0x6a, 0xd3,
-	0xb4, 0x13, 0x88, 0xff, 0xc8, 0xf3, 0xd3, 0xc5, 0x10, 0xb2, 0x7a, 0xaa, 0x80, 0xbf, 0x29, 0xf0,
-	0x83, 0x91, 0x00, 0x38, 0xb5, 0x1a, 0xcb, 0xe9, 0x26, 0xed, 0x00, 0x14, 0xf5, 0x9f, 0x79, 0x05,
-	0x85, 0x49, 0x5c, 0xb3, 0x6a, 0x0e, 0xcd, 0x49, 0x0b, 0xd3, 0x48, 0x6a, 0xff, 0xa5, 0xfa, 0x42,
-	0x9b, 0x46, 0x74, 0xb2, 0x98, 0x90, 0xb7, 0x55, 0x21, 0x8c, 0x4c, 0x70, 0xd3, 0xf1, 0x70, 0x12,
-	0xf5, 0x8e, 0x2a, 0x84, 0x0e, 0x3b, 0x49, 0xf2, 0xbb, 0x6a, 0x40, 0xa4, 0xbd, 0x51, 0x34, 0xbf,
-	0xc0, 0xf2, 0x35, 0xa2, 0x8a, 0x4b, 0x4a, 0x4c, 0xff, 0x62, 0xd2, 0xc2, 0x96, 0xa5, 0x57, 0xb1,
-	0x98, 0x65, 0x28, 0xf9, 0x4b, 0x6a, 0xaa, 0x78, 0x44, 0xb7, 0xdd, 0x9a, 0x43, 0x9a, 0x49, 0x03,
-	0xbe, 0xac, 0xee, 0xa5, 0x8b, 0x3d, 0xbe, 0xc7, 0x8c, 0xf4, 0x15, 0x55, 0x7b, 0xc4, 0xf4, 0x80,
-	0x98, 0x1e, 0x17, 0xff, 0x55, 0x35, 0xcb, 0x5a, 0x3a, 0x71, 0x15, 0xd7, 0x99, 0x11, 0x7c, 0xce,
-	0xfe, 0x5a, 0x1e, 0xdd, 0x84, 0x17, 0xd4, 0x5d, 0x15, 0xc9, 0x6d, 0xf3, 0x91, 0x2c, 0x9e, 0x0f,
-	0xbe, 0x9e, 0x5f, 0xff, 0x54, 0x11, 0x56, 0x92, 0xd7, 0x60, 0x7a, 0x99, 0xb2, 0x4d, 0x4b, 0x3b,
-	0x41, 0xef, 0x21, 0xba, 0x41, 0xcb, 0x6c, 0x4d, 0x6f, 0x5b, 0xb4, 0x2e, 0xb6, 0x1c, 0x6d, 0x8f,
-	0x5e, 0x16, 0x65, 0xe9, 0x52, 0xd6, 0x03, 0x3a, 0x37, 0x4f, 0xaf, 0xfb, 0x75, 0xcb, 0xd9, 0xd4,
-	0x2d, 0x51, 0x4a, 0xb5, 0xc7, 0x74, 0x86, 0xaf, 0x57, 0x2d, 0xa7, 0x1d, 0x55, 0x24, 0x7a, 0x4d,
-	0x11, 0x64, 0x3a, 0x8e, 0x74, 0xe9, 0x1d, 0x72, 0x36, 0xe9, 0x0d, 0x7a, 0x1d, 0xe4, 0x2a, 0x84,
-	0x08, 0x71, 0xc3, 0xd2, 0x7a, 0x31, 0x45, 0xb0, 0xca, 0xcb, 0xd4, 0x87, 0xa8, 0xb9, 0x35, 0xf3,
-	0x75, 0xbe, 0x85, 0xbc, 0x14, 0xf2, 0x4b, 0xcf, 0x59, 0x40, 0x02, 0x2b, 0xc7, 0x74, 0x8f, 0xec,
-	0x68, 0x7d, 0x7a, 0x85, 0xa0, 0x78, 0x65, 0xea, 0x8f, 0x6a, 0x82, 0x70, 0x62, 0x5f, 0x62, 0xdc,
-	0x2d, 0xbd, 0x56, 0x73, 0x2c, 0x23, 0x6a, 0x14, 0xd1, 0x85, 0x42, 0x1b, 0x50, 0x47, 0x29, 0x46,
-	0x19, 0xe9, 0xa5, 0x27, 0x3a, 0x4b, 0xf6, 0x21, 0xba, 0x0e, 0x57, 0x29, 0x62, 0xee, 0x0c, 0xcd,
-	0x66, 0xed, 0x03, 0x3a, 0xc7, 0x27, 0x5c, 0x9b, 0x06, 0x4a, 0x67, 0xdf, 0xa4, 0xc9, 0x29, 0xa6,
-	0x83, 0xa9, 0x3a, 0x45, 0x6f, 0xb3, 0x65, 0x58, 0xe5, 0xe4, 0xa8, 0x64, 0xf3, 0x56, 0x44, 0x2f,
-	0xb5, 0xac, 0x55, 0xbb, 0x9e, 0x6e, 0x59, 0x2c, 0x67, 0xb4, 0x3f, 0x63, 0x4b, 0xed, 0x16, 0xbd,
-	0x74, 0x60, 0xbe, 0xf4, 0xe7, 0x29, 0x74, 0x1b, 0x5e, 0x9a, 0xe5, 0x39, 0x2f, 0x59, 0x32, 0x4e,
-	0xce, 0x36, 0x26, 0xc4, 0x34, 0xb0, 0xab, 0xfd, 0x05, 0xbb, 0x41, 0xab, 0x42, 0xee, 0xde, 0xd1,
-	0xfe, 0x32, 0x85, 0x36, 0xe0, 0xc5, 0xb9, 0x62, 0x64, 0xb2, 0xea, 0x4d, 0xec, 0xb6, 0xf4, 0x2a,
-	0xd6, 0xfe, 0x2a, 0x45, 0x1b, 0xa2, 0x34, 0x4e, 0xbe, 0x15, 0xfc, 0x7d, 0x8a, 0x9e, 0xa3, 0xc9,
-	0x59, 0xc8, 0x72, 0xea, 0x2e, 0xbd, 0x28, 0x44, 0x9e, 0xd2, 0x3a, 0x61, 0xda, 0xf4, 0x82, 0xde,
-	0x22, 0xce, 0x26, 0xa6, 0x17, 0x84, 0x88, 0x16, 0xb3, 0xb1, 0xd3, 0x43, 0x6f, 0x05, 0x57, 0xe1,
-	0xa2, 0x6e, 0x18, 0x74, 0x36, 0x9e, 0x3b, 0xa1, 0x5f, 0x81, 0x72, 0x02, 0x32, 0x35, 0x9d, 0x5f,
-	0x87, 0xb5, 0x04, 0x60, 0xce, 0x64, 0x7e, 0x19, 0xce, 0x27, 0x60, 0x93, 0x53, 0xf9, 0xa4, 0x9e,
-	0xa9, 0x89, 0xfc, 0x12, 0x94, 0x26, 0x00, 0x89, 0x69, 0xfc, 0x02, 0x9c, 0x4d, 0x9a, 0xa1, 0x4e,
-	0xe2, 0x8a, 0xf2, 0x99, 0x53, 0x78, 0x14, 0xa3, 0x86, 0xe3, 0x7a, 0x6a, 0x16, 0xfd, 0x0c, 0x1b,
-	0x1e, 0xd9, 0xa5, 0x27, 0xca, 0x22, 0x3a, 0xc5, 0xae, 0x82, 0xd6, 0xb6, 0xd9, 0x84, 0x10, 0x2f,
-	0xbf, 0xcb, 0x46, 0x3a, 0x7a, 0x49, 0x13, 0xa9, 0x4b, 0xef, 0x7b, 0xda, 0xa7, 0x16, 0xd8, 0x0c,
-	0x84, 0xa9, 0x35, 0x36, 0x1d, 0x05, 0x6a, 0x96, 0x5e, 0x8f, 0x5a, 0x46, 0x4d, 0xb7, 0x5c, 0xac,
-	0xfd, 0xdd, 0x02, 0x3a, 0x09, 0xe0, 0xb4, 0xb0, 0xed, 0x9b, 0xae, 0xdb, 0xc6, 0xda, 0xf7, 0xe6,
-	0xef, 0xfc, 0x76, 0x16, 0x4e, 0xba, 0xe2, 0xef, 0x21, 0xdd, 0x60, 0xf4, 0xb4, 0xb7, 0x1b, 0xa0,
-	0x2a, 0x14, 0xea, 0x41, 0x28, 0xfe, 0x64, 0x61, 0xea, 0x4d, 0x15, 0xef, 0x1f, 0x84, 0x47, 0xe5,
-	0xc4, 0x5f, 0x2c, 0x56, 0x4e, 0x7d, 0xcf, 0x5f, 0x7f, 0xf6, 0x63, 0xe9, 0x45, 0x54, 0xbc, 0xf5,
-	0xf4, 0x95, 0x5b, 0xec, 0xc9, 0x12, 0xd5, 0xa1, 0xc0, 0x5e, 0x54, 0xad, 0x61, 0x17, 0xc9, 0xff,
-	0x8d, 0x94, 0x8f, 0xb7, 0xe5, 0xc9, 0x85, 0xca, 0x2a, 0x13, 0x70, 0x12, 0x2d, 0x53, 0x01, 0xfc,
-	0x3f, 0x93, 0xfb, 0xc3, 0xee, 0xcd, 0xd4, 0xed, 0x14, 0xaa, 0x43, 0x8e, 0x09, 0x1a, 0xcf, 0xb5,
-	0x65, 0x4a, 0x1a, 0x62, 0xd2, 0x96, 0x10, 0x44, 0xd2, 0xc6, 0xb7, 0x53, 0xe8, 0x75, 0xc8, 0xe3,
-	0x0f, 0x07, 0xbb, 0x87, 0x61, 0x80, 0x4a, 0x82, 0x63, 0xea, 0x35, 0xb7, 0x3c, 0x47, 0x47, 0xe5,
-	0x02, 0x13, 0xb9, 0x5a, 0x59, 0x64, 0x22, 0xb9, 0x98, 0x7b, 0xe2, 0x6d, 0x17, 0x75, 0xa0, 0xa8,
-	0x1f, 0x86, 0x43, 0xf6, 0xf4, 0x87, 0x56, 0x93, 0xef, 0xb8, 0xc7, 0x09, 0xbe, 0xce, 0x04, 0x5f,
-	0x29, 0x9f, 0xa5, 0x82, 0xd9, 0xd3, 0xec, 0xad, 0xce, 0x61, 0x38, 0xf4, 0xa5, 0x0e, 0xfe, 0x02,
-	0x8c, 0x7c, 0x28, 0x50, 0x15, 0xee, 0xd1, 0x60, 0xf7, 0x79, 0x35, 0x5c, 0x63, 0x1a, 0x2e, 0x97,
-	0x57, 0xd9, 0xe6, 0x1c, 0x0d, 0x76, 0x67, 0x2a, 0xd8, 0x05, 0xa0, 0x0a, 0xf8, 0xc3, 0xe3, 0xf3,
-	0xaa, 0xb8, 0xc1, 0x54, 0xac, 0x95, 0xcf, 0x51, 0x15, 0xfc, 0xd1, 0x78, 0xa6, 0x12, 0x0b, 0x72,
-	0x8d, 0xce, 0x60, 0xaf, 0x1f, 0xa0, 0xc4, 0xab, 0xfb, 0x5c, 0xb9, 0x17, 0x99, 0xdc, 0xb3, 0x95,
-	0x53, 0xf1, 0x46, 0xde, 0x7a, 0x83, 0x09, 0xb8, 0x97, 0x5a, 0x7f, 0x94, 0x63, 0xe8, 0xbb, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x84, 0x69, 0xe1, 0xf8, 0xd1, 0x2b, 0x00, 0x00,
+	// 4478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x59, 0x8c, 0x1c, 0xc7,
+	0x79, 0xe6, 0xdc, 0x3b, 0xff, 0x1e, 0x6c, 0x16, 0xb9, 0xe4, 0x68, 0xb9, 0x24, 0x97, 0x63, 0x92,
+	0x92, 0x56, 0xca, 0x92, 0x92, 0x02, 0x43, 0x66, 0x24, 0x07, 0xbd, 0xd3, 0x35, 0x3b, 0xad, 0xed,
+	0xe9, 0x9e, 0x54, 0xf7, 0x50, 0xa2, 0x80, 0x60, 0x30, 0xdc, 0x6d, 0x0e, 0xc7, 0x9a, 0x9d, 0x59,
+	0xcd, 0xcc, 0x52, 0xa2, 0x93, 0xf8, 0x21, 0xc8, 0xed, 0x04, 0x48, 0xe2, 0x38, 0xf7, 0x83, 0x13,
+	0x27, 0xc8, 0x8b, 0xe3, 0xc4, 0x39, 0x9e, 0x82, 0xc4, 0x31, 0xf2, 0x90, 0x38, 0xe7, 0x43, 0x10,
+	0x20, 0x0e, 0x02, 0x04, 0x01, 0xec, 0x07, 0xe7, 0x46, 0x22, 0xc9, 0xf2, 0x25, 0x07, 0x7f, 0x1d,
+	0xdd, 0xd5, 0x73, 0x90, 0xa2, 0x8d, 0x20, 0x4f, 0x3b, 0x55, 0xff, 0x57, 0xff, 0x55, 0x7f, 0xfd,
+	0xff, 0x5f, 0xd5, 0x0b, 0x2b, 0xa3, 0x57, 0xda, 0xb7, 0x6f, 0x0f, 0x7a, 0xfb, 0x5b, 0x87, 0xc3,
+	0xc1, 0x78, 0x40, 0x72, 0xfc, 0xcf, 0xda, 0x7a, 0x67, 0x30, 0xe8, 0xf4, 0xc2, 0xab, 0xed, 0xc3,
+	0xee, 0xd5, 0x76, 0xbf, 0x3f, 0x18, 0xb7, 0xc7, 0xdd, 0x41, 0x7f, 0x24, 0x40, 0x6b, 0x17, 0x24,
+	0x95, 0x8f, 0x6e, 0x1d, 0xdd, 0xbe, 0x3a, 0xee, 0x1e, 0x84, 0xa3, 0x71, 0xfb, 0xe0, 0x50, 0x02,
+	0xce, 0x4e, 0x02, 0xc2, 0x83, 0xc3, 0xf1, 0x3d, 0x41, 0x2c, 0x3f, 0x03, 0xcb, 0xfe, 0xb8, 0x3d,
+	0x0e, 0x59, 0x38, 0x3a, 0x1c, 0xf4, 0x47, 0x21, 0x29, 0x43, 0x6e, 0x84, 0x13, 0xa5, 0xd4, 0x46,
+	0xea, 0xb1, 0xc5, 0xa7, 0x97, 0x04, 0x6e, 0x4b, 0x80, 0x04, 0xa9, 0xbc, 0x0e, 0x0b, 0x11, 0xde,
+	0x80, 0xcc, 0xc1, 0xa8, 0xc3, 0xd1, 0x45, 0x86, 0x3f, 0xcb, 0xe7, 0xa0, 0xc0, 0xc2, 0x57, 0x8f,
+	0xc2, 0xd1, 0x98, 0x10, 0xc8, 0xf6, 0xdb, 0x07, 0xa1, 0xa4, 0xf2, 0xdf, 0xe5, 0x8f, 0x66, 0x21,
+	0xc7, 0xb9, 0x91, 0xa7, 0x00, 0x6e, 0x1d, 0x75, 0x7b, 0xfb, 0xbe, 0x26, 0xef, 0x84, 0x94, 0xb7,
+	0x1d, 0x11, 0x98, 0x06, 0x22, 0xdf, 0x0e, 0x8b, 0xfb, 0xe1, 0x61, 0x6f, 0x70, 0x4f, 0xac, 0x49,
+	0xf3, 0x35, 0x44, 0xae, 0xb1, 0x62, 0x0a, 0xd3, 0x61, 0xa4, 0x06, 0x2b, 0xb7, 0x07, 0xc3, 0xd7,
+	0xda, 0xc3, 0xfd, 0x70, 0xbf, 0x31, 0x18, 0x8e, 0x47, 0xa5, 0xec, 0x46, 0xe6, 0xb1, 0xc5, 0xa7,
+	0x37, 0x74, 0xe3, 0xb6, 0xaa, 0x09, 0x08, 0xed, 0x8f, 0x87, 0xf7, 0xd8, 0xc4, 0x3a, 0x52, 0x01,
+	0x03, 0x5d, 0x70, 0x34, 0xaa, 0xdc, 0x09, 0xf7, 0x5e, 0x11, 0x4a, 0xe4, 0xb8, 0x12, 0x67, 0x34,
+	0x5e, 0x3a, 0x99, 0x4d, 0x2d, 0x20, 0xd7, 0x61, 0xf9, 0x76, 0xb7, 0x17, 0xfa, 0xf7, 0xfa, 0x7b,
+	0x82, 0x43, 0x9e, 0x73, 0x38, 0x25, 0x39, 0x54, 0x75, 0x1a, 0x4b, 0x42, 0x49, 0x03, 0x4e, 0xee,
+	0x87, 0xb7, 0x8e, 0x3a, 0x9d, 0x6e, 0xbf, 0x53, 0x19, 0xf4, 0xc7, 0xed, 0x6e, 0x3f, 0x1c, 0x8e,
+	0x4a, 0x05, 0x6e, 0xcf, 0xf9, 0xc8, 0x11, 0x93, 0x08, 0x7a, 0x37, 0xec, 0x8f, 0xd9, 0xac, 0xa5,
+	0xe4, 0x09, 0x58, 0x38, 0x08, 0xc7, 0xed, 0xfd, 0xf6, 0xb8, 0x5d, 0x5a, 0xe0, 0x8a, 0x1c, 0x97,
+	0x6c, 0xea, 0x72, 0x9a, 0x45, 0x80, 0x35, 0x1f, 0x4e, 0xce, 0x70, 0x13, 0x06, 0xc1, 0x2b, 0xe1,
+	0x3d, 0xbe, 0x85, 0x39, 0x86, 0x3f, 0xc9, 0x15, 0xc8, 0xdd, 0x6d, 0xf7, 0x8e, 0xd4, 0x16, 0x19,
+	0x92, 0x25, 0xae, 0x11, 0xba, 0x08, 0xf2, 0xf5, 0xf4, 0xb3, 0xa9, 0x17, 0xb2, 0x0b, 0x19, 0x23,
+	0x5b, 0xfe, 0x42, 0x0a, 0x16, 0x94, 0x44, 0xb2, 0x09, 0x39, 0xbe, 0xeb, 0xa5, 0x54, 0xc2, 0x35,
+	0x3c, 0x2a, 0x22, 0xb5, 0x04, 0x84, 0x7c, 0x1b, 0xe4, 0xc5, 0x66, 0x4b, 0x59, 0xab, 0x89, 0x70,
+	0x88, 0xd0, 0x12, 0x44, 0xbe, 0x13, 0xa0, 0xbd, 0xbf, 0xdf, 0xc5, 0x23, 0xd4, 0xee, 0x95, 0xf6,
+	0xb8, 0xe3, 0x2e, 0x4c, 0x58, 0xbc, 0x65, 0x46, 0x08, 0x11, 0x07, 0xda, 0x92, 0xb5, 0xe7, 0xe1,
+	0xf8, 0x04, 0x59, 0xb7, 0xbf, 0x28, 0xec, 0x3f, 0xa5, 0xdb, 0x5f, 0xd4, 0xac, 0x2d, 0xbf, 0x99,
+	0x86, 0xe5, 0x84, 0x1d, 0xe4, 0x49, 0x38, 0xd1, 0x3f, 0x3a, 0xb8, 0x15, 0x0e, 0xbd, 0xdb, 0xe6,
+	0x70, 0xdc, 0xbd, 0xdd, 0xde, 0x1b, 0x8f, 0xa4, 0x2f, 0xa7, 0x09, 0xe4, 0x79, 0x58, 0xe0, 0x76,
+	0xe3, 0xb6, 0xa7, 0xb9, 0xf6, 0x17, 0x67, 0x79, 0x67, 0xcb, 0x3e, 0x68, 0x77, 0xc2, 0x6d, 0x81,
+	0x64, 0xd1, 0x12, 0x72, 0x09, 0xb2, 0xe3, 0x7b, 0x87, 0x61, 0x29, 0xb3, 0x91, 0x7a, 0x6c, 0x25,
+	0xda, 0x17, 0x8e, 0x0b, 0xee, 0x1d, 0x86, 0x8c, 0x53, 0x89, 0x35, 0xc3, 0x49, 0x97, 0x66, 0x8a,
+	0xb9, 0x9f, 0xa7, 0x1c, 0x58, 0xd2, 0xb5, 0x20, 0x57, 0xa4, 0xec, 0x14, 0x97, 0x4d, 0x74, 0x7e,
+	0xe1, 0x50, 0x93, 0x7e, 0x0a, 0x72, 0x7b, 0x83, 0xa3, 0xfe, 0x98, 0x3b, 0x2f, 0xc7, 0xc4, 0xe0,
+	0x5b, 0xf5, 0xfb, 0x9f, 0xa6, 0x60, 0x25, 0x19, 0x12, 0xe4, 0x39, 0x28, 0x8a, 0xa0, 0x40, 0x5f,
+	0xa6, 0x26, 0x8e, 0x90, 0x8e, 0x94, 0xc3, 0x70, 0xc8, 0xe2, 0x05, 0xe4, 0x49, 0x28, 0xec, 0xf5,
+	0x8e, 0x46, 0xe3, 0x70, 0x58, 0x4a, 0x27, 0x0c, 0xaa, 0x88, 0x59, 0x6e, 0x90, 0x82, 0xac, 0xd9,
+	0xb0, 0xa0, 0x98, 0x90, 0x47, 0x13, 0x7e, 0x38, 0x99, 0x10, 0xf9, 0x60, 0x47, 0x94, 0xff, 0x29,
+	0x05, 0x10, 0xe7, 0x47, 0xf2, 0x7e, 0x28, 0xb6, 0xb5, 0xb0, 0xd1, 0x13, 0x5b, 0x8c, 0xda, 0x8a,
+	0x02, 0x48, 0x6c, 0x53, 0xbc, 0x84, 0x6c, 0xc0, 0x62, 0xfb, 0x68, 0x3c, 0x08, 0x86, 0xdd, 0x4e,
+	0x47, 0xda, 0xb2, 0xc0, 0xf4, 0x29, 0x4c, 0xd4, 0x32, 0x89, 0x0d, 0xf6, 0x55, 0xe4, 0x9c, 0x48,
+	0xe6, 0xbb, 0xc1, 0x7e, 0xc8, 0x34, 0xd0, 0xda, 0x73, 0xb0, 0x92, 0x94, 0xf8, 0x50, 0x7b, 0xf5,
+	0x41, 0x58, 0xd4, 0x92, 0x39, 0x39, 0x0d, 0x79, 0xc1, 0x5a, 0xae, 0x96, 0xa3, 0xff, 0x13, 0xcd,
+	0xcb, 0xff, 0x9c, 0x02, 0x63, 0x32, 0x89, 0xcf, 0xd5, 0xc0, 0x82, 0xe2, 0x30, 0x1c, 0x0d, 0x8e,
+	0x86, 0x7b, 0xa1, 0x3a, 0x8d, 0x57, 0xe6, 0x14, 0x82, 0x2d, 0xa6, 0x80, 0x72, 0x07, 0xa2, 0x85,
+	0xdf, 0xa4, 0x7f, 0x93, 0xfc, 0x1e, 0xca, 0xbf, 0x36, 0x2c, 0x27, 0xaa, 0xcc, 0x37, 0xef, 0xe1,
+	0xf2, 0xdb, 0x39, 0xc8, 0xf1, 0x8c, 0x4e, 0xae, 0x41, 0x11, 0xeb, 0x04, 0x1f, 0xc8, 0xbc, 0x6d,
+	0x68, 0x79, 0x95, 0xcf, 0xd7, 0x8e, 0xb1, 0x18, 0x44, 0x9e, 0x91, 0x0d, 0x80, 0x58, 0x92, 0x9e,
+	0x6e, 0x00, 0xd4, 0x1a, 0x0d, 0x46, 0xde, 0xab, 0x5a, 0x00, 0xb1, 0x2a, 0x33, 0xa3, 0x05, 0x50,
+	0xcb, 0x74, 0x20, 0xaa, 0x77, 0xa8, 0xaa, 0x4f, 0x29, 0x3b, 0xbb, 0x2a, 0xa1, 0x7a, 0x11, 0x88,
+	0xd0, 0x44, 0xb1, 0x17, 0x0b, 0xe7, 0x16, 0x7b, 0xb5, 0x7e, 0x6a, 0x09, 0xf9, 0x6e, 0x28, 0xa9,
+	0xad, 0x9e, 0xc4, 0xcb, 0xca, 0xaf, 0xca, 0x0f, 0x9b, 0x03, 0xab, 0x1d, 0x63, 0x73, 0x59, 0x90,
+	0xe7, 0xe2, 0x6e, 0x42, 0xf0, 0x2c, 0xcc, 0xec, 0x26, 0x14, 0xa3, 0x24, 0x98, 0xbc, 0x0c, 0x67,
+	0xf6, 0x67, 0x77, 0x0b, 0xb2, 0x19, 0x78, 0x40, 0x4f, 0x51, 0x3b, 0xc6, 0xe6, 0x31, 0x20, 0xef,
+	0x83, 0xa5, 0xfd, 0xf0, 0xae, 0x33, 0x18, 0x1c, 0x0a, 0x86, 0x45, 0xce, 0x30, 0x4e, 0x77, 0x31,
+	0xa9, 0x76, 0x8c, 0x25, 0xa0, 0xe8, 0xfa, 0x71, 0x38, 0x3c, 0xe8, 0xf6, 0x79, 0xab, 0x2b, 0x96,
+	0x43, 0xc2, 0xf5, 0xc1, 0x04, 0x19, 0x5d, 0x3f, 0xb9, 0x84, 0xb8, 0x70, 0xb2, 0x7d, 0x78, 0xd8,
+	0xeb, 0xee, 0xf1, 0x39, 0x67, 0xd0, 0x11, 0x9c, 0x16, 0x39, 0xa7, 0x35, 0xc9, 0xc9, 0x9c, 0x46,
+	0xd4, 0x8e, 0xb1, 0x59, 0x0b, 0xb7, 0x97, 0x00, 0x42, 0xfc, 0xd1, 0xc2, 0xec, 0x5c, 0x66, 0x60,
+	0x4c, 0x6a, 0x31, 0xf7, 0x20, 0x5d, 0x81, 0x4c, 0x38, 0x1c, 0x96, 0xd2, 0x89, 0xbd, 0x31, 0xf7,
+	0x70, 0x61, 0xfb, 0x56, 0x2f, 0xa4, 0xc3, 0x21, 0x43, 0x40, 0xb9, 0x07, 0x4b, 0xba, 0x63, 0xc8,
+	0x3a, 0x14, 0xbb, 0xe3, 0x70, 0xc8, 0x25, 0xc8, 0x9e, 0x20, 0x9e, 0xd0, 0xa4, 0xa5, 0x67, 0x49,
+	0xcb, 0x3c, 0x48, 0xda, 0x87, 0x53, 0xb0, 0x9c, 0x98, 0x26, 0x4f, 0x40, 0x21, 0x1c, 0x0e, 0x79,
+	0x1e, 0x4a, 0xcd, 0xcb, 0x43, 0x0a, 0x41, 0x4a, 0x50, 0x38, 0x08, 0x47, 0xa3, 0x76, 0x47, 0xa5,
+	0x18, 0x35, 0x24, 0xcf, 0xc0, 0xe2, 0xe8, 0xa8, 0xd3, 0x09, 0x47, 0xfc, 0xa6, 0x52, 0xca, 0xf0,
+	0xcc, 0x18, 0xb1, 0x8a, 0x28, 0x4c, 0x47, 0x95, 0x5d, 0x28, 0x46, 0x89, 0x02, 0x93, 0x57, 0x88,
+	0x79, 0x4d, 0xfa, 0x51, 0x0c, 0x12, 0xcd, 0x6a, 0xfa, 0x01, 0xcd, 0x6a, 0xf9, 0x0f, 0x54, 0x9d,
+	0x14, 0x1c, 0xd7, 0x60, 0x41, 0x15, 0x3d, 0xc9, 0x34, 0x1a, 0xcf, 0x75, 0xa4, 0x11, 0x3b, 0xb2,
+	0xc8, 0x5d, 0xa6, 0x3b, 0x28, 0xfb, 0x40, 0x07, 0x5d, 0x87, 0xe5, 0xb6, 0xee, 0xde, 0x52, 0xee,
+	0x3e, 0x3b, 0x92, 0x84, 0x96, 0x3f, 0x96, 0x52, 0x45, 0xf0, 0xfe, 0x91, 0x65, 0xc4, 0x91, 0x35,
+	0xad, 0x62, 0xe6, 0xe1, 0x55, 0xcc, 0xbe, 0x7b, 0x15, 0x3f, 0x9d, 0x2c, 0x95, 0xf7, 0xd7, 0x73,
+	0x7e, 0xb0, 0xfc, 0x3f, 0x3a, 0xf9, 0x8b, 0x29, 0x28, 0xcd, 0xcb, 0xba, 0x18, 0x30, 0x2a, 0xeb,
+	0xaa, 0x80, 0x51, 0xe3, 0xb9, 0x01, 0xa3, 0x59, 0x99, 0x99, 0x69, 0x65, 0x36, 0xb6, 0x32, 0x59,
+	0xf6, 0x73, 0xef, 0xa2, 0xec, 0x4f, 0xdb, 0x9a, 0x7f, 0xf7, 0xb6, 0x7e, 0x2e, 0x0d, 0xc5, 0xa8,
+	0xd2, 0x61, 0x62, 0xe9, 0x0d, 0xf6, 0xda, 0x3d, 0x9c, 0x51, 0x89, 0x25, 0x9a, 0x20, 0xe7, 0x01,
+	0x86, 0xe1, 0xc1, 0x60, 0x1c, 0x72, 0xb2, 0xe8, 0x3e, 0xb5, 0x19, 0x34, 0xf3, 0x70, 0xb0, 0xef,
+	0xb6, 0x0f, 0x22, 0x33, 0xe5, 0x90, 0x5c, 0x82, 0xe5, 0x3d, 0x55, 0x06, 0x38, 0x5d, 0x18, 0x9c,
+	0x9c, 0x44, 0xe9, 0xf8, 0x18, 0x30, 0x3a, 0x6c, 0xef, 0x09, 0xcb, 0x8b, 0x2c, 0x9e, 0x40, 0xc7,
+	0x63, 0x15, 0xe6, 0xcb, 0xf3, 0xc2, 0xf1, 0x6a, 0x4c, 0xca, 0xb0, 0xa4, 0x36, 0x01, 0x1b, 0x65,
+	0x5e, 0xed, 0x8a, 0x2c, 0x31, 0xa7, 0x63, 0x38, 0x8f, 0x85, 0x24, 0x86, 0xf3, 0x29, 0x41, 0xa1,
+	0xbd, 0xbf, 0x3f, 0x0c, 0x47, 0x23, 0x5e, 0x97, 0x8a, 0x4c, 0x0d, 0xc9, 0xd3, 0x00, 0xe3, 0xf6,
+	0xb0, 0x13, 0x8e, 0xb9, 0xed, 0x90, 0xe8, 0x2f, 0xec, 0xfe, 0xd8, 0x1b, 0xfa, 0xe3, 0x61, 0xb7,
+	0xdf, 0x61, 0x1a, 0xaa, 0xfc, 0x4e, 0x2a, 0xee, 0xa8, 0x22, 0xff, 0x62, 0xa5, 0xad, 0xf0, 0xf6,
+	0x5d, 0xfa, 0x37, 0x9a, 0xc0, 0xec, 0xd6, 0x3d, 0x88, 0x8f, 0x82, 0x18, 0x68, 0x41, 0x95, 0x99,
+	0x75, 0xc4, 0xb3, 0x33, 0x0f, 0x48, 0xee, 0xe1, 0x0f, 0xc8, 0xbb, 0x0f, 0x1a, 0x72, 0x05, 0x56,
+	0x0e, 0x07, 0xa3, 0x31, 0xda, 0x25, 0x70, 0xd2, 0xe1, 0x13, 0xb3, 0xe5, 0x37, 0xd2, 0x70, 0x66,
+	0x4e, 0x8b, 0x70, 0xbf, 0x8c, 0xa0, 0x82, 0x28, 0xfd, 0x80, 0x20, 0xca, 0x3c, 0x30, 0x88, 0xb2,
+	0x33, 0x82, 0x28, 0x4a, 0xf7, 0xb9, 0x89, 0x74, 0x5f, 0x82, 0xc2, 0xf0, 0xa8, 0x8f, 0x0f, 0x65,
+	0x32, 0xbe, 0xd4, 0x10, 0x03, 0xff, 0xb5, 0xc1, 0xf0, 0x95, 0x6e, 0xbf, 0x63, 0x75, 0x87, 0xd2,
+	0x56, 0x6d, 0x86, 0xb8, 0x00, 0xbc, 0xdd, 0x11, 0xcf, 0x48, 0x0b, 0xbc, 0xae, 0x6d, 0xdd, 0xbf,
+	0x45, 0xda, 0xb2, 0xa2, 0x05, 0xf2, 0x8a, 0x1c, 0x73, 0xc0, 0x4b, 0xed, 0x04, 0xf9, 0x41, 0x8d,
+	0xfc, 0xb2, 0xde, 0xc8, 0x7f, 0x08, 0x16, 0xb0, 0x39, 0xe1, 0xeb, 0x9e, 0x85, 0x62, 0xf4, 0xf4,
+	0x27, 0xfb, 0xef, 0xb5, 0x2d, 0xf1, 0xf6, 0xb7, 0xa5, 0xde, 0xfe, 0xb6, 0x02, 0x85, 0x60, 0x31,
+	0x18, 0xdf, 0xfc, 0x42, 0xad, 0x05, 0x57, 0x6f, 0x7e, 0xf2, 0xa1, 0x26, 0x4c, 0xd6, 0xe3, 0x8c,
+	0x56, 0x8f, 0xcb, 0xd7, 0xe1, 0x44, 0x73, 0x14, 0x0e, 0xed, 0xfe, 0x18, 0xa1, 0xf2, 0xd5, 0xef,
+	0x32, 0xe4, 0xbb, 0x7c, 0x42, 0x6a, 0xb1, 0x1c, 0x1f, 0x1e, 0x44, 0x49, 0x62, 0xf9, 0x3b, 0x60,
+	0x45, 0x5e, 0x22, 0xd4, 0xc2, 0xc7, 0x93, 0x6f, 0x8f, 0xaa, 0x53, 0x94, 0xa8, 0xc4, 0x13, 0xe4,
+	0x53, 0xb0, 0xa4, 0x4f, 0x93, 0x35, 0x28, 0x84, 0x3c, 0x68, 0xc5, 0x93, 0xd1, 0x42, 0xed, 0x18,
+	0x53, 0x13, 0xdb, 0x39, 0xc8, 0xdc, 0x6d, 0xf7, 0xca, 0xaf, 0x42, 0x5e, 0x68, 0x80, 0xb6, 0xc4,
+	0xaf, 0x4b, 0x0b, 0xea, 0x1d, 0x89, 0x40, 0x76, 0x74, 0xaf, 0xbf, 0x27, 0x2f, 0x39, 0xfc, 0x37,
+	0x86, 0xae, 0x7c, 0x5b, 0xca, 0xf0, 0x59, 0x39, 0x22, 0x97, 0x21, 0xdb, 0x1b, 0x74, 0x46, 0xb2,
+	0x58, 0xaa, 0xc3, 0xe7, 0x0c, 0x3a, 0x23, 0x69, 0x24, 0x27, 0x97, 0xf7, 0x00, 0xe2, 0x66, 0x87,
+	0x3c, 0x0f, 0x2b, 0x71, 0xbb, 0xa3, 0xb5, 0x58, 0xab, 0x53, 0x7d, 0x11, 0x12, 0xd9, 0x04, 0x18,
+	0x75, 0x11, 0x67, 0x53, 0x95, 0x1c, 0x31, 0x2a, 0x7f, 0x17, 0x2c, 0x6a, 0x69, 0x09, 0xcd, 0x88,
+	0x1e, 0x17, 0x72, 0xf2, 0x1d, 0xe1, 0x34, 0xdf, 0x91, 0x1b, 0xed, 0x9e, 0x4c, 0xe5, 0x72, 0x24,
+	0x4e, 0xe6, 0x10, 0xe7, 0xa3, 0x84, 0x83, 0xa3, 0xf2, 0x1d, 0x00, 0xcc, 0x66, 0xcd, 0xc3, 0xde,
+	0xa0, 0xcd, 0x1d, 0x73, 0xd8, 0x1e, 0xdf, 0x51, 0xaf, 0xb8, 0xf8, 0x1b, 0x4f, 0xd0, 0xde, 0x40,
+	0x6c, 0x32, 0xb2, 0x5c, 0x62, 0x6a, 0x88, 0xe8, 0x03, 0xd5, 0x7a, 0x2c, 0x33, 0xfe, 0x1b, 0xd1,
+	0xfb, 0x61, 0x2f, 0x1c, 0x87, 0xfb, 0xdc, 0x63, 0x0b, 0x4c, 0x0d, 0xcb, 0xcf, 0xc2, 0xb2, 0x90,
+	0xa2, 0x62, 0xe0, 0x51, 0xc8, 0x61, 0x9a, 0x54, 0x2f, 0x19, 0x27, 0xb4, 0x6b, 0x8c, 0x04, 0x0a,
+	0x7a, 0xd9, 0x82, 0x15, 0xb5, 0x52, 0x3e, 0x45, 0x97, 0xa0, 0xf0, 0xda, 0xb0, 0x3b, 0x1e, 0x87,
+	0xaa, 0x53, 0x56, 0x43, 0x5d, 0xbe, 0x70, 0x40, 0x24, 0xbf, 0x0d, 0x8b, 0xde, 0xd1, 0xf8, 0xf0,
+	0x68, 0xfc, 0xad, 0x9e, 0xa1, 0xb9, 0x0e, 0x29, 0x7f, 0x3c, 0x05, 0x27, 0x67, 0xdc, 0x31, 0xa6,
+	0x93, 0x5c, 0x6a, 0x56, 0x92, 0x9b, 0x9f, 0x24, 0x13, 0xe9, 0x2f, 0x33, 0x99, 0xfe, 0xa2, 0x0a,
+	0x93, 0xd5, 0x2b, 0x8c, 0xd6, 0x9e, 0xe4, 0x12, 0xed, 0x49, 0xf9, 0xb3, 0x29, 0x80, 0x38, 0x7e,
+	0xf1, 0x71, 0xab, 0xdb, 0xdf, 0xeb, 0x1d, 0xed, 0x87, 0x72, 0x23, 0x48, 0x1c, 0xe3, 0x7e, 0xd8,
+	0x0b, 0xf7, 0xc6, 0x83, 0x21, 0x53, 0x10, 0x44, 0x87, 0xaf, 0x0b, 0x74, 0x7a, 0x3e, 0x5a, 0x42,
+	0x30, 0xea, 0x6e, 0x77, 0x7b, 0xf8, 0x6e, 0x26, 0xa3, 0x4e, 0x8c, 0x50, 0xe5, 0x5e, 0x78, 0x37,
+	0xec, 0x29, 0x95, 0xf9, 0x80, 0x3c, 0x05, 0xc5, 0x3b, 0xdd, 0xce, 0x9d, 0x5e, 0xb7, 0x73, 0x07,
+	0x13, 0x79, 0x46, 0x4b, 0x0c, 0xce, 0xa0, 0x53, 0x53, 0x24, 0x16, 0xa3, 0xca, 0x1d, 0x58, 0xd4,
+	0x04, 0xc7, 0xae, 0x48, 0xe9, 0xae, 0x58, 0x87, 0x62, 0xe4, 0x69, 0xe9, 0xda, 0x78, 0x02, 0x37,
+	0xa7, 0xd7, 0xbe, 0x15, 0xf6, 0x14, 0x13, 0x55, 0x81, 0x12, 0x93, 0xe5, 0xf7, 0xc3, 0x92, 0xae,
+	0x03, 0xdf, 0xac, 0xf6, 0x78, 0x1c, 0x0e, 0xfb, 0x52, 0x96, 0x1a, 0x8a, 0x97, 0xbc, 0xde, 0x40,
+	0x49, 0x12, 0x83, 0xcd, 0x01, 0x2c, 0x6a, 0xaf, 0x9f, 0xa4, 0x04, 0xa7, 0x9a, 0xee, 0xae, 0xeb,
+	0xbd, 0xe8, 0xb6, 0xb6, 0x9b, 0xb6, 0x63, 0x51, 0xd6, 0x0a, 0x6e, 0x36, 0xa8, 0x71, 0x8c, 0x14,
+	0x20, 0xf3, 0x82, 0xbd, 0x6d, 0xa4, 0x48, 0x11, 0x72, 0xdb, 0xe6, 0xcb, 0xd4, 0x31, 0xd2, 0x64,
+	0x05, 0x80, 0xa3, 0x1a, 0x66, 0x65, 0xd7, 0x37, 0x32, 0x04, 0x20, 0x5f, 0x69, 0xfa, 0x81, 0x57,
+	0x37, 0xb2, 0xf8, 0x7b, 0xd7, 0x74, 0xed, 0x5d, 0xcf, 0xc8, 0xe1, 0x6f, 0xcb, 0xab, 0xec, 0x52,
+	0x66, 0xe4, 0x37, 0x2d, 0x28, 0x46, 0x4f, 0xbd, 0xe4, 0x34, 0x90, 0x84, 0x38, 0x25, 0x6c, 0x11,
+	0x0a, 0x15, 0xa7, 0xe9, 0x07, 0x94, 0x19, 0x29, 0x94, 0xbc, 0x53, 0xd9, 0x36, 0xd2, 0x28, 0xd9,
+	0xf1, 0x2a, 0xa6, 0x63, 0x64, 0x36, 0xef, 0xe0, 0x25, 0x35, 0x7e, 0xac, 0x24, 0x8f, 0xc0, 0xaa,
+	0x62, 0x64, 0xd1, 0x86, 0xe3, 0xdd, 0x8c, 0x15, 0x5f, 0x80, 0x6c, 0x8d, 0x3a, 0x75, 0x23, 0x45,
+	0x96, 0xa1, 0xb8, 0xcb, 0xd5, 0xb3, 0x5f, 0xa6, 0x46, 0x1a, 0x85, 0xec, 0x36, 0xb7, 0x69, 0x25,
+	0x70, 0x8c, 0x8c, 0xa6, 0x62, 0x96, 0x4b, 0xf7, 0xea, 0x0d, 0xcf, 0xa7, 0x46, 0x6e, 0xd3, 0x86,
+	0x45, 0xed, 0x35, 0x55, 0x77, 0x90, 0xd4, 0x50, 0xc9, 0x59, 0x82, 0x85, 0xba, 0xed, 0xda, 0xc8,
+	0x52, 0x2a, 0xbd, 0x4b, 0x85, 0xd2, 0x5e, 0x50, 0xa3, 0xcc, 0xc8, 0x6c, 0x7e, 0x6a, 0x1d, 0x20,
+	0xee, 0x8e, 0x48, 0x1e, 0xd2, 0xde, 0xae, 0x71, 0x8c, 0x94, 0xe0, 0xa4, 0x1f, 0x98, 0x41, 0xd3,
+	0xaf, 0xd4, 0x68, 0x65, 0xb7, 0xe5, 0x37, 0x2b, 0x15, 0xea, 0xfb, 0xc6, 0x9f, 0xa5, 0x08, 0x81,
+	0x65, 0xe1, 0x16, 0x35, 0xf7, 0xd9, 0x14, 0x39, 0x09, 0x2b, 0xc2, 0xc2, 0x68, 0xf2, 0xcf, 0x53,
+	0x64, 0x1d, 0x4a, 0x02, 0xd8, 0x68, 0xfa, 0xb5, 0x96, 0xc9, 0xe7, 0x5b, 0x16, 0x75, 0x6d, 0x6a,
+	0x19, 0x21, 0x39, 0x0b, 0x67, 0x24, 0x95, 0x79, 0x2f, 0xd0, 0x4a, 0xd0, 0x72, 0xbd, 0xa0, 0x55,
+	0xf5, 0x9a, 0xae, 0x65, 0xdc, 0x26, 0xef, 0x81, 0x0b, 0x82, 0x28, 0xcc, 0x6f, 0x59, 0x26, 0xad,
+	0x7b, 0x2e, 0x87, 0xb0, 0xa6, 0xeb, 0xda, 0xee, 0x8e, 0xd1, 0x21, 0xa7, 0xc0, 0x10, 0xa0, 0xa6,
+	0x4f, 0x59, 0x8b, 0x32, 0xe6, 0x31, 0xe3, 0x4e, 0x2c, 0x55, 0x2e, 0x6d, 0xba, 0xe6, 0x0d, 0xd3,
+	0x76, 0xcc, 0x6d, 0x87, 0x1a, 0x5d, 0x72, 0x0e, 0x1e, 0x99, 0xa4, 0x36, 0x83, 0x9a, 0xc7, 0xec,
+	0x97, 0xa9, 0x65, 0x7c, 0x20, 0x56, 0x4a, 0x92, 0xfd, 0x9b, 0x7e, 0x40, 0xeb, 0xc8, 0xdb, 0x78,
+	0x85, 0x5c, 0x84, 0x73, 0x09, 0x22, 0x6a, 0x53, 0xf7, 0x2c, 0xbb, 0x6a, 0x53, 0x8b, 0x43, 0x7a,
+	0xe4, 0x12, 0x6c, 0x4c, 0x41, 0xec, 0x7a, 0xc3, 0xa1, 0x75, 0xea, 0x06, 0x12, 0x75, 0x40, 0xce,
+	0xc3, 0xda, 0x84, 0x75, 0x81, 0xd9, 0x72, 0x3c, 0xdf, 0xe7, 0xf4, 0xfe, 0x14, 0xbd, 0xea, 0xb1,
+	0x6d, 0xdb, 0xb2, 0xa8, 0xcb, 0xe9, 0x83, 0x29, 0x23, 0x2a, 0x9e, 0x5b, 0x75, 0xec, 0x4a, 0xc0,
+	0xc9, 0x87, 0x64, 0x03, 0xd6, 0x13, 0x64, 0xee, 0x19, 0xcd, 0xbd, 0xaf, 0x92, 0x32, 0x9c, 0x4f,
+	0x20, 0x6c, 0xf7, 0x86, 0xe9, 0xd8, 0x56, 0xab, 0x61, 0x32, 0x53, 0x58, 0x3b, 0x9c, 0x54, 0xa2,
+	0x6a, 0x3b, 0x54, 0xe3, 0x31, 0x9a, 0x32, 0xb5, 0x62, 0x56, 0x6a, 0xb4, 0x55, 0x65, 0x5e, 0xbd,
+	0xd5, 0x68, 0x3a, 0x0e, 0xe7, 0x32, 0x26, 0x17, 0xe0, 0x6c, 0x02, 0xb5, 0x43, 0x83, 0x96, 0x65,
+	0xef, 0x50, 0x5f, 0x28, 0x7b, 0x14, 0x3b, 0x95, 0xd1, 0x1d, 0xdb, 0x0f, 0xd8, 0xcd, 0x49, 0xc8,
+	0xdd, 0x18, 0xa2, 0x62, 0xfc, 0x05, 0x7b, 0xbb, 0xd5, 0x70, 0x9a, 0x3b, 0xb6, 0x2b, 0xc2, 0xfc,
+	0xb5, 0x78, 0xd3, 0x91, 0xb4, 0xc3, 0x4c, 0xcb, 0xa1, 0x78, 0xe4, 0x38, 0x83, 0xd7, 0xe3, 0x5d,
+	0x45, 0x6a, 0xdd, 0xbc, 0x41, 0xdd, 0x88, 0x78, 0x8f, 0x6c, 0xc2, 0x15, 0xdb, 0xb5, 0x83, 0x68,
+	0xc7, 0x68, 0xf0, 0xa2, 0xc7, 0x76, 0x5b, 0x8e, 0xed, 0x07, 0xb6, 0xbb, 0x83, 0xbe, 0x0d, 0x4c,
+	0xdb, 0xa5, 0xcc, 0x37, 0x3e, 0x48, 0xb6, 0x60, 0x73, 0x16, 0x56, 0xb9, 0x2f, 0xc2, 0xb6, 0x5c,
+	0xb3, 0x4e, 0x8d, 0xef, 0x21, 0xd7, 0xe0, 0xc9, 0x59, 0xf8, 0x18, 0x67, 0x79, 0xd4, 0xe7, 0x5e,
+	0xa5, 0x2f, 0xd9, 0x7e, 0x60, 0x7c, 0x2f, 0x21, 0xb0, 0x22, 0x54, 0xad, 0x79, 0xde, 0x2e, 0xd7,
+	0xf0, 0xfb, 0x30, 0x1f, 0xc9, 0x93, 0xe2, 0x98, 0x41, 0xd5, 0x63, 0x62, 0x87, 0x3e, 0x44, 0x2e,
+	0xc0, 0x9a, 0x7e, 0x44, 0xed, 0xba, 0xb9, 0x43, 0x63, 0xdf, 0x7f, 0x22, 0x4d, 0xde, 0x03, 0xe7,
+	0x75, 0x40, 0x2c, 0xb6, 0xc2, 0xa8, 0x89, 0xd6, 0x19, 0xbf, 0x99, 0x26, 0x65, 0x38, 0xa7, 0x83,
+	0x58, 0xd3, 0xd5, 0x80, 0xc8, 0xe8, 0x93, 0x69, 0x72, 0x19, 0x36, 0x66, 0x33, 0x0a, 0x28, 0xab,
+	0xdb, 0xae, 0x19, 0x50, 0xcb, 0xf8, 0xad, 0x34, 0x79, 0x02, 0xae, 0xe8, 0x30, 0x91, 0x11, 0x30,
+	0xf2, 0x5b, 0xcc, 0x73, 0x1c, 0xaf, 0x19, 0xb4, 0x1a, 0xd4, 0xb5, 0x50, 0xee, 0x6f, 0xa7, 0xc9,
+	0x63, 0xf0, 0x9e, 0x44, 0x82, 0x09, 0x4c, 0xd7, 0x32, 0x1d, 0xcf, 0xa5, 0xad, 0x86, 0x67, 0xf9,
+	0x11, 0xf2, 0x53, 0x69, 0xb2, 0x0e, 0x67, 0x74, 0xe4, 0x0b, 0xde, 0x76, 0x44, 0xfd, 0x9d, 0x34,
+	0x39, 0x0b, 0xa7, 0x27, 0xa9, 0x55, 0xd3, 0x76, 0xa8, 0x65, 0xfc, 0xee, 0x94, 0x10, 0x51, 0x07,
+	0x5a, 0x8c, 0xfa, 0x5e, 0x93, 0x55, 0x68, 0xc4, 0xe6, 0xf7, 0xd2, 0xe4, 0x51, 0x28, 0xdf, 0x0f,
+	0x29, 0x59, 0xfe, 0xfe, 0x7d, 0x7c, 0xc1, 0xa8, 0x1f, 0x98, 0x8c, 0xbb, 0xf5, 0xf3, 0x69, 0xb2,
+	0x06, 0xab, 0x3a, 0xac, 0xe9, 0xd6, 0xa8, 0xe9, 0x04, 0xb5, 0x9b, 0xc6, 0x17, 0xa6, 0x58, 0xb8,
+	0x9e, 0x45, 0x5b, 0x75, 0x5a, 0xf7, 0xd8, 0xcd, 0x56, 0x83, 0x51, 0xdf, 0x6f, 0x32, 0x6a, 0xfc,
+	0x64, 0x66, 0x72, 0xfb, 0x38, 0xcc, 0xb2, 0xfd, 0xdd, 0x18, 0xf4, 0x53, 0x19, 0xf2, 0x38, 0x5c,
+	0x9a, 0x02, 0xa9, 0x38, 0xd3, 0x53, 0xdf, 0x4f, 0x67, 0x26, 0x77, 0x9a, 0x43, 0x1b, 0xb6, 0x15,
+	0xb3, 0xfb, 0xc8, 0x6c, 0x99, 0x4d, 0x17, 0x47, 0x56, 0x53, 0x30, 0xfa, 0x99, 0x0c, 0xb9, 0x08,
+	0xeb, 0x33, 0x40, 0x8c, 0x9a, 0x95, 0x1a, 0x87, 0x7c, 0x34, 0x33, 0x19, 0x9b, 0x42, 0x2d, 0xcc,
+	0xde, 0xd4, 0xb4, 0x6e, 0x1a, 0x3f, 0x3b, 0xa5, 0x8c, 0xf0, 0x6f, 0x4b, 0x0a, 0x42, 0x1f, 0xfe,
+	0x5c, 0x66, 0x72, 0x4f, 0x64, 0x5d, 0x44, 0x97, 0xbb, 0xb4, 0x12, 0xd8, 0x9e, 0xc8, 0x87, 0xbf,
+	0x30, 0xa5, 0xb5, 0x02, 0xa2, 0x71, 0xbb, 0xb6, 0x83, 0x1b, 0xf7, 0x8b, 0x53, 0x9e, 0x8a, 0xb8,
+	0x39, 0x36, 0x46, 0x68, 0x95, 0x06, 0x95, 0x1a, 0xe7, 0xf7, 0x4b, 0x99, 0xc9, 0x0d, 0xd2, 0x02,
+	0x39, 0x86, 0xfd, 0xf2, 0x94, 0x1f, 0x1a, 0x9e, 0xd5, 0xc2, 0xe3, 0x6e, 0x9b, 0x8e, 0xfd, 0x32,
+	0x9a, 0xf0, 0x27, 0x19, 0x2c, 0x96, 0x2a, 0x6b, 0x89, 0x02, 0xf5, 0x46, 0x66, 0xb2, 0xb4, 0x4a,
+	0xba, 0xf1, 0x66, 0x86, 0x5c, 0x81, 0x8b, 0x33, 0x28, 0x13, 0x1b, 0xf0, 0x56, 0x86, 0x6c, 0xc2,
+	0xe5, 0xd9, 0x31, 0xf8, 0xa2, 0x69, 0xf3, 0xac, 0xa5, 0x78, 0x7e, 0x29, 0x43, 0xce, 0xc3, 0x23,
+	0xb3, 0x78, 0xd2, 0x1b, 0xd4, 0x0d, 0x8c, 0xaf, 0x67, 0xb4, 0xd2, 0xad, 0x16, 0xbd, 0x9d, 0x21,
+	0x27, 0x60, 0xc9, 0xbf, 0xe9, 0x56, 0xa2, 0xa9, 0x2f, 0x67, 0xe2, 0xb2, 0xaf, 0xe6, 0xbe, 0x92,
+	0x21, 0xa7, 0xe0, 0xb8, 0x45, 0x6f, 0xf0, 0x14, 0xa7, 0x66, 0xbf, 0xca, 0x67, 0x2b, 0x0e, 0x35,
+	0xdd, 0x66, 0x23, 0x9a, 0xfd, 0x1a, 0x67, 0x99, 0x00, 0xbe, 0x93, 0x21, 0x8f, 0xc0, 0xa9, 0x89,
+	0x62, 0x2c, 0x48, 0xdf, 0xe0, 0x3c, 0xb8, 0x02, 0x7c, 0x89, 0xf0, 0xdc, 0xe7, 0xb2, 0xa8, 0x03,
+	0x9f, 0x8d, 0x92, 0xe3, 0x3f, 0x64, 0xc9, 0x06, 0x9c, 0x55, 0x3a, 0x88, 0x12, 0x42, 0x99, 0x6c,
+	0xdb, 0x2c, 0xda, 0xf0, 0x8d, 0x3f, 0xcc, 0x61, 0x2c, 0x4e, 0x21, 0x02, 0x2c, 0x2f, 0x1c, 0xf0,
+	0x47, 0x39, 0xdc, 0xc7, 0x29, 0x80, 0xf4, 0x09, 0x87, 0x7c, 0x3a, 0x37, 0x53, 0x0a, 0x96, 0x5d,
+	0x7b, 0x07, 0x21, 0xc6, 0x1f, 0xe7, 0xc8, 0x25, 0xb8, 0x10, 0xfb, 0xc2, 0x6f, 0x36, 0x1a, 0x1e,
+	0xc3, 0x8a, 0x7f, 0xe3, 0xa9, 0x56, 0xdd, 0x74, 0xed, 0x2a, 0xf5, 0x03, 0xe3, 0x33, 0xb9, 0xc9,
+	0x73, 0xc1, 0x3b, 0x97, 0x8a, 0xe9, 0x56, 0x28, 0x8f, 0xd2, 0x8f, 0xe5, 0x27, 0xcf, 0x85, 0x45,
+	0x4d, 0xcb, 0xb1, 0x5d, 0xda, 0xa2, 0x2f, 0x55, 0x28, 0xb5, 0xa8, 0x65, 0xfc, 0x4a, 0x1e, 0x9d,
+	0x23, 0x2c, 0x8c, 0x57, 0xfe, 0x6a, 0x9e, 0xac, 0x82, 0x21, 0x95, 0x8e, 0xa7, 0x3f, 0x9e, 0xc7,
+	0xfc, 0x38, 0x51, 0xa7, 0x15, 0xf1, 0xd7, 0xf2, 0x98, 0xa5, 0x12, 0x44, 0x25, 0xce, 0xf8, 0xf5,
+	0x3c, 0x39, 0x07, 0x25, 0x6e, 0x0d, 0x2f, 0x16, 0xb4, 0x15, 0x98, 0x3b, 0x3b, 0x51, 0x9b, 0xf5,
+	0x83, 0x05, 0xb4, 0x84, 0x93, 0x55, 0x7b, 0xd9, 0x6a, 0x98, 0x4d, 0x5f, 0xb4, 0x38, 0x1e, 0x33,
+	0x7e, 0xa8, 0x80, 0x0e, 0x49, 0x02, 0xb4, 0xee, 0x4d, 0xa2, 0x7e, 0xb8, 0x80, 0xe1, 0xa9, 0x4b,
+	0x51, 0xfd, 0xbd, 0xa0, 0xff, 0x48, 0x2c, 0x46, 0xd2, 0xa3, 0x3e, 0x5a, 0x00, 0x7e, 0x74, 0x0a,
+	0xa0, 0x36, 0x56, 0x02, 0x7e, 0xac, 0x80, 0x7e, 0x11, 0x00, 0xde, 0xa0, 0x88, 0xe9, 0x0f, 0xc7,
+	0xea, 0xc9, 0x75, 0x2f, 0x9a, 0x78, 0xb0, 0x03, 0x66, 0x6b, 0x56, 0xfe, 0x78, 0x01, 0x33, 0x8b,
+	0x8e, 0xc2, 0xfc, 0x5e, 0x35, 0x2b, 0xba, 0x84, 0x9f, 0x28, 0xe0, 0x9e, 0x29, 0xcf, 0xcb, 0xee,
+	0x7b, 0x22, 0x45, 0x7d, 0xb1, 0x80, 0x29, 0x25, 0x0a, 0xa9, 0xed, 0xe6, 0x4e, 0xab, 0x46, 0x9d,
+	0x06, 0x2f, 0x1a, 0x01, 0xb3, 0xe9, 0x0d, 0xae, 0x97, 0xf1, 0x2f, 0x05, 0x72, 0x06, 0x48, 0xc4,
+	0x4a, 0x1c, 0x21, 0x24, 0xfc, 0x6b, 0x01, 0x77, 0x43, 0x12, 0xf0, 0xde, 0xd0, 0x32, 0x1b, 0x0d,
+	0xe7, 0x66, 0xcb, 0x31, 0xb7, 0xa9, 0xe3, 0x1b, 0xff, 0x56, 0xc0, 0xa3, 0xa4, 0x93, 0x55, 0x47,
+	0x6c, 0xfc, 0xbb, 0xbe, 0xd2, 0xf5, 0x5a, 0x75, 0x34, 0x13, 0x37, 0x80, 0x3b, 0xda, 0xf8, 0x8f,
+	0x02, 0x56, 0x57, 0x7d, 0xe5, 0x0d, 0xca, 0x7c, 0xa5, 0xf6, 0x7f, 0x16, 0x44, 0xdc, 0xc7, 0xd4,
+	0xba, 0xed, 0x26, 0x10, 0xff, 0x55, 0x10, 0xa7, 0x8b, 0x23, 0x54, 0x46, 0xd5, 0x01, 0x7f, 0xb7,
+	0x20, 0x0e, 0x46, 0x02, 0xe0, 0x55, 0xab, 0x3c, 0xa6, 0xeb, 0x58, 0x15, 0x10, 0xf5, 0xdf, 0x05,
+	0x0d, 0x45, 0x59, 0x9c, 0xc7, 0xaa, 0x1e, 0xc6, 0xa4, 0x43, 0xd1, 0x93, 0xc6, 0xff, 0xe8, 0xb6,
+	0x60, 0x21, 0x89, 0x4e, 0x16, 0x67, 0xf2, 0x86, 0xce, 0x84, 0x93, 0x19, 0xad, 0x7b, 0x01, 0x4d,
+	0xa2, 0xde, 0xd4, 0x99, 0x60, 0x93, 0x97, 0x24, 0xbf, 0xa5, 0x3b, 0x44, 0xe9, 0x1b, 0x79, 0xf3,
+	0x4b, 0x3c, 0x5e, 0x23, 0xaa, 0xbc, 0xb5, 0xc5, 0xf4, 0xb7, 0x93, 0x1a, 0x36, 0x1c, 0xb3, 0x42,
+	0x65, 0x5f, 0x86, 0xe4, 0x2f, 0xeb, 0xa1, 0x12, 0x30, 0xd3, 0xf5, 0x79, 0x37, 0x97, 0x50, 0xe0,
+	0x2b, 0xfa, 0x5e, 0xfa, 0x34, 0x10, 0x7b, 0xcc, 0x49, 0x5f, 0xd5, 0xa5, 0x47, 0x8b, 0x5e, 0x64,
+	0x76, 0x20, 0xd8, 0x7f, 0x4d, 0x8f, 0xb2, 0x86, 0xc9, 0x7c, 0xcd, 0x74, 0xae, 0x84, 0xb8, 0x5f,
+	0x7c, 0xbd, 0x80, 0x6d, 0x91, 0xbe, 0xab, 0x32, 0xb8, 0x5d, 0xd1, 0x8a, 0xc6, 0x3d, 0xc3, 0x3b,
+	0x05, 0x91, 0xe1, 0x05, 0x52, 0xe5, 0xdc, 0x6f, 0x14, 0x36, 0x3f, 0x53, 0x84, 0x95, 0xe4, 0xab,
+	0x1c, 0x5e, 0x2d, 0x5d, 0xdb, 0x31, 0x8e, 0xe1, 0xad, 0xcc, 0xb4, 0x30, 0xf9, 0x56, 0xcd, 0xa6,
+	0x83, 0xd9, 0xb2, 0xe1, 0x19, 0xfb, 0xd8, 0xc3, 0xaa, 0x84, 0xa6, 0xcd, 0xe3, 0x8b, 0xf6, 0xc6,
+	0xf4, 0x7c, 0x6b, 0xc7, 0xf1, 0xb6, 0x4d, 0x47, 0x26, 0x58, 0xe3, 0x36, 0xde, 0x68, 0x76, 0x2a,
+	0x8e, 0xd7, 0x8c, 0xf2, 0x14, 0x5e, 0xda, 0x24, 0x19, 0x1b, 0x97, 0x0e, 0x5e, 0xb5, 0x67, 0x93,
+	0xf0, 0x49, 0xee, 0x94, 0x10, 0x21, 0x59, 0xc8, 0xfb, 0xa6, 0xd1, 0x8d, 0x29, 0x72, 0xa9, 0xba,
+	0x5a, 0x7e, 0x00, 0xd5, 0xad, 0xda, 0x2f, 0x89, 0x8d, 0x15, 0x09, 0x52, 0x5c, 0x01, 0x4f, 0x03,
+	0x91, 0x58, 0x75, 0x69, 0x09, 0xd8, 0x4d, 0xa3, 0x87, 0x17, 0x2a, 0xc4, 0x6b, 0x77, 0xa0, 0x28,
+	0x53, 0x48, 0x23, 0x0e, 0x14, 0xc6, 0xdf, 0x35, 0xab, 0x55, 0xcf, 0xb1, 0xa2, 0xf2, 0x11, 0x5d,
+	0xaf, 0x8c, 0x3e, 0x1a, 0x8a, 0x18, 0xed, 0x82, 0xa3, 0x2c, 0x31, 0xf9, 0x11, 0x18, 0x90, 0xcb,
+	0x70, 0x11, 0x11, 0x73, 0x6f, 0x14, 0xfc, 0xe6, 0x71, 0x88, 0xb7, 0x9a, 0x84, 0x69, 0xd3, 0x40,
+	0x65, 0xec, 0xab, 0xe8, 0x06, 0x64, 0xa9, 0x6e, 0x17, 0xbe, 0x52, 0x79, 0x88, 0xc1, 0x2c, 0xb8,
+	0x4c, 0xe7, 0x35, 0xbc, 0xf5, 0xaf, 0xc1, 0xaa, 0x20, 0x47, 0x29, 0x5e, 0x94, 0x2e, 0xbc, 0xfc,
+	0xf3, 0x72, 0xef, 0x07, 0xa6, 0xe3, 0xf0, 0x18, 0x33, 0xfe, 0x82, 0x4f, 0x35, 0x1b, 0x78, 0x39,
+	0xa3, 0x62, 0xea, 0x2f, 0x53, 0xe4, 0x1a, 0x3c, 0x31, 0xcb, 0x27, 0x22, 0xc5, 0x29, 0x0f, 0x7a,
+	0x37, 0x28, 0x63, 0xb6, 0x45, 0x7d, 0xe3, 0xaf, 0xf8, 0x4b, 0x83, 0xce, 0xe4, 0x99, 0xa7, 0x8d,
+	0xbf, 0x4e, 0x91, 0x2d, 0x78, 0x7c, 0x2e, 0x1b, 0x15, 0xdc, 0x66, 0x9d, 0xfa, 0x0d, 0xb3, 0x42,
+	0x8d, 0xbf, 0x49, 0x61, 0x54, 0x2b, 0xe5, 0xd4, 0x63, 0xcb, 0x3f, 0xa6, 0xf0, 0xdc, 0x4d, 0xf6,
+	0x53, 0x8e, 0xb7, 0xe3, 0xe3, 0x25, 0x29, 0xb2, 0x14, 0xf3, 0x8a, 0xed, 0xe2, 0x43, 0x46, 0x83,
+	0x79, 0xdb, 0xd4, 0xf8, 0xa4, 0x46, 0x8b, 0x97, 0xf1, 0xd3, 0x86, 0x37, 0xa2, 0x8b, 0xb0, 0x6e,
+	0x5a, 0x16, 0xf6, 0xd7, 0x73, 0xbb, 0xfc, 0x0b, 0xb0, 0x96, 0x80, 0x4c, 0x75, 0xf8, 0x97, 0x61,
+	0x23, 0x01, 0x98, 0xd3, 0xdd, 0x9f, 0x87, 0x47, 0x12, 0xb0, 0xc9, 0xce, 0x7e, 0x52, 0xce, 0x54,
+	0x57, 0x7f, 0x0e, 0x4a, 0x13, 0x80, 0x44, 0x47, 0x7f, 0x16, 0x4e, 0x27, 0xd5, 0xd0, 0xbb, 0x79,
+	0x4d, 0xf8, 0xcc, 0x4e, 0x3e, 0xf2, 0x51, 0xcd, 0xf3, 0x03, 0x3d, 0x8a, 0x7e, 0x9e, 0x37, 0xa0,
+	0xfc, 0xe2, 0x14, 0x45, 0x11, 0x76, 0xc2, 0xab, 0x60, 0x34, 0x5d, 0xde, 0x51, 0xc4, 0xd3, 0x6f,
+	0xf1, 0xb6, 0x10, 0x2f, 0xa8, 0x32, 0xa8, 0xf1, 0xae, 0x6b, 0xfc, 0x46, 0x96, 0xf7, 0x4c, 0x14,
+	0xb5, 0x71, 0xb1, 0x75, 0xa8, 0x3a, 0xe6, 0x4e, 0x54, 0x62, 0xaa, 0xa6, 0xe3, 0x53, 0xe3, 0xef,
+	0xb3, 0xe4, 0x38, 0x80, 0xd7, 0xa0, 0x6e, 0xcb, 0xf6, 0xfd, 0x26, 0x35, 0x7e, 0xa0, 0xf0, 0xf4,
+	0x27, 0xf2, 0x70, 0xdc, 0x97, 0xff, 0x57, 0xee, 0x87, 0xc3, 0xbb, 0xdd, 0xbd, 0x90, 0x54, 0x60,
+	0x61, 0x27, 0x1c, 0xcb, 0x7f, 0xfd, 0x9a, 0x7a, 0xdf, 0xa6, 0xf8, 0xff, 0xe1, 0x6b, 0x89, 0xff,
+	0xfc, 0x2e, 0x9f, 0xf8, 0xfe, 0xbf, 0xfd, 0xfc, 0x47, 0xd2, 0x8b, 0xa4, 0x78, 0xf5, 0xee, 0x53,
+	0x57, 0xf9, 0x27, 0x18, 0xb2, 0x03, 0x0b, 0xfc, 0x21, 0xdb, 0x19, 0x74, 0xc8, 0xf1, 0xf8, 0x45,
+	0x96, 0x3f, 0xa4, 0xaf, 0x4d, 0x4e, 0x94, 0x57, 0x39, 0x83, 0xe3, 0x64, 0x19, 0x19, 0x88, 0x7f,
+	0xa2, 0xe9, 0x0d, 0x3a, 0x8f, 0xa5, 0xae, 0xa5, 0xc8, 0x0e, 0xe4, 0x39, 0xa3, 0xd1, 0x5c, 0x5d,
+	0xa6, 0xb8, 0x11, 0xce, 0x6d, 0x89, 0x40, 0xc4, 0x6d, 0x74, 0x2d, 0x45, 0x5e, 0x82, 0x02, 0x7d,
+	0x3d, 0xdc, 0x3b, 0x1a, 0x87, 0xa4, 0x24, 0x57, 0x4c, 0x7d, 0x9d, 0x5a, 0x9b, 0x23, 0xa3, 0x7c,
+	0x96, 0xb3, 0x5c, 0xbd, 0xae, 0x3e, 0x4f, 0x2d, 0x72, 0xd6, 0x92, 0x5d, 0x1b, 0x8a, 0xe6, 0xd1,
+	0x78, 0xc0, 0xdf, 0x4e, 0xc9, 0x6a, 0xf2, 0xbb, 0xd4, 0x83, 0x18, 0x5f, 0xe6, 0x8c, 0x2f, 0x5c,
+	0x17, 0x5f, 0xae, 0xd6, 0x4e, 0x23, 0x5f, 0xfe, 0xc5, 0xe9, 0x2a, 0xfe, 0x1f, 0x5d, 0x4b, 0x89,
+	0x68, 0xc1, 0x02, 0x8a, 0xc0, 0x6f, 0xaa, 0x0f, 0x2b, 0xe1, 0x12, 0x97, 0x70, 0x5e, 0x49, 0x58,
+	0xe5, 0x7b, 0x74, 0xaf, 0xbf, 0x97, 0x14, 0xb0, 0x07, 0x80, 0x02, 0xc4, 0xcb, 0xed, 0xc3, 0x8a,
+	0xb8, 0xc2, 0x45, 0x6c, 0x28, 0x11, 0x67, 0x50, 0x84, 0xf8, 0x16, 0x96, 0x14, 0xe2, 0x40, 0xbe,
+	0xd6, 0xee, 0xef, 0xf7, 0x42, 0x92, 0xf8, 0x8a, 0x38, 0x97, 0xef, 0x3a, 0xe7, 0x7b, 0xfa, 0x7a,
+	0x6a, 0xb3, 0x7c, 0x22, 0xde, 0xcb, 0xab, 0x77, 0x04, 0x8f, 0xf7, 0x41, 0x5e, 0x7e, 0x83, 0x52,
+	0x1f, 0xab, 0x13, 0x1f, 0x8b, 0xd6, 0x56, 0x27, 0x66, 0xc5, 0x87, 0x20, 0x1e, 0x54, 0xef, 0x85,
+	0xbc, 0xf8, 0xb0, 0x33, 0x37, 0xa8, 0xd4, 0x37, 0x0a, 0xed, 0xfb, 0xcf, 0xb5, 0xd4, 0xad, 0x3c,
+	0x9f, 0x7c, 0xe6, 0x7f, 0x07, 0x00, 0xb1, 0x9c, 0xe8, 0x31, 0x8c, 0x31, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3181,6 +3748,11 @@ type SkaffoldServiceClient interface {
 	AutoDeploy(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error)
+	// EXPERIMENTAL. Streams the file changes of a local workspace to a remote Skaffold that owns the dev loop.
+	// Skaffold must be started with `--accept-uploads`.
+	Upload(ctx context.Context, opts ...grpc.CallOption) (SkaffoldService_UploadClient, error)
+	// EXPERIMENTAL. Streams what Skaffold prints to its terminal, including the application logs.
+	Output(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SkaffoldService_OutputClient, error)
 }
 
 type skaffoldServiceClient struct {
@@ -3308,6 +3880,69 @@ func (c *skaffoldServiceClient) Handle(ctx context.Context, in *Event, opts ...g
 	return out, nil
 }
 
+func (c *skaffoldServiceClient) Upload(ctx context.Context, opts ...grpc.CallOption) (SkaffoldService_UploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SkaffoldService_serviceDesc.Streams[2], "/proto.SkaffoldService/Upload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &skaffoldServiceUploadClient{stream}
+	return x, nil
+}
+
+type SkaffoldService_UploadClient interface {
+	Send(*UploadRequest) error
+	Recv() (*UploadResponse, error)
+	grpc.ClientStream
+}
+
+type skaffoldServiceUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *skaffoldServiceUploadClient) Send(m *UploadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *skaffoldServiceUploadClient) Recv() (*UploadResponse, error) {
+	m := new(UploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *skaffoldServiceClient) Output(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SkaffoldService_OutputClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SkaffoldService_serviceDesc.Streams[3], "/proto.SkaffoldService/Output", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &skaffoldServiceOutputClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SkaffoldService_OutputClient interface {
+	Recv() (*OutputEntry, error)
+	grpc.ClientStream
+}
+
+type skaffoldServiceOutputClient struct {
+	grpc.ClientStream
+}
+
+func (x *skaffoldServiceOutputClient) Recv() (*OutputEntry, error) {
+	m := new(OutputEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SkaffoldServiceServer is the server API for SkaffoldService service.
 type SkaffoldServiceServer interface {
 	// Returns the state of the current Skaffold execution
@@ -3327,6 +3962,11 @@ type SkaffoldServiceServer interface {
 	AutoDeploy(context.Context, *TriggerRequest) (*empty.Empty, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(context.Context, *Event) (*empty.Empty, error)
+	// EXPERIMENTAL. Streams the file changes of a local workspace to a remote Skaffold that owns the dev loop.
+	// Skaffold must be started with `--accept-uploads`.
+	Upload(SkaffoldService_UploadServer) error
+	// EXPERIMENTAL. Streams what Skaffold prints to its terminal, including the application logs.
+	Output(*empty.Empty, SkaffoldService_OutputServer) error
 }
 
 // UnimplementedSkaffoldServiceServer can be embedded to have forward compatible implementations.
@@ -3357,6 +3997,12 @@ func (*UnimplementedSkaffoldServiceServer) AutoDeploy(ctx context.Context, req *
 func (*UnimplementedSkaffoldServiceServer) Handle(ctx context.Context, req *Event) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Handle not implemented")
 }
+func (*UnimplementedSkaffoldServiceServer) Upload(srv SkaffoldService_UploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (*UnimplementedSkaffoldServiceServer) Output(req *empty.Empty, srv SkaffoldService_OutputServer) error {
+	return status.Errorf(codes.Unimplemented, "method Output not implemented")
+}
 
 func RegisterSkaffoldServiceServer(s *grpc.Server, srv SkaffoldServiceServer) {
 	s.RegisterService(&_SkaffoldService_serviceDesc, srv)
@@ -3517,6 +4163,53 @@ func _SkaffoldService_Handle_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkaffoldService_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SkaffoldServiceServer).Upload(&skaffoldServiceUploadServer{stream})
+}
+
+type SkaffoldService_UploadServer interface {
+	Send(*UploadResponse) error
+	Recv() (*UploadRequest, error)
+	grpc.ServerStream
+}
+
+type skaffoldServiceUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *skaffoldServiceUploadServer) Send(m *UploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *skaffoldServiceUploadServer) Recv() (*UploadRequest, error) {
+	m := new(UploadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SkaffoldService_Output_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SkaffoldServiceServer).Output(m, &skaffoldServiceOutputServer{stream})
+}
+
+type SkaffoldService_OutputServer interface {
+	Send(*OutputEntry) error
+	grpc.ServerStream
+}
+
+type skaffoldServiceOutputServer struct {
+	grpc.ServerStream
+}
+
+func (x *skaffoldServiceOutputServer) Send(m *OutputEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _SkaffoldService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.SkaffoldService",
 	HandlerType: (*SkaffoldServiceServer)(nil),
@@ -3558,6 +4251,17 @@ var _SkaffoldService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _SkaffoldService_Events_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Upload",
+			Handler:       _SkaffoldService_Upload_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Output",
+			Handler:       _SkaffoldService_Output_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "skaffold.proto",
 }
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutil
+
+import (
+	"archive/tar"
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/pkg/stdcopy"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// FakeContainer is a container managed by the FakeAPIClient.
+type FakeContainer struct {
+	ID         string
+	Name       string
+	Config     container.Config
+	HostConfig container.HostConfig
+	State      ContainerState
+	// Logs are returned by ContainerLogs.
+	Logs string
+}
+
+func (f *FakeAPIClient) ContainerCreate(_ context.Context, config *container.Config, hostConfig *container.HostConfig, _ *network.NetworkingConfig, _ *specs.Platform, name string) (container.ContainerCreateCreatedBody, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	for _, c := range f.Containers {
+		if c.Name == name {
+			return container.ContainerCreateCreatedBody{}, fmt.Errorf("container name %q is already in use", name)
+		}
+	}
+	if _, pulled := f.pulled.Load(config.Image); f.MissingImages[config.Image] && !pulled {
+		return container.ContainerCreateCreatedBody{}, notFoundError{fmt.Errorf("no such image: %s", config.Image)}
+	}
+
+	id := fmt.Sprintf("container-%d", len(f.Containers)+1)
+	f.Containers = append(f.Containers, &FakeContainer{ID: id, Name: name, Config: *config, HostConfig: *hostConfig, State: Created})
+	return container.ContainerCreateCreatedBody{ID: id}, nil
+}
+
+func (f *FakeAPIClient) ContainerStart(_ context.Context, id string, _ types.ContainerStartOptions) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	c := f.findContainer(id)
+	if c == nil {
+		return notFoundError{fmt.Errorf("no such container: %s", id)}
+	}
+	c.State = Started
+	return nil
+}
+
+func (f *FakeAPIClient) ContainerRemove(_ context.Context, id string, _ types.ContainerRemoveOptions) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	for i, c := range f.Containers {
+		if c.ID == id || c.Name == id {
+			f.Containers = append(f.Containers[:i], f.Containers[i+1:]...)
+			return nil
+		}
+	}
+	return notFoundError{fmt.Errorf("no such container: %s", id)}
+}
+
+func (f *FakeAPIClient) ContainerList(_ context.Context, options types.ContainerListOptions) ([]types.Container, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	var list []types.Container
+	for _, c := range f.Containers {
+		if matchesLabels(c.Config.Labels, options.Filters.Get("label")) {
+			list = append(list, types.Container{ID: c.ID, Names: []string{"/" + c.Name}, Image: c.Config.Image, Labels: c.Config.Labels})
+		}
+	}
+	return list, nil
+}
+
+func (f *FakeAPIClient) ContainerLogs(_ context.Context, id string, _ types.ContainerLogsOptions) (io.ReadCloser, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	c := f.findContainer(id)
+	if c == nil {
+		return nil, notFoundError{fmt.Errorf("no such container: %s", id)}
+	}
+
+	reader, writer := io.Pipe()
+	go func(logs string) {
+		w := stdcopy.NewStdWriter(writer, stdcopy.Stdout)
+		w.Write([]byte(logs))
+		writer.Close()
+	}(c.Logs)
+	return reader, nil
+}
+
+func (f *FakeAPIClient) CopyToContainer(_ context.Context, id, dir string, content io.Reader, _ types.CopyToContainerOptions) error {
+	tr := tar.NewReader(content)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		f.mux.Lock()
+		f.Copies = append(f.Copies, id+": "+path.Join(dir, header.Name))
+		f.mux.Unlock()
+	}
+}
+
+func (f *FakeAPIClient) ContainerExecCreate(_ context.Context, id string, config types.ExecConfig) (types.IDResponse, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	if f.findContainer(id) == nil {
+		return types.IDResponse{}, notFoundError{fmt.Errorf("no such container: %s", id)}
+	}
+	f.Execs = append(f.Execs, id+": "+strings.Join(config.Cmd, " "))
+	return types.IDResponse{ID: fmt.Sprintf("exec-%d", len(f.Execs))}, nil
+}
+
+func (f *FakeAPIClient) ContainerExecAttach(context.Context, string, types.ExecStartCheck) (types.HijackedResponse, error) {
+	conn, other := net.Pipe()
+	other.Close()
+	return types.HijackedResponse{Conn: conn, Reader: bufio.NewReader(strings.NewReader(""))}, nil
+}
+
+func (f *FakeAPIClient) ContainerExecInspect(context.Context, string) (types.ContainerExecInspect, error) {
+	return types.ContainerExecInspect{}, nil
+}
+
+func (f *FakeAPIClient) NetworkInspect(_ context.Context, name string, _ types.NetworkInspectOptions) (types.NetworkResource, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	for _, n := range f.Networks {
+		if n != name {
+			continue
+		}
+		connected := map[string]types.EndpointResource{}
+		for _, c := range f.Containers {
+			if string(c.HostConfig.NetworkMode) == name {
+				connected[c.ID] = types.EndpointResource{Name: c.Name}
+			}
+		}
+		return types.NetworkResource{Name: name, Containers: connected}, nil
+	}
+	return types.NetworkResource{}, notFoundError{fmt.Errorf("network %s not found", name)}
+}
+
+func (f *FakeAPIClient) NetworkCreate(_ context.Context, name string, _ types.NetworkCreate) (types.NetworkCreateResponse, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.Networks = append(f.Networks, name)
+	return types.NetworkCreateResponse{ID: name}, nil
+}
+
+func (f *FakeAPIClient) NetworkRemove(_ context.Context, name string) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	for i, n := range f.Networks {
+		if n == name {
+			f.Networks = append(f.Networks[:i], f.Networks[i+1:]...)
+			return nil
+		}
+	}
+	return notFoundError{fmt.Errorf("network %s not found", name)}
+}
+
+func (f *FakeAPIClient) findContainer(id string) *FakeContainer {
+	for _, c := range f.Containers {
+		if c.ID == id || c.Name == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func matchesLabels(labels map[string]string, filters []string) bool {
+	for _, filter := range filters {
+		kv := strings.SplitN(filter, "=", 2)
+		if len(kv) != 2 || labels[kv[0]] != kv[1] {
+			return false
+		}
+	}
+	return true
+}
@@ -70,6 +70,15 @@ type FakeAPIClient struct {
 	Built []types.ImageBuildOptions
 	// ref -> [id]
 	LocalImages map[string][]string
+
+	Containers []*FakeContainer
+	Networks   []string
+	// MissingImages are not in the daemon until they are pulled.
+	MissingImages map[string]bool
+	// Execs records the commands run with ContainerExecCreate, as `id: command`.
+	Execs []string
+	// Copies records the files copied with CopyToContainer, as `id: path`.
+	Copies []string
 }
 
 func (f *FakeAPIClient) ServerVersion(ctx context.Context) (types.Version, error) {